@@ -11,12 +11,18 @@
 //        -i <path> Input file path or directory for the XML schema definition
 //        -o <path> Output file path or directory for the generated code
 //        -p        Specify the package name
-//        -l        Specify the language of generated code (Go/C/Java/Rust/TypeScript)
+//        -l        Specify the language of generated code (Go/C/Java/Rust/TypeScript/Dart/Scala/GraphQL/OpenAPI/Kotlin/Swift/Protobuf/Ruby/C++/JSONSchema/Python/C#/PHP)
+//        -verbose  Output the progress of parsing
+//        -dump-ast Output the parsed definitions before generating code
+//        -optional-as-pointer
+//                  Declare the optional elements and attributes as pointers in Go
 //        -h        Output this help and exit
 //        -v        Output version and exit
 //
 // If the path specified by the -i flag is a directory, all files in the
-// directory will be processed as XML schema definition.
+// directory will be processed as XML schema definition. Files with the .wsdl
+// extension will be processed as WSDL documents, the XML schema definitions
+// embedded in the types section of them will be used.
 //
 // The default package name and output directory are "schema" and "xgen_out".
 //
@@ -27,6 +33,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"log"
 	"os"
 
 	"github.com/xuri/xgen"
@@ -35,11 +42,14 @@ import (
 // Config holds user-defined overrides and filters that are used when
 // generating source code from an XSD document.
 type Config struct {
-	I       string
-	O       string
-	Pkg     string
-	Lang    string
-	Version string
+	I                 string
+	O                 string
+	Pkg               string
+	Lang              string
+	Verbose           bool
+	DumpAST           bool
+	OptionalAsPointer bool
+	Version           string
 }
 
 // Cfg are the default config for xgen. The default package name and output
@@ -56,6 +66,19 @@ var SupportLang = map[string]bool{
 	"Java":       true,
 	"Rust":       true,
 	"TypeScript": true,
+	"Dart":       true,
+	"Scala":      true,
+	"GraphQL":    true,
+	"OpenAPI":    true,
+	"Kotlin":     true,
+	"Swift":      true,
+	"Protobuf":   true,
+	"Ruby":       true,
+	"C++":        true,
+	"JSONSchema": true,
+	"Python":     true,
+	"C#":         true,
+	"PHP":        true,
 }
 
 // parseFlags parse flags of program.
@@ -64,11 +87,14 @@ func parseFlags() *Config {
 	oPtr := flag.String("o", "xgen_out", "Output file path or directory for the generated code")
 	pkgPtr := flag.String("p", "", "Specify the package name")
 	langPtr := flag.String("l", "", "Specify the language of generated code")
+	verbosePtr := flag.Bool("verbose", false, "Output the progress of parsing")
+	dumpASTPtr := flag.Bool("dump-ast", false, "Output the parsed definitions before generating code")
+	optionalAsPointerPtr := flag.Bool("optional-as-pointer", false, "Declare the optional elements and attributes as pointers in Go")
 	verPtr := flag.Bool("v", false, "Show version and exit")
 	helpPtr := flag.Bool("h", false, "Show this help and exit")
 	flag.Parse()
 	if *helpPtr {
-		fmt.Printf("xgen version: %s\r\nCopyright (c) 2020 Ri Xu https://xuri.me All rights reserved.\r\n\r\nUsage:\r\n$ xgen [<flag> ...] <XSD file or directory> ...\n  -i <path>\tInput file path or directory for the XML schema definition\r\n  -o <path>\tOutput file path or directory for the generated code\r\n  -p     \tSpecify the package name\r\n  -l      \tSpecify the language of generated code (Go/C/Java/Rust/TypeScript)\r\n  -h     \tOutput this help and exit\r\n  -v     \tOutput version and exit\r\n", Cfg.Version)
+		fmt.Printf("xgen version: %s\r\nCopyright (c) 2020 Ri Xu https://xuri.me All rights reserved.\r\n\r\nUsage:\r\n$ xgen [<flag> ...] <XSD file or directory> ...\n  -i <path>\tInput file path or directory for the XML schema definition\r\n  -o <path>\tOutput file path or directory for the generated code\r\n  -p     \tSpecify the package name\r\n  -l      \tSpecify the language of generated code (Go/C/Java/Rust/TypeScript/Dart/Scala/GraphQL/OpenAPI/Kotlin/Swift/Protobuf/Ruby/C++/JSONSchema/Python/C#/PHP)\r\n  -verbose\tOutput the progress of parsing\r\n  -dump-ast\tOutput the parsed definitions before generating code\r\n  -optional-as-pointer\r\n         \tDeclare the optional elements and attributes as pointers in Go\r\n  -h     \tOutput this help and exit\r\n  -v     \tOutput version and exit\r\n", Cfg.Version)
 		os.Exit(0)
 	}
 	if *verPtr {
@@ -81,7 +107,7 @@ func parseFlags() *Config {
 	}
 	Cfg.I = *iPtr
 	if *langPtr == "" {
-		fmt.Println("must specify the language of generated code (Go/C/Java/Rust/TypeScript)")
+		fmt.Println("must specify the language of generated code (Go/C/Java/Rust/TypeScript/Dart/Scala/GraphQL/OpenAPI/Kotlin/Swift/Protobuf/Ruby/C++/JSONSchema/Python/C#/PHP)")
 		os.Exit(1)
 	}
 	Cfg.Lang = *langPtr
@@ -95,6 +121,7 @@ func parseFlags() *Config {
 	if *pkgPtr != "" {
 		Cfg.Pkg = *pkgPtr
 	}
+	Cfg.Verbose, Cfg.DumpAST, Cfg.OptionalAsPointer = *verbosePtr, *dumpASTPtr, *optionalAsPointerPtr
 	return &Cfg
 }
 
@@ -104,28 +131,27 @@ func main() {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	files, err := xgen.GetFileList(cfg.I)
+	files, err := xgen.GetFileList(cfg.I, ".xsd", ".wsdl")
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	for _, file := range files {
-		if err = xgen.NewParser(&xgen.Options{
-			FilePath:            file,
-			OutputDir:           cfg.O,
-			Lang:                cfg.Lang,
-			Package:             cfg.Pkg,
-			IncludeMap:          make(map[string]bool),
-			LocalNameNSMap:      make(map[string]string),
-			NSSchemaLocationMap: make(map[string]string),
-			ParseFileList:       make(map[string]bool),
-			ParseFileMap:        make(map[string][]interface{}),
-			ProtoTree:           make([]interface{}, 0),
-			RemoteSchema:        make(map[string][]byte),
-		}).Parse(); err != nil {
-			fmt.Printf("process error on %s: %s\r\n", file, err.Error())
-			os.Exit(1)
+	var logger *log.Logger
+	if cfg.Verbose {
+		logger = log.New(os.Stderr, "xgen: ", 0)
+	}
+	if err = xgen.ParseFiles(files, &xgen.Options{
+		OutputDir:          cfg.O,
+		Lang:               cfg.Lang,
+		Package:            cfg.Pkg,
+		Logger:             logger,
+		DumpAST:            cfg.DumpAST,
+		GoOptionalPointers: cfg.OptionalAsPointer,
+	}); err != nil {
+		for _, parseErr := range err.(xgen.ParseErrors) {
+			fmt.Printf("process error on %s\r\n", parseErr.Error())
 		}
+		os.Exit(1)
 	}
 	fmt.Println("done")
 }
@@ -0,0 +1,68 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// ParseError holds the error which occurred when processing an XML schema
+// document, with the file path and the line number or the byte offset if it
+// is known.
+type ParseError struct {
+	FilePath string
+	Line     int
+	Offset   int64
+	Err      error
+}
+
+// newParseError wraps the error by given file path, the line number will be
+// filled for XML syntax errors.
+func newParseError(filePath string, err error) *ParseError {
+	if parseErr, ok := err.(*ParseError); ok {
+		return parseErr
+	}
+	parseErr := &ParseError{FilePath: filePath, Err: err}
+	if syntaxErr, ok := err.(*xml.SyntaxError); ok {
+		parseErr.Line = syntaxErr.Line
+	}
+	return parseErr
+}
+
+// Error returns the error message with the file path and the line number or
+// the byte offset.
+func (e *ParseError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s:%d: %s", e.FilePath, e.Line, e.Err.Error())
+	}
+	if e.Offset > 0 {
+		return fmt.Sprintf("%s: offset %d: %s", e.FilePath, e.Offset, e.Err.Error())
+	}
+	return fmt.Sprintf("%s: %s", e.FilePath, e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseErrors holds the errors of each XML schema document that failed to be
+// processed by ParseFiles.
+type ParseErrors []*ParseError
+
+// Error returns the error messages of all documents, one per line.
+func (e ParseErrors) Error() string {
+	var messages []string
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
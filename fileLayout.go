@@ -0,0 +1,357 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"go/format"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// File layouts supported by the code generator. FileLayoutSingle is the
+// default and writes all declarations of one schema into a single file,
+// FileLayoutPerType writes every top-level declaration into its own file and
+// FileLayoutPerNamespace writes one file per target namespace.
+const (
+	FileLayoutSingle       = "single"
+	FileLayoutPerType      = "perType"
+	FileLayoutPerNamespace = "perNamespace"
+)
+
+// defaultIndent defines the idiomatic indentation of the generated code for
+// each language, which is used if the Indent of the code generator is empty.
+var defaultIndent = map[string]string{
+	"Go":         "\t",
+	"C":          "\t",
+	"Java":       "    ",
+	"Rust":       "    ",
+	"TypeScript": "  ",
+	"Dart":       "  ",
+	"Scala":      "  ",
+	"GraphQL":    "  ",
+	"OpenAPI":    "  ",
+	"Kotlin":     "    ",
+	"Swift":      "    ",
+	"Protobuf":   "  ",
+	"Ruby":       "  ",
+	"C++":        "  ",
+	"JSONSchema": "  ",
+	"Python":     "    ",
+	"C#":         "    ",
+	"PHP":        "    ",
+}
+
+// defaultFormatters defines the formatters of the generated code for the
+// languages, which are used if the Formatters of the code generator has no
+// formatter for the language.
+var defaultFormatters = map[string]func([]byte) ([]byte, error){
+	"Go": format.Source,
+}
+
+// packageScopedLangs defines the languages in which the generated files in
+// the same directory share one namespace, so each declaration is written
+// into only one of them.
+var packageScopedLangs = map[string]bool{
+	"Go":     true,
+	"Java":   true,
+	"Kotlin": true,
+	"Scala":  true,
+	"C#":     true,
+	"PHP":    true,
+}
+
+// Decl holds the generated source code of a top-level declaration.
+type Decl struct {
+	Name   string
+	Source string
+}
+
+// genProtoTree walks the proto tree and calls the code generator function of
+// the given language for every element, or the registered code generator of
+// the language if any, the source code each of them appended is recorded as
+// a declaration.
+func (gen *CodeGenerator) genProtoTree(lang string) {
+	gen.types = newTypeIndex(gen.ProtoTree)
+	registered, ok := registeredGenerator(lang)
+	for _, ele := range gen.ProtoTree {
+		if ele == nil {
+			continue
+		}
+		start := len(gen.Field)
+		if ok {
+			gen.Field += genRegisteredDecl(registered, ele)
+		} else {
+			funcName := fmt.Sprintf("%s%s", lang, reflect.TypeOf(ele).String()[6:])
+			callFuncByName(gen, funcName, []reflect.Value{reflect.ValueOf(ele)})
+		}
+		if len(gen.Field) > start {
+			gen.Decls = append(gen.Decls, Decl{
+				Name:   reflect.ValueOf(ele).Elem().FieldByName("Name").String(),
+				Source: gen.Field[start:],
+			})
+		}
+	}
+}
+
+// writeSource writes the generated code by the file layout of the code
+// generator. The render function produces the complete file content for the
+// given file path and declarations source, and the genName function derives
+// the file name from a type or namespace name with the naming strategy of the
+// language. All declarations are written into the Output of the code generator
+// instead if it is set. The files are recorded in the Manifest instead of
+// being written if the DryRun of the code generator is set. The declarations
+// which have been written by the other schemas into the same package are
+// skipped. The rendered content is indented and passed to the formatter of the
+// language before it is written. The per-namespace file is written with the
+// declarations of all schemas in the namespace.
+func (gen *CodeGenerator) writeSource(ext string, genName func(string) string, render func(path, field string) ([]byte, error)) (err error) {
+	render = gen.postRender(render)
+	if gen.Output != nil {
+		var source []byte
+		source, err = render(gen.File+ext, gen.Field)
+		if _, writeErr := gen.Output.Write(source); err == nil {
+			err = writeErr
+		}
+		return
+	}
+	if gen.declared != nil && packageScopedLangs[gen.Lang] {
+		gen.dropDeclared(genName)
+	}
+	var typeNames []string
+	declared := map[string]bool{}
+	for _, decl := range gen.Decls {
+		if typeName := genName(decl.Name); !declared[typeName] {
+			declared[typeName] = true
+			typeNames = append(typeNames, typeName)
+		}
+	}
+	switch gen.FileLayout {
+	case "", FileLayoutSingle:
+		return gen.writeFile(gen.File+ext, gen.Field, typeNames, render)
+	case FileLayoutPerType:
+		names := map[string]int{}
+		for _, decl := range gen.Decls {
+			fileName := uniqueFileName(genName(decl.Name), names)
+			if err = gen.writeFile(filepath.Join(filepath.Dir(gen.File), fileName+ext), decl.Source, []string{genName(decl.Name)}, render); err != nil {
+				return
+			}
+		}
+		return
+	case FileLayoutPerNamespace:
+		fileName := genName(nsToName(gen.Namespace))
+		if fileName == "" {
+			return gen.writeFile(gen.File+ext, gen.Field, typeNames, render)
+		}
+		path, field := filepath.Join(filepath.Dir(gen.File), fileName+ext), gen.Field
+		if gen.namespaceFiles != nil {
+			field, typeNames = gen.mergeNamespaceFile(path, genName)
+		}
+		return gen.writeFile(path, field, typeNames, render)
+	}
+	return fmt.Errorf("unsupported file layout %s", gen.FileLayout)
+}
+
+// namespaceFile holds the declarations written into the file of a target
+// namespace by the schemas in the namespace, with the names of the types and
+// the Go packages imported by them.
+type namespaceFile struct {
+	field     string
+	typeNames []string
+	imports   [6]bool
+}
+
+// mergeNamespaceFile adds the declarations of the code generator to the file
+// of the target namespace by given path, and returns the declarations of all
+// schemas in the namespace with the names of the types. The file is written
+// again with all of them by each schema, so the declarations of the other
+// schemas in the same namespace are kept, and the declarations which have
+// been added by them are skipped.
+func (gen *CodeGenerator) mergeNamespaceFile(path string, genName func(string) string) (string, []string) {
+	file, ok := gen.namespaceFiles[path]
+	if !ok {
+		file = &namespaceFile{}
+		gen.namespaceFiles[path] = file
+	}
+	declared := map[string]bool{}
+	for _, typeName := range file.typeNames {
+		declared[typeName] = true
+	}
+	field := gen.Field
+	for _, decl := range gen.Decls {
+		typeName := genName(decl.Name)
+		if declared[typeName] {
+			field = strings.Replace(field, decl.Source, "", 1)
+			continue
+		}
+		declared[typeName] = true
+		file.typeNames = append(file.typeNames, typeName)
+	}
+	file.field += field
+	for i, imported := range []*bool{&gen.ImportTime, &gen.ImportEncodingXML, &gen.ImportStrings, &gen.ImportFmt, &gen.ImportRegexp, &gen.ImportUTF8} {
+		file.imports[i] = file.imports[i] || *imported
+		*imported = file.imports[i]
+	}
+	return file.field, file.typeNames
+}
+
+// sourcePaths returns the paths of the files which the writeSource function
+// writes the declarations into by given extension and the genName function,
+// the files of other languages generated for the same schema can refer to
+// them.
+func (gen *CodeGenerator) sourcePaths(ext string, genName func(string) string) []string {
+	switch gen.FileLayout {
+	case FileLayoutPerType:
+		var paths []string
+		names := map[string]int{}
+		for _, decl := range gen.Decls {
+			paths = append(paths, filepath.Join(filepath.Dir(gen.File), uniqueFileName(genName(decl.Name), names)+ext))
+		}
+		return paths
+	case FileLayoutPerNamespace:
+		if fileName := genName(nsToName(gen.Namespace)); fileName != "" {
+			return []string{filepath.Join(filepath.Dir(gen.File), fileName+ext)}
+		}
+	}
+	return []string{gen.File + ext}
+}
+
+// dropDeclared removes the declarations which have been written into the
+// output directory by the other schemas from the generated code, and records
+// the rest as declared by the schema of the code generator, so generating
+// the same schema again produces the same code.
+func (gen *CodeGenerator) dropDeclared(genName func(string) string) {
+	var decls []Decl
+	for _, decl := range gen.Decls {
+		name := filepath.Join(filepath.Dir(gen.File), genName(decl.Name))
+		if file, ok := gen.declared[name]; ok && file != gen.File {
+			gen.Field = strings.Replace(gen.Field, decl.Source, "", 1)
+			continue
+		}
+		gen.declared[name] = gen.File
+		decls = append(decls, decl)
+	}
+	gen.Decls = decls
+}
+
+// writeFile creates the file by given path and writes the rendered content of
+// the source into it. In the dry-run mode, the content is rendered without
+// being written, and the file is recorded in the Manifest of the code
+// generator with the names of the types declared in it.
+func (gen *CodeGenerator) writeFile(path, field string, typeNames []string, render func(path, field string) ([]byte, error)) error {
+	if gen.DryRun {
+		_, err := render(path, field)
+		if gen.Manifest != nil {
+			gen.Manifest[path] = typeNames
+		}
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	source, err := render(path, field)
+	f.Write(source)
+	return err
+}
+
+// postRender wraps the render function to indent and format the rendered
+// content. The formatter in the Formatters of the code generator runs last,
+// so that its output is written unchanged, and the default formatter runs
+// before the indentation, since it would replace the indentation otherwise,
+// such as gofmt.
+func (gen *CodeGenerator) postRender(render func(path, field string) ([]byte, error)) func(path, field string) ([]byte, error) {
+	if _, ok := gen.Formatters[gen.Lang]; ok {
+		return gen.formatRender(gen.indentRender(render))
+	}
+	return gen.indentRender(gen.formatRender(render))
+}
+
+// formatRender wraps the render function to post-process the rendered content
+// by the formatter of the language in the Formatters of the code generator,
+// or the default formatter of the language if there is none, a nil formatter
+// disables the default one. The unformatted content is returned with the
+// error if the formatter failed.
+func (gen *CodeGenerator) formatRender(render func(path, field string) ([]byte, error)) func(path, field string) ([]byte, error) {
+	formatter, ok := gen.Formatters[gen.Lang]
+	if !ok {
+		formatter = defaultFormatters[gen.Lang]
+	}
+	if formatter == nil {
+		return render
+	}
+	return func(path, field string) ([]byte, error) {
+		source, err := render(path, field)
+		if err != nil {
+			return source, err
+		}
+		formatted, err := formatter(source)
+		if err != nil {
+			return source, fmt.Errorf("%s formatter: %v", gen.Lang, err)
+		}
+		return formatted, nil
+	}
+}
+
+// indentRender wraps the render function to replace the tabs which are used
+// to indent the generated code with the indentation of the code generator.
+func (gen *CodeGenerator) indentRender(render func(path, field string) ([]byte, error)) func(path, field string) ([]byte, error) {
+	indent := gen.Indent
+	if indent == "" {
+		indent = defaultIndent[gen.Lang]
+	}
+	if indent == "" || indent == "\t" {
+		return render
+	}
+	return func(path, field string) ([]byte, error) {
+		source, err := render(path, field)
+		lines := strings.Split(string(source), "\n")
+		for i, line := range lines {
+			content := strings.TrimLeft(line, "\t")
+			lines[i] = strings.Repeat(indent, len(line)-len(content)) + content
+		}
+		return []byte(strings.Join(lines, "\n")), err
+	}
+}
+
+// uniqueFileName returns a file name which wasn't used before by appending a
+// sequence number to the name if necessary. File names are compared case
+// insensitively, since some file systems are case-insensitive.
+func uniqueFileName(name string, names map[string]int) string {
+	lower := strings.ToLower(name)
+	if _, ok := names[lower]; !ok {
+		names[lower] = 1
+		return name
+	}
+	for {
+		names[lower]++
+		fileName := fmt.Sprintf("%s_%d", name, names[lower])
+		if _, ok := names[strings.ToLower(fileName)]; !ok {
+			names[strings.ToLower(fileName)] = 1
+			return fileName
+		}
+	}
+}
+
+// nsToName converts the namespace URI or URN to a dot-separated name, that
+// can be passed to the naming strategy of the language, for example
+// "http://www.example.com/schemas/order" to "www.example.com.schemas.order".
+func nsToName(ns string) string {
+	if u, err := url.Parse(ns); err == nil && u.Host != "" {
+		ns = u.Host + u.Path
+	}
+	ns = strings.TrimPrefix(ns, "urn:")
+	return strings.Join(strings.FieldsFunc(ns, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}), ".")
+}
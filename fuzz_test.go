@@ -0,0 +1,45 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+
+//go:build go1.18
+// +build go1.18
+
+package xgen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// FuzzParse checks that the parser never panics on malformed XML schema
+// documents, and all of the failures are reported as the ParseError.
+func FuzzParse(f *testing.F) {
+	for _, name := range []string{"base64.xsd", "extension.xsd", "shipOrder.xsd"} {
+		data, err := ioutil.ReadFile(filepath.Join("test", "xsd", name))
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data)
+	}
+	f.Add([]byte(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:element name="a"><xs:complexType>`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		opt := &Options{
+			FilePath:            "fuzz.xsd",
+			Extract:             true,
+			Lang:                "Go",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		}
+		err := opt.parse(bytes.NewReader(data))
+		if _, ok := err.(*ParseError); err != nil && !ok {
+			t.Fatalf("unexpected error %T: %v", err, err)
+		}
+	})
+}
@@ -10,8 +10,8 @@ package xgen
 
 import (
 	"fmt"
-	"os"
-	"reflect"
+	"path/filepath"
+	"regexp"
 	"strings"
 )
 
@@ -37,21 +37,63 @@ var cBuildInType = map[string]bool{
 // GenC generates C programming language source code for XML schema definition
 // files.
 func (gen *CodeGenerator) GenC() error {
-	for _, ele := range gen.ProtoTree {
-		if ele == nil {
-			continue
+	gen.genProtoTree("C")
+	return gen.writeSource(".h", genCFieldName, func(path, field string) ([]byte, error) {
+		guard := genCHeaderGuard(filepath.Base(path))
+		var forward string
+		for _, match := range cStructDefinition.FindAllStringSubmatch(field, -1) {
+			forward += fmt.Sprintf("typedef struct %s %s;\n", match[1], match[1])
 		}
-		funcName := fmt.Sprintf("C%s", reflect.TypeOf(ele).String()[6:])
-		callFuncByName(gen, funcName, []reflect.Value{reflect.ValueOf(ele)})
+		if forward != "" {
+			forward = "\n" + forward
+		}
+		var include string
+		if cBoolType.MatchString(field) {
+			include = "\n#include <stdbool.h>\n"
+		}
+		return []byte(fmt.Sprintf("%s\n#ifndef %s\n#define %s\n%s%s%s\n#endif /* %s */\n", copyright, guard, guard, include, forward, field, guard)), nil
+	})
+}
+
+// cBoolType matches the bool type in the generated code, which is declared
+// in the stdbool.h header.
+var cBoolType = regexp.MustCompile(`\bbool\b`)
+
+// cStructDefinition matches the struct definitions in the generated code,
+// which are forward declared before all of the definitions.
+var cStructDefinition = regexp.MustCompile(`(?m)^struct (\w+) \{$`)
+
+// genCHeaderGuard generates the name of the include guard macro by given
+// header file name.
+func genCHeaderGuard(fileName string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		if r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, fileName) + "_"
+}
+
+// genCFieldDecl generates the declaration of the struct member by given
+// type, name and occurrence. Array and repeating members are declared as
+// pointers, and the member with the type defined in the schema is declared
+// as the pointer to break the dependencies between the struct definitions.
+func genCFieldDecl(fieldType, fieldName string, plural bool) string {
+	fieldType, array := innerArray(genCFieldType(fieldType))
+	var pointer string
+	if array {
+		pointer += "*"
+	}
+	if plural {
+		pointer += "*"
 	}
-	f, err := os.Create(gen.File + ".h")
-	if err != nil {
-		return err
+	if _, ok := cBuildInType[fieldType]; !ok && pointer == "" {
+		pointer = "*"
 	}
-	defer f.Close()
-	source := []byte(fmt.Sprintf("%s\n%s", copyright, gen.Field))
-	f.Write(source)
-	return err
+	return fmt.Sprintf("%s %s%s", fieldType, pointer, fieldName)
 }
 
 func innerArray(dataType string) (string, bool) {
@@ -94,41 +136,37 @@ func genCFieldType(name string) string {
 func (gen *CodeGenerator) CSimpleType(v *SimpleType) {
 	if v.List {
 		if _, ok := gen.StructAST[v.Name]; !ok {
-			fieldType := genCFieldType(getBasefromSimpleType(trimNSPrefix(v.Base), gen.ProtoTree))
-			content := fmt.Sprintf("%s %s[];\n", genCFieldType(fieldType), genCFieldName(v.Name))
+			fieldType := genCFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))
+			content := fmt.Sprintf("%s;\n", genCFieldDecl(fieldType, genCFieldName(v.Name), true))
 			gen.StructAST[v.Name] = content
-			gen.Field += fmt.Sprintf("\ntypedef %s", gen.StructAST[v.Name])
+			gen.Field += withDerivationComment(fmt.Sprintf("\ntypedef %s", gen.StructAST[v.Name]), "", v.Final)
 			return
 		}
 	}
 	if v.Union && len(v.MemberTypes) > 0 {
 		if _, ok := gen.StructAST[v.Name]; !ok {
-			content := "struct {\n"
-			for memberName, memberType := range v.MemberTypes {
+			content := " {\n"
+			for _, memberName := range v.Members {
+				memberType := v.MemberTypes[memberName]
 				if memberType == "" { // fix order issue
-					memberType = getBasefromSimpleType(memberName, gen.ProtoTree)
+					memberType = gen.getBasefromSimpleType(memberName)
 				}
-				var plural, fieldType string
-				var ok bool
-				if fieldType, ok = innerArray(genCFieldType(memberType)); ok {
-					plural = "[]"
-				}
-				content += fmt.Sprintf("\t%s %s%s;\n", fieldType, genCFieldName(memberName), plural)
+				content += fmt.Sprintf("\t%s;\n", genCFieldDecl(memberType, genCFieldName(memberName), false))
 			}
-			content += "}"
+			content += "};\n"
 			gen.StructAST[v.Name] = content
-			gen.Field += fmt.Sprintf("\ntypedef %s %s;\n", gen.StructAST[v.Name], genCFieldName(v.Name))
+			gen.Field += withDerivationComment(fmt.Sprintf("\nstruct %s%s", genCFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final)
 		}
 		return
 	}
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		var plural, fieldType string
 		var ok bool
-		if fieldType, ok = innerArray(genCFieldType(getBasefromSimpleType(trimNSPrefix(v.Base), gen.ProtoTree))); ok {
+		if fieldType, ok = innerArray(genCFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))); ok {
 			plural = "[]"
 		}
 		gen.StructAST[v.Name] = fmt.Sprintf("%s %s%s", fieldType, genCFieldName(v.Name), plural)
-		gen.Field += fmt.Sprintf("\ntypedef %s;\n", gen.StructAST[v.Name])
+		gen.Field += withDerivationComment(fmt.Sprintf("\ntypedef %s;\n", gen.StructAST[v.Name]), "", v.Final)
 	}
 	return
 }
@@ -137,10 +175,10 @@ func (gen *CodeGenerator) CSimpleType(v *SimpleType) {
 // syntax.
 func (gen *CodeGenerator) CComplexType(v *ComplexType) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		content := "struct {\n"
+		content := " {\n"
 		for _, attrGroup := range v.AttributeGroup {
-			fieldType := getBasefromSimpleType(trimNSPrefix(attrGroup.Ref), gen.ProtoTree)
-			content += fmt.Sprintf("\t%s %s;\n", genCFieldType(fieldType), genCFieldName(attrGroup.Name))
+			fieldType := gen.getBasefromSimpleType(trimNSPrefix(attrGroup.Ref))
+			content += fmt.Sprintf("\t%s;\n", genCFieldDecl(fieldType, genCFieldName(attrGroup.Name), false))
 		}
 
 		for _, attribute := range v.Attributes {
@@ -148,33 +186,22 @@ func (gen *CodeGenerator) CComplexType(v *ComplexType) {
 			if attribute.Optional {
 				optional = `, optional`
 			}
-			var plural, fieldType string
-			var ok bool
-			if fieldType, ok = innerArray(genCFieldType(getBasefromSimpleType(trimNSPrefix(attribute.Type), gen.ProtoTree))); ok {
-				plural = "[]"
-			}
-			content += fmt.Sprintf("\t%s %sAttr%s; // attr%s\n", fieldType, genCFieldName(attribute.Name), plural, optional)
+			fieldType := gen.getBasefromSimpleType(trimNSPrefix(attribute.Type))
+			content += fmt.Sprintf("\t%s; // attr%s\n", genCFieldDecl(fieldType, genCFieldName(attribute.Name)+"Attr", false), optional)
 		}
 
 		for _, group := range v.Groups {
-			var plural string
-			if group.Plural {
-				plural = "[]"
-			}
-			content += fmt.Sprintf("\t%s %s%s;\n", genCFieldType(getBasefromSimpleType(trimNSPrefix(group.Ref), gen.ProtoTree)), genCFieldName(group.Name), plural)
+			fieldType := gen.getBasefromSimpleType(trimNSPrefix(group.Ref))
+			content += fmt.Sprintf("\t%s;\n", genCFieldDecl(fieldType, genCFieldName(group.Name), group.Plural))
 		}
 
 		for _, element := range v.Elements {
-			var plural, fieldType string
-			var ok bool
-			if fieldType, ok = innerArray(genCFieldType(getBasefromSimpleType(trimNSPrefix(element.Type), gen.ProtoTree))); ok || element.Plural {
-				plural = "[]"
-			}
-			content += fmt.Sprintf("\t%s %s%s;\n", fieldType, genCFieldName(element.Name), plural)
+			fieldType := gen.getBasefromSimpleType(trimNSPrefix(element.Type))
+			content += fmt.Sprintf("\t%s;\n", genCFieldDecl(fieldType, genCFieldName(element.Name), element.Plural))
 		}
-		content += "}"
+		content += "};\n"
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\ntypedef %s %s;\n", gen.StructAST[v.Name], genCFieldName(v.Name))
+		gen.Field += withDerivationComment(fmt.Sprintf("\nstruct %s%s", genCFieldName(v.Name), gen.StructAST[v.Name]), v.Block, v.Final)
 	}
 	return
 }
@@ -182,26 +209,20 @@ func (gen *CodeGenerator) CComplexType(v *ComplexType) {
 // CGroup generates code for group XML schema in C language syntax.
 func (gen *CodeGenerator) CGroup(v *Group) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		content := "struct {\n"
+		content := " {\n"
 		for _, element := range v.Elements {
-			var plural string
-			if element.Plural {
-				plural = "[]"
-			}
-			content += fmt.Sprintf("\t%s %s%s;\n", genCFieldType(getBasefromSimpleType(trimNSPrefix(element.Type), gen.ProtoTree)), genCFieldName(element.Name), plural)
+			fieldType := gen.getBasefromSimpleType(trimNSPrefix(element.Type))
+			content += fmt.Sprintf("\t%s;\n", genCFieldDecl(fieldType, genCFieldName(element.Name), element.Plural))
 		}
 
 		for _, group := range v.Groups {
-			var plural string
-			if group.Plural {
-				plural = "[]"
-			}
-			content += fmt.Sprintf("\t%s %s%s;\n", genCFieldType(getBasefromSimpleType(trimNSPrefix(group.Ref), gen.ProtoTree)), genCFieldName(group.Name), plural)
+			fieldType := gen.getBasefromSimpleType(trimNSPrefix(group.Ref))
+			content += fmt.Sprintf("\t%s;\n", genCFieldDecl(fieldType, genCFieldName(group.Name), group.Plural))
 		}
 
-		content += "}"
+		content += "};\n"
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\ntypedef %s %s;\n", gen.StructAST[v.Name], genCFieldName(v.Name))
+		gen.Field += fmt.Sprintf("\nstruct %s%s", genCFieldName(v.Name), gen.StructAST[v.Name])
 	}
 	return
 }
@@ -210,21 +231,18 @@ func (gen *CodeGenerator) CGroup(v *Group) {
 // syntax.
 func (gen *CodeGenerator) CAttributeGroup(v *AttributeGroup) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		content := "struct {\n"
+		content := " {\n"
 		for _, attribute := range v.Attributes {
-			var optional, plural, fieldType string
-			var ok bool
+			var optional string
 			if attribute.Optional {
 				optional = `, optional`
 			}
-			if fieldType, ok = innerArray(genCFieldType(getBasefromSimpleType(trimNSPrefix(attribute.Type), gen.ProtoTree))); ok {
-				plural = "[]"
-			}
-			content += fmt.Sprintf("\t%s %sAttr%s; // attr%s\n", fieldType, genCFieldName(attribute.Name), plural, optional)
+			fieldType := gen.getBasefromSimpleType(trimNSPrefix(attribute.Type))
+			content += fmt.Sprintf("\t%s; // attr%s\n", genCFieldDecl(fieldType, genCFieldName(attribute.Name)+"Attr", false), optional)
 		}
-		content += "}"
+		content += "};\n"
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\ntypedef %s %s;\n", gen.StructAST[v.Name], genCFieldName(v.Name))
+		gen.Field += fmt.Sprintf("\nstruct %s%s", genCFieldName(v.Name), gen.StructAST[v.Name])
 	}
 }
 
@@ -233,11 +251,11 @@ func (gen *CodeGenerator) CElement(v *Element) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		var plural, fieldType string
 		var ok bool
-		if fieldType, ok = innerArray(genCFieldType(getBasefromSimpleType(trimNSPrefix(v.Type), gen.ProtoTree))); ok || v.Plural {
+		if fieldType, ok = innerArray(genCFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Type)))); ok || v.Plural {
 			plural = "[]"
 		}
 		gen.StructAST[v.Name] = fmt.Sprintf("%s %s%s", fieldType, genCFieldName(v.Name), plural)
-		gen.Field += fmt.Sprintf("\ntypedef %s;\n", gen.StructAST[v.Name])
+		gen.Field += withDerivationComment(fmt.Sprintf("\ntypedef %s;\n", gen.StructAST[v.Name]), v.Block, v.Final)
 	}
 }
 
@@ -246,7 +264,7 @@ func (gen *CodeGenerator) CAttribute(v *Attribute) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		var plural, fieldType string
 		var ok bool
-		if fieldType, ok = innerArray(genCFieldType(getBasefromSimpleType(trimNSPrefix(v.Type), gen.ProtoTree))); ok || v.Plural {
+		if fieldType, ok = innerArray(genCFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Type)))); ok || v.Plural {
 			plural = "[]"
 		}
 		gen.StructAST[v.Name] = fmt.Sprintf("%s %s%s", fieldType, genCFieldName(v.Name), plural)
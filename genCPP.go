@@ -0,0 +1,474 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var cppBuildInType = map[string]bool{
+	"bool":                       true,
+	"double":                     true,
+	"float":                      true,
+	"int":                        true,
+	"long long":                  true,
+	"short":                      true,
+	"signed char":                true,
+	"std::string":                true,
+	"std::vector<std::string>":   true,
+	"std::vector<unsigned char>": true,
+	"unsigned char":              true,
+	"unsigned int":               true,
+	"unsigned long long":         true,
+	"unsigned short":             true,
+}
+
+var cppKeywords = map[string]bool{
+	"alignas": true, "alignof": true, "and": true, "asm": true, "auto": true,
+	"bool": true, "break": true, "case": true, "catch": true, "char": true,
+	"class": true, "const": true, "constexpr": true, "continue": true,
+	"decltype": true, "default": true, "delete": true, "do": true,
+	"double": true, "else": true, "enum": true, "explicit": true,
+	"export": true, "extern": true, "false": true, "float": true, "for": true,
+	"friend": true, "goto": true, "if": true, "inline": true, "int": true,
+	"long": true, "mutable": true, "namespace": true, "new": true,
+	"noexcept": true, "not": true, "nullptr": true, "operator": true,
+	"or": true, "private": true, "protected": true, "public": true,
+	"register": true, "return": true, "short": true, "signed": true,
+	"sizeof": true, "static": true, "struct": true, "switch": true,
+	"template": true, "this": true, "throw": true, "true": true, "try": true,
+	"typedef": true, "typeid": true, "typename": true, "union": true,
+	"unsigned": true, "using": true, "virtual": true, "void": true,
+	"volatile": true, "while": true, "xor": true,
+}
+
+// cppMember defines a data member of the generated class.
+type cppMember struct {
+	Name     string
+	Type     string
+	Plural   bool
+	Optional bool
+}
+
+// cppIncludes defines the standard library headers which are included if the
+// generated code uses the types declared in them.
+var cppIncludes = []struct {
+	Header string
+	Usage  *regexp.Regexp
+}{
+	{"memory", regexp.MustCompile(`\bstd::shared_ptr<`)},
+	{"optional", regexp.MustCompile(`\bstd::optional<`)},
+	{"string", regexp.MustCompile(`\bstd::string\b`)},
+	{"string_view", regexp.MustCompile(`\bstd::string_view\b`)},
+	{"variant", regexp.MustCompile(`\bstd::variant<`)},
+	{"vector", regexp.MustCompile(`\bstd::vector<`)},
+}
+
+// cppDefinition matches the class and enumeration definitions in the
+// generated code, which are forward declared before all of the definitions.
+var cppDefinition = regexp.MustCompile(`(?m)^(class|enum class) (\w+)\b.*\{`)
+
+// GenCPP generates C++ programming language source code for XML schema
+// definition files. Complex types are declared as the classes in the
+// namespace named after the package, simple types with enumerations are
+// declared as the scoped enumerations, and other simple types are declared
+// as type aliases. The optional members are declared as std::optional, the
+// repeating ones are declared as std::vector, and the members which refer to
+// the class being defined are declared as std::shared_ptr. The functions
+// converting the enumerations from and to their values are declared in the
+// headers and defined in the implementation file next to them.
+func (gen *CodeGenerator) GenCPP() error {
+	gen.genProtoTree("CPP")
+	namespace := gen.Package
+	if namespace == "" {
+		namespace = "schema"
+	}
+	namespace = strings.Replace(namespace, ".", "::", -1)
+	if err := gen.writeSource(".hpp", genCPPClassName, func(path, field string) ([]byte, error) {
+		guard := genCHeaderGuard(filepath.Base(path))
+		var include, forward string
+		for _, header := range cppIncludes {
+			if header.Usage.MatchString(field) {
+				include += fmt.Sprintf("#include <%s>\n", header.Header)
+			}
+		}
+		if include != "" {
+			include = "\n" + include
+		}
+		for _, match := range cppDefinition.FindAllStringSubmatch(field, -1) {
+			forward += fmt.Sprintf("%s %s;\n", match[1], match[2])
+		}
+		if forward != "" {
+			forward = "\n" + forward
+		}
+		return []byte(fmt.Sprintf("%s\n#ifndef %s\n#define %s\n%s\nnamespace %s {\n%s%s\n}  // namespace %s\n\n#endif  // %s\n",
+			copyright, guard, guard, include, namespace, forward, field, namespace, guard)), nil
+	}); err != nil || gen.Output != nil {
+		return err
+	}
+	return gen.genCPPSource(namespace)
+}
+
+// genCPPSource generates the implementation file next to the headers, which
+// defines the functions declared with the enumerations in them. The file
+// isn't generated if no function is declared.
+func (gen *CodeGenerator) genCPPSource(namespace string) error {
+	var content string
+	var typeNames []string
+	for _, decl := range gen.Decls {
+		for _, ele := range gen.ProtoTree {
+			v, ok := ele.(*SimpleType)
+			if !ok || v.Name != decl.Name || len(v.Restriction.Enum) == 0 || v.List || v.Union {
+				continue
+			}
+			typeName := genCPPClassName(v.Name)
+			typeNames = append(typeNames, typeName)
+			var cases, conditions string
+			for i, enumName := range genCPPEnumeratorNames(v.Restriction.Enum) {
+				cases += fmt.Sprintf("\tcase %s::%s:\n\t\treturn %q;\n", typeName, enumName, v.Restriction.Enum[i])
+				conditions += fmt.Sprintf("\tif (value == %q) {\n\t\treturn %s::%s;\n\t}\n", v.Restriction.Enum[i], typeName, enumName)
+			}
+			content += fmt.Sprintf("\nstd::string_view toString(%s value) {\n\tswitch (value) {\n%s\t}\n\treturn {};\n}\n", typeName, cases)
+			content += fmt.Sprintf("\nstd::optional<%s> parse%s(std::string_view value) {\n%s\treturn std::nullopt;\n}\n", typeName, typeName, conditions)
+			break
+		}
+	}
+	if content == "" {
+		return nil
+	}
+	return gen.writeFile(gen.File+".cpp", content, typeNames, gen.postRender(func(path, field string) ([]byte, error) {
+		var include string
+		for _, header := range gen.sourcePaths(".hpp", genCPPClassName) {
+			include += fmt.Sprintf("#include \"%s\"\n", filepath.Base(header))
+		}
+		return []byte(fmt.Sprintf("%s\n%s\nnamespace %s {\n%s\n}  // namespace %s\n", copyright, include, namespace, field, namespace)), nil
+	}))
+}
+
+func genCPPClassName(name string) (fieldName string) {
+	for _, str := range strings.Split(name, ":") {
+		fieldName += MakeFirstUpperCase(str)
+	}
+	var tmp string
+	for _, str := range strings.Split(fieldName, ".") {
+		tmp += MakeFirstUpperCase(str)
+	}
+	fieldName = tmp
+	fieldName = strings.Replace(strings.Replace(fieldName, "-", "", -1), "_", "", -1)
+	return
+}
+
+// genCPPMemberName generates the lower camel case data member name of the
+// class by given name, keywords will be suffixed with an underscore.
+func genCPPMemberName(name string) string {
+	memberName := genCPPClassName(name)
+	if memberName == "" {
+		return memberName
+	}
+	memberName = strings.ToLower(memberName[:1]) + memberName[1:]
+	if cppKeywords[memberName] {
+		return memberName + "_"
+	}
+	return memberName
+}
+
+// genCPPEnumerators generates the enumerators of the scoped enumeration by
+// given values.
+func genCPPEnumerators(enums []string) (content string) {
+	for i, enumName := range genCPPEnumeratorNames(enums) {
+		content += fmt.Sprintf("\t%s,  // %s\n", enumName, enums[i])
+	}
+	return
+}
+
+// genCPPEnumeratorNames returns the names of the enumerators by given
+// values, the enumerators are named after the values in upper camel case
+// and numbered if the names are duplicated.
+func genCPPEnumeratorNames(enums []string) (names []string) {
+	seen := map[string]bool{}
+	for _, enum := range enums {
+		var enumName string
+		for _, str := range strings.FieldsFunc(enum, func(r rune) bool {
+			return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+		}) {
+			enumName += MakeFirstUpperCase(str)
+		}
+		if enumName == "" {
+			enumName = "Empty"
+		}
+		if enumName[0] >= '0' && enumName[0] <= '9' {
+			enumName = "Value" + enumName
+		}
+		for i, name := 2, enumName; seen[enumName]; i++ {
+			enumName = fmt.Sprintf("%s%d", name, i)
+		}
+		seen[enumName] = true
+		names = append(names, enumName)
+	}
+	return
+}
+
+// isCPPBuildInType reports whether the type is the fundamental type or the
+// type in the standard library, which can't be trimmed as the QName since
+// the scope resolution operator is used in the name.
+func isCPPBuildInType(name string) bool {
+	return cppBuildInType[name] || strings.HasPrefix(name, "std::")
+}
+
+// genCPPType returns the C++ type of the definition by given type name. The
+// lists and unions are declared as std::vector and std::variant of their
+// item and member types, the enumerations are referenced by name, and other
+// simple types are replaced by their base types.
+func (gen *CodeGenerator) genCPPType(name string) string {
+	if isCPPBuildInType(name) {
+		return name
+	}
+	for _, ele := range gen.ProtoTree {
+		v, ok := ele.(*SimpleType)
+		if !ok || v.Name != trimNSPrefix(name) {
+			continue
+		}
+		if v.List {
+			return fmt.Sprintf("std::vector<%s>", gen.genCPPType(v.Base))
+		}
+		if v.Union && len(v.MemberTypes) > 0 {
+			return gen.genCPPVariant(v)
+		}
+		if len(v.Restriction.Enum) > 0 {
+			return genCPPClassName(v.Name)
+		}
+		break
+	}
+	fieldType := gen.getBasefromSimpleType(trimNSPrefix(name))
+	if isCPPBuildInType(fieldType) {
+		return fieldType
+	}
+	if fieldType = genCPPClassName(fieldType); fieldType != "" {
+		return fieldType
+	}
+	return "std::string"
+}
+
+// genCPPVariant returns the std::variant of the member types of the union.
+func (gen *CodeGenerator) genCPPVariant(v *SimpleType) string {
+	var memberNames, memberTypes []string
+	for memberName := range v.MemberTypes {
+		memberNames = append(memberNames, memberName)
+	}
+	sort.Strings(memberNames)
+	seen := map[string]bool{}
+	for _, memberName := range memberNames {
+		memberType := v.MemberTypes[memberName]
+		if memberType == "" { // fix order issue
+			memberType = memberName
+		}
+		if memberType = gen.genCPPType(memberType); !seen[memberType] {
+			seen[memberType] = true
+			memberTypes = append(memberTypes, memberType)
+		}
+	}
+	return fmt.Sprintf("std::variant<%s>", strings.Join(memberTypes, ", "))
+}
+
+// genCPPDependency generates the class definition with the name before the
+// class which refers to it, and reports whether the class is defined. The
+// class is not defined yet if it's being generated, which means the
+// reference to the class is recursive.
+func (gen *CodeGenerator) genCPPDependency(name string) bool {
+	if source, ok := gen.StructAST[name]; ok {
+		return source != ""
+	}
+	for _, ele := range gen.ProtoTree {
+		switch v := ele.(type) {
+		case *ComplexType:
+			if v.Name == name {
+				gen.CPPComplexType(v)
+				return true
+			}
+		case *Group:
+			if v.Name == name {
+				gen.CPPGroup(v)
+				return true
+			}
+		case *AttributeGroup:
+			if v.Name == name {
+				gen.CPPAttributeGroup(v)
+				return true
+			}
+		}
+	}
+	return true
+}
+
+// genCPPMember generates the declaration of the data member, the members
+// which refer to the class being defined are declared as std::shared_ptr
+// since the type is incomplete.
+func (gen *CodeGenerator) genCPPMember(member cppMember) string {
+	fieldType := gen.genCPPType(member.Type)
+	defined := true
+	if !isCPPBuildInType(fieldType) {
+		defined = gen.genCPPDependency(trimNSPrefix(gen.getBasefromSimpleType(trimNSPrefix(member.Type))))
+	}
+	switch {
+	case member.Plural:
+		fieldType = fmt.Sprintf("std::vector<%s>", fieldType)
+	case !defined:
+		fieldType = fmt.Sprintf("std::shared_ptr<%s>", fieldType)
+	case member.Optional:
+		fieldType = fmt.Sprintf("std::optional<%s>", fieldType)
+	}
+	return fmt.Sprintf("\t%s %s;\n", fieldType, genCPPMemberName(member.Name))
+}
+
+// genCPPClass generates the class definition by given name, base class and
+// data members. The members are generated before the class is recorded in
+// the StructAST, so the classes they refer to are defined first.
+func (gen *CodeGenerator) genCPPClass(name, className, base string, members []cppMember) string {
+	gen.StructAST[name] = ""
+	var content string
+	for _, member := range members {
+		content += gen.genCPPMember(member)
+	}
+	declaration := "class " + className
+	if base != "" {
+		declaration += " : public " + base
+	}
+	if content == "" {
+		return fmt.Sprintf("\n%s {};\n", declaration)
+	}
+	return fmt.Sprintf("\n%s {\npublic:\n%s};\n", declaration, content)
+}
+
+// CPPSimpleType generates code for simple type XML schema in C++ language
+// syntax.
+func (gen *CodeGenerator) CPPSimpleType(v *SimpleType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	if len(v.Restriction.Enum) > 0 && !v.List && !v.Union {
+		typeName := genCPPClassName(v.Name)
+		gen.StructAST[v.Name] = genCPPEnumerators(v.Restriction.Enum)
+		gen.Field += withDerivationComment(fmt.Sprintf("\nenum class %s {\n%s};\n\nstd::string_view toString(%s value);\nstd::optional<%s> parse%s(std::string_view value);\n", typeName, gen.StructAST[v.Name], typeName, typeName, typeName), "", v.Final)
+		return
+	}
+	fieldType := gen.genCPPType(v.Name)
+	if !v.List && !(v.Union && len(v.MemberTypes) > 0) {
+		fieldType = gen.genCPPType(v.Base)
+	}
+	gen.StructAST[v.Name] = fmt.Sprintf(" = %s;\n", fieldType)
+	gen.Field += withDerivationComment(fmt.Sprintf("\nusing %s%s", genCPPClassName(v.Name), gen.StructAST[v.Name]), "", v.Final)
+	return
+}
+
+// CPPComplexType generates code for complex type XML schema in C++ language
+// syntax. The complex type derived by extension from a complex type is
+// declared as the derived class of it.
+func (gen *CodeGenerator) CPPComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var base string
+	if baseName := trimNSPrefix(v.Base); baseName != "" && v.Extension && gen.isComplexType(baseName) {
+		gen.StructAST[v.Name] = ""
+		if gen.genCPPDependency(baseName) {
+			base = genCPPClassName(baseName)
+		}
+	}
+	var members []cppMember
+	for _, attrGroup := range v.AttributeGroup {
+		members = append(members, cppMember{Name: attrGroup.Name, Type: attrGroup.Ref})
+	}
+
+	for _, attribute := range v.Attributes {
+		members = append(members, cppMember{Name: attribute.Name + "Attr", Type: attribute.Type, Plural: attribute.Plural, Optional: attribute.Optional})
+	}
+
+	for _, group := range v.Groups {
+		members = append(members, cppMember{Name: group.Name, Type: group.Ref, Plural: group.Plural})
+	}
+
+	for _, element := range v.Elements {
+		members = append(members, cppMember{Name: element.Name, Type: element.Type, Plural: element.Plural, Optional: element.Optional})
+	}
+	if v.Mixed {
+		members = append(members, cppMember{Name: "value", Type: "std::string"})
+	} else if v.Base != "" && !gen.isComplexType(trimNSPrefix(v.Base)) {
+		members = append(members, cppMember{Name: "value", Type: v.Base})
+	}
+	source := gen.genCPPClass(v.Name, genCPPClassName(v.Name), base, members)
+	gen.StructAST[v.Name] = source
+	gen.Field += withDerivationComment(source, v.Block, v.Final)
+	return
+}
+
+// CPPGroup generates code for group XML schema in C++ language syntax.
+func (gen *CodeGenerator) CPPGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var members []cppMember
+	for _, element := range v.Elements {
+		members = append(members, cppMember{Name: element.Name, Type: element.Type, Plural: element.Plural, Optional: element.Optional})
+	}
+
+	for _, group := range v.Groups {
+		members = append(members, cppMember{Name: group.Name, Type: group.Ref, Plural: group.Plural})
+	}
+	gen.StructAST[v.Name] = gen.genCPPClass(v.Name, genCPPClassName(v.Name), "", members)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
+
+// CPPAttributeGroup generates code for attribute group XML schema in C++
+// language syntax.
+func (gen *CodeGenerator) CPPAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var members []cppMember
+	for _, attribute := range v.Attributes {
+		members = append(members, cppMember{Name: attribute.Name + "Attr", Type: attribute.Type, Plural: attribute.Plural, Optional: attribute.Optional})
+	}
+	gen.StructAST[v.Name] = gen.genCPPClass(v.Name, genCPPClassName(v.Name), "", members)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
+
+// CPPElement generates code for element XML schema in C++ language syntax.
+func (gen *CodeGenerator) CPPElement(v *Element) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	fieldType := gen.genCPPType(v.Type)
+	if v.Plural {
+		fieldType = fmt.Sprintf("std::vector<%s>", fieldType)
+	}
+	gen.StructAST[v.Name] = fmt.Sprintf(" = %s;\n", fieldType)
+	gen.Field += withDerivationComment(fmt.Sprintf("\nusing %s%s", genCPPClassName(v.Name), gen.StructAST[v.Name]), v.Block, v.Final)
+	return
+}
+
+// CPPAttribute generates code for attribute XML schema in C++ language
+// syntax.
+func (gen *CodeGenerator) CPPAttribute(v *Attribute) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	fieldType := gen.genCPPType(v.Type)
+	if v.Plural {
+		fieldType = fmt.Sprintf("std::vector<%s>", fieldType)
+	}
+	gen.StructAST[v.Name] = fmt.Sprintf(" = %s;\n", fieldType)
+	gen.Field += fmt.Sprintf("\nusing %s%s", genCPPClassName(v.Name), gen.StructAST[v.Name])
+	return
+}
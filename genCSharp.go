@@ -0,0 +1,540 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var csharpBuildInType = map[string]bool{
+	"bool":     true,
+	"byte":     true,
+	"byte[]":   true,
+	"DateTime": true,
+	"decimal":  true,
+	"double":   true,
+	"float":    true,
+	"int":      true,
+	"long":     true,
+	"object":   true,
+	"sbyte":    true,
+	"short":    true,
+	"string":   true,
+	"string[]": true,
+	"uint":     true,
+	"ulong":    true,
+	"ushort":   true,
+}
+
+// csharpValueType defines the build-in value types, the optional members of
+// them are paired with the Specified properties, since XmlSerializer can't
+// omit them otherwise.
+var csharpValueType = map[string]bool{
+	"bool":     true,
+	"byte":     true,
+	"DateTime": true,
+	"decimal":  true,
+	"double":   true,
+	"float":    true,
+	"int":      true,
+	"long":     true,
+	"sbyte":    true,
+	"short":    true,
+	"uint":     true,
+	"ulong":    true,
+	"ushort":   true,
+}
+
+// csharpXmlConvert defines the methods of XmlConvert which parse the items
+// of the lists by the build-in types, the items of other types are kept as
+// the strings.
+var csharpXmlConvert = map[string]string{
+	"bool":    "ToBoolean",
+	"byte":    "ToByte",
+	"decimal": "ToDecimal",
+	"double":  "ToDouble",
+	"float":   "ToSingle",
+	"int":     "ToInt32",
+	"long":    "ToInt64",
+	"sbyte":   "ToSByte",
+	"short":   "ToInt16",
+	"uint":    "ToUInt32",
+	"ulong":   "ToUInt64",
+	"ushort":  "ToUInt16",
+}
+
+// csharpProperty defines a property of the generated class and the
+// attribute which controls its XML serialization. The properties with the
+// accessors are declared with them instead of the auto-implemented ones.
+type csharpProperty struct {
+	Attribute string
+	Type      string
+	Name      string
+	Accessors string
+	Specified bool
+}
+
+// GenCSharp generate C# programming language source code for XML schema
+// definition files. Complex types are declared as the classes with the
+// properties annotated by the System.Xml.Serialization attributes, so they
+// can be serialized by XmlSerializer, and simple types with enumerations are
+// declared as the enums. The global elements are declared as the classes
+// with the XmlRoot attribute. All declarations are placed in the namespace
+// named after the package.
+func (gen *CodeGenerator) GenCSharp() error {
+	gen.genProtoTree("CSharp")
+	packageName := gen.Package
+	if packageName == "" {
+		packageName = "schema"
+	}
+	return gen.writeSource(".cs", genCSharpFieldName, func(path, field string) ([]byte, error) {
+		var usings string
+		if strings.Contains(field, "DateTime") || strings.Contains(field, "StringSplitOptions") {
+			usings += "using System;\n"
+		}
+		if strings.Contains(field, "List<") {
+			usings += "using System.Collections.Generic;\n"
+		}
+		if strings.Contains(field, "StringSplitOptions") {
+			usings += "using System.Linq;\n"
+		}
+		if strings.Contains(field, "XmlConvert.") {
+			usings += "using System.Xml;\n"
+		}
+		if strings.Contains(field, "XmlSchemaForm.") {
+			usings += "using System.Xml.Schema;\n"
+		}
+		usings += "using System.Xml.Serialization;\n"
+		lines := strings.Split(strings.TrimPrefix(field, "\n"), "\n")
+		for i, line := range lines {
+			if line != "" {
+				lines[i] = "\t" + line
+			}
+		}
+		return []byte(fmt.Sprintf("%s\n\n%s\nnamespace %s\n{\n%s}\n", copyright, usings, genCSharpNamespace(packageName), strings.Join(lines, "\n"))), nil
+	})
+}
+
+func genCSharpFieldName(name string) (fieldName string) {
+	for _, str := range strings.Split(name, ":") {
+		fieldName += MakeFirstUpperCase(str)
+	}
+	var tmp string
+	for _, str := range strings.Split(fieldName, ".") {
+		tmp += MakeFirstUpperCase(str)
+	}
+	fieldName = tmp
+	fieldName = strings.Replace(strings.Replace(fieldName, "-", "", -1), "_", "", -1)
+	return
+}
+
+// genCSharpNamespace generates the namespace by given package name, each
+// segment of the package separated by slashes or dots is upper-cased.
+func genCSharpNamespace(packageName string) string {
+	var segments []string
+	for _, segment := range strings.FieldsFunc(packageName, func(r rune) bool { return r == '/' || r == '.' }) {
+		if segment = genCSharpFieldName(segment); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+// genCSharpFieldType returns the type and the XML schema data type of it by
+// given type name, the build-in types which are serialized as an XML schema
+// data type other than the default one are declared as the type and the
+// data type separated by a slash, such as "DateTime/date".
+func genCSharpFieldType(name string) (fieldType, dataType string) {
+	if i := strings.Index(name, "/"); i != -1 {
+		name, dataType = name[:i], name[i+1:]
+	}
+	if _, ok := csharpBuildInType[name]; ok {
+		return name, dataType
+	}
+	if fieldType = genCSharpFieldName(name); fieldType != "" {
+		return fieldType, dataType
+	}
+	return "string", dataType
+}
+
+// genCSharpEnumName generates the enum member by given enumeration value,
+// characters which are not allowed in the identifier will be removed.
+func genCSharpEnumName(value string) string {
+	var enumName string
+	for _, str := range strings.FieldsFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		enumName += MakeFirstUpperCase(str)
+	}
+	if enumName == "" || enumName[0] >= '0' && enumName[0] <= '9' {
+		return "Value" + enumName
+	}
+	return enumName
+}
+
+// genCSharpType returns the type of the definition by given type name, the
+// enumerations are declared as the C# enums.
+func (gen *CodeGenerator) genCSharpType(name string) string {
+	return gen.getEnumOrBaseType(name)
+}
+
+// genCSharpAttributeArgs generates the arguments of the serialization
+// attribute by given name in the XML document, the namespace of it and the
+// XML schema data type.
+func genCSharpAttributeArgs(name, ns, dataType string) string {
+	args := []string{fmt.Sprintf("%q", name)}
+	if ns != "" {
+		args = append(args, fmt.Sprintf("Namespace = %q", ns))
+	}
+	if dataType != "" {
+		args = append(args, fmt.Sprintf("DataType = %q", dataType))
+	}
+	return strings.Join(args, ", ")
+}
+
+// genCSharpListItem returns the type of the items by given name of the list
+// simple type, the items which can't be parsed by XmlConvert are declared as
+// the strings.
+func (gen *CodeGenerator) genCSharpListItem(name string) (itemType string, ok bool) {
+	for _, ele := range gen.ProtoTree {
+		if v, isSimpleType := ele.(*SimpleType); isSimpleType && v.List && v.Name == trimNSPrefix(name) {
+			if itemType, _ = genCSharpFieldType(gen.genCSharpType(v.Base)); csharpXmlConvert[itemType] == "" || isEnumSimpleType(trimNSPrefix(v.Base), gen.ProtoTree) {
+				itemType = "string"
+			}
+			return itemType, true
+		}
+	}
+	return "", false
+}
+
+// genCSharpListProperties returns the properties of the list simple type by
+// given serialization attribute, property name and item type. XmlSerializer
+// can't split the text nodes into the items, so the items are ignored by it
+// and serialized by the property suffixed with Text, which joins the items
+// with the spaces. The optional lists are omitted when they are empty.
+func genCSharpListProperties(attribute, name, itemType string, optional bool) []csharpProperty {
+	join, parse := fmt.Sprintf("string.Join(\" \", %s)", name), ""
+	if method := csharpXmlConvert[itemType]; method != "" {
+		join = fmt.Sprintf("string.Join(\" \", %s.Select(item => XmlConvert.ToString(item)))", name)
+		parse = fmt.Sprintf(".Select(XmlConvert.%s)", method)
+	}
+	if optional {
+		join = fmt.Sprintf("%s.Count == 0 ? null : %s", name, join)
+	}
+	return []csharpProperty{
+		{Attribute: "[XmlIgnore]", Type: fmt.Sprintf("List<%s>", itemType), Name: name},
+		{Attribute: attribute, Type: "string", Name: name + "Text", Accessors: fmt.Sprintf("\n{\n\tget => %s;\n\tset => %s = value.Split(new[] { ' ', '\\t', '\\r', '\\n' }, StringSplitOptions.RemoveEmptyEntries)%s.ToList();\n}", join, name, parse)},
+	}
+}
+
+// genCSharpElementProperty returns the properties of the class by given
+// local element declaration. The unqualified elements of the types in a
+// namespace are declared with the unqualified form, since XmlSerializer
+// qualifies them by the namespace of the type by default.
+func (gen *CodeGenerator) genCSharpElementProperty(element Element) []csharpProperty {
+	fieldType, dataType := genCSharpFieldType(gen.genCSharpType(element.Type))
+	args := genCSharpAttributeArgs(trimNSPrefix(element.Name), element.Namespace, dataType)
+	if element.Namespace == "" && gen.Namespace != "" {
+		args += ", Form = XmlSchemaForm.Unqualified"
+	}
+	if element.Nillable {
+		args += ", IsNullable = true"
+	}
+	itemType, list := gen.genCSharpListItem(element.Type)
+	if list && !element.Plural {
+		return genCSharpListProperties(fmt.Sprintf("[XmlElement(%s)]", args), genCSharpFieldName(element.Name), itemType, element.Optional)
+	}
+	if list {
+		fieldType = "string"
+	}
+	property := csharpProperty{Attribute: fmt.Sprintf("[XmlElement(%s)]", args), Type: fieldType, Name: genCSharpFieldName(element.Name)}
+	if element.Plural {
+		property.Type = fmt.Sprintf("List<%s>", fieldType)
+		return []csharpProperty{property}
+	}
+	property.Specified = element.Optional && (csharpValueType[fieldType] || isEnumSimpleType(trimNSPrefix(element.Type), gen.ProtoTree))
+	return []csharpProperty{property}
+}
+
+// genCSharpAttributeProperty returns the properties of the class by given
+// attribute declaration, the attributes in the xml namespace, such as
+// xml:lang, are declared with the namespace.
+func (gen *CodeGenerator) genCSharpAttributeProperty(attribute Attribute) []csharpProperty {
+	fieldType, dataType := genCSharpFieldType(gen.genCSharpType(attribute.Type))
+	ns := attribute.Namespace
+	if getNSPrefix(attribute.Name) == "xml" {
+		ns = xmlNamespace
+	}
+	if itemType, ok := gen.genCSharpListItem(attribute.Type); ok {
+		return genCSharpListProperties(fmt.Sprintf("[XmlAttribute(%s)]", genCSharpAttributeArgs(trimNSPrefix(attribute.Name), ns, "")), genCSharpFieldName(attribute.Name)+"Attr", itemType, attribute.Optional)
+	}
+	if attribute.Plural && !strings.HasSuffix(fieldType, "[]") {
+		fieldType += "[]"
+	}
+	return []csharpProperty{{
+		Attribute: fmt.Sprintf("[XmlAttribute(%s)]", genCSharpAttributeArgs(trimNSPrefix(attribute.Name), ns, dataType)),
+		Type:      fieldType,
+		Name:      genCSharpFieldName(attribute.Name) + "Attr",
+		Specified: attribute.Optional && (csharpValueType[fieldType] || isEnumSimpleType(trimNSPrefix(attribute.Type), gen.ProtoTree)),
+	}}
+}
+
+// genCSharpClass generates the class declaration by given name, base class,
+// serialization attributes and properties. The properties named after the
+// class are suffixed with Property, since the members can't have the same
+// name as their enclosing type. The list properties are initialized with
+// empty lists.
+func genCSharpClass(name, base, attributes string, properties []csharpProperty) string {
+	declaration := "public class " + name
+	if base != "" {
+		declaration += " : " + base
+	}
+	var members []string
+	for _, property := range properties {
+		if property.Name == name {
+			property.Name += "Property"
+		}
+		accessors := " { get; set; }"
+		if property.Accessors != "" {
+			accessors = property.Accessors
+		}
+		member := fmt.Sprintf("%s\npublic %s %s%s", property.Attribute, property.Type, property.Name, accessors)
+		if strings.HasPrefix(property.Type, "List<") {
+			member += fmt.Sprintf(" = new %s();", property.Type)
+		}
+		if property.Specified {
+			member += fmt.Sprintf("\n\n[XmlIgnore]\npublic bool %sSpecified { get; set; }", property.Name)
+		}
+		members = append(members, "\t"+strings.Replace(member, "\n", "\n\t", -1)+"\n")
+	}
+	return fmt.Sprintf("\n%s%s\n{\n%s}\n", attributes, declaration, strings.Replace(strings.Join(members, "\n"), "\n\t\n", "\n\n", -1))
+}
+
+// genCSharpTypeAttributes generates the serialization attributes of the
+// class by given name of the type in the XML schema, the class of a complex
+// type which has the same name as a global element is also the root element
+// of it.
+func (gen *CodeGenerator) genCSharpTypeAttributes(name string, anonymous bool) string {
+	var attributes string
+	for _, ele := range gen.ProtoTree {
+		if element, ok := ele.(*Element); ok && element.Name == name && trimNSPrefix(element.Type) == name {
+			attributes += fmt.Sprintf("[XmlRoot(%s)]\n", genCSharpAttributeArgs(name, gen.Namespace, ""))
+			break
+		}
+	}
+	if anonymous {
+		if gen.Namespace == "" {
+			return attributes + "[XmlType(AnonymousType = true)]\n"
+		}
+		return attributes + fmt.Sprintf("[XmlType(AnonymousType = true, Namespace = %q)]\n", gen.Namespace)
+	}
+	return attributes + fmt.Sprintf("[XmlType(%s)]\n", genCSharpAttributeArgs(name, gen.Namespace, ""))
+}
+
+// CSharpSimpleType generates code for simple type XML schema in C# language
+// syntax. The union is declared as the class with a property for each of its
+// member types, the list is declared by the members which reference it as
+// the list of its items, and other simple types are replaced by their base
+// types.
+func (gen *CodeGenerator) CSharpSimpleType(v *SimpleType) {
+	if _, ok := gen.StructAST[v.Name]; ok || v.List {
+		return
+	}
+	if v.Union && len(v.MemberTypes) > 0 {
+		var memberNames []string
+		for memberName := range v.MemberTypes {
+			memberNames = append(memberNames, memberName)
+		}
+		sort.Strings(memberNames)
+		var properties []csharpProperty
+		for _, memberName := range memberNames {
+			memberType := v.MemberTypes[memberName]
+			if memberType == "" { // fix order issue
+				memberType = gen.getBasefromSimpleType(memberName)
+			}
+			fieldType, dataType := genCSharpFieldType(memberType)
+			properties = append(properties, csharpProperty{Attribute: fmt.Sprintf("[XmlElement(%s)]", genCSharpAttributeArgs(memberName, "", dataType)), Type: fieldType, Name: genCSharpFieldName(memberName)})
+		}
+		gen.StructAST[v.Name] = genCSharpClass(genCSharpFieldName(v.Name), "", "", properties)
+		gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+		return
+	}
+	if len(v.Restriction.Enum) > 0 {
+		var members []string
+		names := map[string]int{}
+		for _, enum := range v.Restriction.Enum {
+			enumName := genCSharpEnumName(enum)
+			if names[enumName]++; names[enumName] > 1 {
+				enumName = fmt.Sprintf("%s%d", enumName, names[enumName])
+			}
+			members = append(members, fmt.Sprintf("\t[XmlEnum(%q)]\n\t%s,\n", enum, enumName))
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf("\n[XmlType(%s)]\npublic enum %s\n{\n%s}\n", genCSharpAttributeArgs(v.Name, gen.Namespace, ""), genCSharpFieldName(v.Name), strings.Join(members, ""))
+		gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+	}
+	return
+}
+
+// CSharpComplexType generates code for complex type XML schema in C#
+// language syntax. The complex type derived by extension from a complex type
+// is declared as the subclass of it, and the abstract complex type is
+// declared as the abstract class including its subclasses. The mixed content
+// is declared as the text nodes, and the simple content is declared as the
+// Value property.
+func (gen *CodeGenerator) CSharpComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var base string
+	baseName := trimNSPrefix(v.Base)
+	if baseName != "" && v.Extension && gen.isComplexType(baseName) {
+		base = genCSharpFieldName(baseName)
+	}
+	var properties []csharpProperty
+	for _, attrGroup := range v.AttributeGroup {
+		fieldType, _ := genCSharpFieldType(gen.getBasefromSimpleType(trimNSPrefix(attrGroup.Ref)))
+		properties = append(properties, csharpProperty{Attribute: "[XmlElement]", Type: fieldType, Name: genCSharpFieldName(attrGroup.Name)})
+	}
+
+	for _, attribute := range v.Attributes {
+		if !attribute.Prohibited {
+			properties = append(properties, gen.genCSharpAttributeProperty(attribute)...)
+		}
+	}
+
+	for _, group := range v.Groups {
+		fieldType, _ := genCSharpFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref)))
+		if group.Plural {
+			fieldType = fmt.Sprintf("List<%s>", fieldType)
+		}
+		properties = append(properties, csharpProperty{Attribute: "[XmlElement]", Type: fieldType, Name: genCSharpFieldName(group.Name)})
+	}
+
+	for _, element := range v.Elements {
+		if !element.Wildcard {
+			properties = append(properties, gen.genCSharpElementProperty(element)...)
+		}
+	}
+	if v.Mixed {
+		properties = append(properties, csharpProperty{Attribute: "[XmlText]", Type: "string[]", Name: "Text"})
+	} else if itemType, ok := gen.genCSharpListItem(baseName); ok {
+		properties = append(properties, genCSharpListProperties("[XmlText]", "Value", itemType, false)...)
+	} else if baseName != "" && !gen.isComplexType(baseName) {
+		fieldType, dataType := genCSharpFieldType(gen.genCSharpType(baseName))
+		attribute := "[XmlText]"
+		if dataType != "" {
+			attribute = fmt.Sprintf("[XmlText(DataType = %q)]", dataType)
+		}
+		properties = append(properties, csharpProperty{Attribute: attribute, Type: fieldType, Name: "Value"})
+	}
+	attributes := gen.genCSharpTypeAttributes(v.Name, v.Anonymous)
+	if v.Abstract {
+		for _, subclass := range gen.genCSharpSubclasses(v.Name) {
+			attributes += fmt.Sprintf("[XmlInclude(typeof(%s))]\n", subclass)
+		}
+	}
+	gen.StructAST[v.Name] = genCSharpClass(genCSharpFieldName(v.Name), base, attributes, properties)
+	if v.Abstract {
+		gen.StructAST[v.Name] = strings.Replace(gen.StructAST[v.Name], "public class ", "public abstract class ", 1)
+	}
+	gen.Field += withDerivationComment(gen.StructAST[v.Name], v.Block, v.Final)
+	return
+}
+
+// genCSharpSubclasses returns the classes of the complex types derived by
+// extension from the complex type directly or indirectly, which are included
+// by the abstract class, so that the serializer selects them by the xsi:type
+// attribute.
+func (gen *CodeGenerator) genCSharpSubclasses(name string) (subclasses []string) {
+	for _, ele := range gen.ProtoTree {
+		if v, ok := ele.(*ComplexType); ok && v.Extension && trimNSPrefix(v.Base) == name && v.Name != name {
+			subclasses = append(subclasses, genCSharpFieldName(v.Name))
+			subclasses = append(subclasses, gen.genCSharpSubclasses(v.Name)...)
+		}
+	}
+	return
+}
+
+// CSharpGroup generates code for group XML schema in C# language syntax.
+func (gen *CodeGenerator) CSharpGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var properties []csharpProperty
+	for _, element := range v.Elements {
+		if !element.Wildcard {
+			properties = append(properties, gen.genCSharpElementProperty(element)...)
+		}
+	}
+
+	for _, group := range v.Groups {
+		fieldType, _ := genCSharpFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref)))
+		if group.Plural {
+			fieldType = fmt.Sprintf("List<%s>", fieldType)
+		}
+		properties = append(properties, csharpProperty{Attribute: "[XmlElement]", Type: fieldType, Name: genCSharpFieldName(group.Name)})
+	}
+	gen.StructAST[v.Name] = genCSharpClass(genCSharpFieldName(v.Name), "", "", properties)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
+
+// CSharpAttributeGroup generates code for attribute group XML schema in C#
+// language syntax.
+func (gen *CodeGenerator) CSharpAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var properties []csharpProperty
+	for _, attribute := range v.Attributes {
+		if !attribute.Prohibited {
+			properties = append(properties, gen.genCSharpAttributeProperty(attribute)...)
+		}
+	}
+	gen.StructAST[v.Name] = genCSharpClass(genCSharpFieldName(v.Name), "", "", properties)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
+
+// CSharpElement generates code for element XML schema in C# language syntax.
+// The element of a complex type is declared as the subclass of the type with
+// the XmlRoot attribute, and the element of a simple type is declared as the
+// class with the value as the text node. The element which has the same name
+// as its complex type is declared by the class of the type.
+func (gen *CodeGenerator) CSharpElement(v *Element) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	typeName := trimNSPrefix(v.Type)
+	if typeName == v.Name && gen.isComplexType(typeName) {
+		gen.StructAST[v.Name] = ""
+		return
+	}
+	className := genCSharpFieldName(v.Name)
+	if gen.isComplexType(v.Name) || isEnumSimpleType(v.Name, gen.ProtoTree) {
+		className += "Element"
+	}
+	attributes := fmt.Sprintf("[XmlRoot(%s)]\n", genCSharpAttributeArgs(trimNSPrefix(v.Name), gen.Namespace, ""))
+	if gen.isComplexType(typeName) && !v.Plural {
+		gen.StructAST[v.Name] = genCSharpClass(className, genCSharpFieldName(typeName), attributes, nil)
+	} else if itemType, ok := gen.genCSharpListItem(v.Type); ok && !v.Plural {
+		gen.StructAST[v.Name] = genCSharpClass(className, "", attributes, genCSharpListProperties("[XmlText]", "Value", itemType, false))
+	} else {
+		fieldType, dataType := genCSharpFieldType(gen.genCSharpType(v.Type))
+		attribute := "[XmlText]"
+		if dataType != "" {
+			attribute = fmt.Sprintf("[XmlText(DataType = %q)]", dataType)
+		}
+		gen.StructAST[v.Name] = genCSharpClass(className, "", attributes, []csharpProperty{{Attribute: attribute, Type: fieldType, Name: "Value"}})
+	}
+	gen.Field += withDerivationComment(gen.StructAST[v.Name], v.Block, v.Final)
+	return
+}
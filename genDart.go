@@ -0,0 +1,564 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"strings"
+)
+
+var dartBuildInType = map[string]bool{
+	"bool":         true,
+	"double":       true,
+	"dynamic":      true,
+	"int":          true,
+	"num":          true,
+	"DateTime":     true,
+	"Duration":     true,
+	"String":       true,
+	"List<int>":    true,
+	"List<String>": true,
+}
+
+// dartField defines a property of the generated Dart class. The Node is one
+// of attribute, element, group and text, which specifies how the property is
+// read from and written to the XML element of the class, and the Base is the
+// type of the values of the property in the BuildInTypes. The Item is the
+// type of the items when the values are the lists separated by whitespace,
+// and the ItemEnum reports whether the items are the enum values.
+type dartField struct {
+	Name     string
+	Type     string
+	Optional bool
+	Plural   bool
+	Node     string
+	XMLName  string
+	Base     string
+	Item     string
+	ItemEnum bool
+	Wildcard bool
+}
+
+// dartHexCodec defines the functions to decode and encode the values of the
+// hexBinary type, which are declared in the file using them.
+var dartHexCodec = `
+List<int> _hexDecode(String value) => [
+	for (var i = 0; i + 1 < value.length; i += 2)
+		int.parse(value.substring(i, i + 2), radix: 16),
+];
+
+String _hexEncode(List<int> value) =>
+	value.map((b) => b.toRadixString(16).padLeft(2, '0')).join();
+`
+
+// dartDurationCodec defines the functions to decode and encode the values of
+// the duration type, which are declared in the file using them. Since the
+// Duration has no calendar fields, the years and months are counted as 365
+// and 30 days, and the values are encoded in days and time.
+var dartDurationCodec = `
+Duration _durationDecode(String value) {
+	final match = RegExp(r'^(-)?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$').firstMatch(value.trim());
+	if (match == null) throw FormatException('invalid duration', value);
+	int part(int i) => int.parse(match.group(i) ?? '0');
+	final duration = Duration(
+		days: part(2) * 365 + part(3) * 30 + part(4),
+		hours: part(5),
+		minutes: part(6),
+		microseconds: (double.parse(match.group(7) ?? '0') * Duration.microsecondsPerSecond).round(),
+	);
+	return match.group(1) == null ? duration : -duration;
+}
+
+String _durationEncode(Duration value) {
+	final microseconds = value.inMicroseconds.abs();
+	final time = Duration(microseconds: microseconds % Duration.microsecondsPerDay);
+	final seconds = time.inMicroseconds % Duration.microsecondsPerMinute / Duration.microsecondsPerSecond;
+	return '${value.isNegative ? '-' : ''}P${microseconds ~/ Duration.microsecondsPerDay}DT${time.inHours}H${time.inMinutes % 60}M${seconds}S';
+}
+`
+
+// GenDart generate Dart programming language source code for XML schema
+// definition files. The classes provide the fromXml and toXml helpers to read
+// and write the XML elements of the xml package, so the code requires Dart 3
+// or later.
+func (gen *CodeGenerator) GenDart() error {
+	gen.genProtoTree("Dart")
+	return gen.writeSource(".dart", genDartFieldName, func(path, field string) ([]byte, error) {
+		var imports string
+		if strings.Contains(field, "base64") {
+			imports += "import 'dart:convert';\n"
+		}
+		if strings.Contains(field, "XmlElement") {
+			imports += "import 'package:xml/xml.dart';\n"
+		}
+		if imports != "" {
+			imports = "\n" + imports
+		}
+		if strings.Contains(field, "_hexDecode(") || strings.Contains(field, "_hexEncode(") {
+			field += dartHexCodec
+		}
+		if strings.Contains(field, "_durationDecode(") || strings.Contains(field, "_durationEncode(") {
+			field += dartDurationCodec
+		}
+		return []byte(fmt.Sprintf("%s\n%s%s", copyright, imports, field)), nil
+	})
+}
+
+func genDartFieldName(name string) (fieldName string) {
+	for _, str := range strings.Split(name, ":") {
+		fieldName += MakeFirstUpperCase(str)
+	}
+	var tmp string
+	for _, str := range strings.Split(fieldName, ".") {
+		tmp += MakeFirstUpperCase(str)
+	}
+	fieldName = tmp
+	fieldName = strings.Replace(strings.Replace(fieldName, "-", "", -1), "_", "", -1)
+	return
+}
+
+// genDartPropertyName generates the lower camel case property name by given
+// name.
+func genDartPropertyName(name string) string {
+	fieldName := genDartFieldName(name)
+	if fieldName == "" {
+		return fieldName
+	}
+	return strings.ToLower(fieldName[:1]) + fieldName[1:]
+}
+
+func genDartFieldType(name string) string {
+	name = strings.SplitN(name, "/", 2)[0]
+	if _, ok := dartBuildInType[name]; ok {
+		return name
+	}
+	var fieldType string
+	for _, str := range strings.Split(name, ".") {
+		fieldType += MakeFirstUpperCase(str)
+	}
+	fieldType = strings.Replace(MakeFirstUpperCase(strings.Replace(fieldType, "-", "", -1)), "_", "", -1)
+	if fieldType != "" {
+		return fieldType
+	}
+	return "dynamic"
+}
+
+// genDartEnumName generates the enum value name by given enumeration value,
+// characters which are not allowed in the identifier will be removed.
+func genDartEnumName(value string) string {
+	var enumName string
+	for _, str := range strings.FieldsFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		enumName += MakeFirstUpperCase(str)
+	}
+	if enumName == "" || enumName[0] >= '0' && enumName[0] <= '9' {
+		return "value" + enumName
+	}
+	return strings.ToLower(enumName[:1]) + enumName[1:]
+}
+
+// genDartString generates the single quoted Dart string literal by given
+// value.
+func genDartString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`, `$`, `\$`).Replace(value) + "'"
+}
+
+// genDartCodec returns the expressions to parse the value of the type from
+// the string and format it to the string by given type in the BuildInTypes,
+// the value is the placeholder of the verb in the expressions. The types
+// which are not built-in are the classes read from the XML elements.
+func genDartCodec(name string) (parse, format string, builtIn bool) {
+	switch name {
+	case "String", "dynamic":
+		return "%s", "%s", true
+	case "int", "double", "num":
+		return name + ".parse(%s)", "%s", true
+	case "bool":
+		return "const {'true', '1'}.contains(%s)", "%s", true
+	case "DateTime":
+		return "DateTime.parse(%s)", "%s.toIso8601String()", true
+	case "DateTime/date":
+		return "DateTime.parse(%s)", "%s.toIso8601String().substring(0, 10)", true
+	case "Duration":
+		return "_durationDecode(%s)", "_durationEncode(%s)", true
+	case "List<String>":
+		return "%s.trim().split(RegExp(r'\\s+'))", "%s.join(' ')", true
+	case "List<int>":
+		return "base64Decode(%s)", "base64Encode(%s)", true
+	case "List<int>/hexBinary":
+		return "_hexDecode(%s)", "_hexEncode(%s)", true
+	}
+	return "%s", "%s", false
+}
+
+// genDartFieldCodec returns the expressions to parse and format the value of
+// the property like genDartCodec. The lists separated by whitespace are split
+// into the items parsed by the codec of the item type, and the items are
+// joined with the spaces.
+func genDartFieldCodec(field dartField) (parse, format string, builtIn bool) {
+	if field.Item == "" {
+		return genDartCodec(field.Base)
+	}
+	itemParse, itemFormat, _ := genDartCodec(field.Item)
+	if field.ItemEnum {
+		itemParse, itemFormat = genDartFieldType(field.Item)+".values.firstWhere((e) => e.value == %s)", "%s.value"
+	}
+	parse, format = "%s.split(RegExp(r'\\s+')).where((s) => s.isNotEmpty)", "%s"
+	if itemParse != "%s" {
+		parse += fmt.Sprintf(".map((s) => %s)", fmt.Sprintf(itemParse, "s"))
+	}
+	if itemFormat != "%s" {
+		format += fmt.Sprintf(".map((e) => %s)", fmt.Sprintf(itemFormat, "e"))
+	}
+	return parse + ".toList()", format + ".join(' ')", true
+}
+
+// genDartListItem returns the type of the items in the BuildInTypes by given
+// name of the list simple type, and whether the items are the enum values.
+// The items of other types which are not built-in are kept as the strings.
+func (gen *CodeGenerator) genDartListItem(name string) (item string, enum, ok bool) {
+	for _, ele := range gen.ProtoTree {
+		if v, isSimpleType := ele.(*SimpleType); isSimpleType && v.List && v.Name == trimNSPrefix(name) {
+			if item = trimNSPrefix(v.Base); isEnumSimpleType(item, gen.ProtoTree) {
+				return item, true, true
+			}
+			if item = gen.getBasefromSimpleType(item); !dartBuildInType[strings.SplitN(item, "/", 2)[0]] {
+				item = "String"
+			}
+			return item, false, true
+		}
+	}
+	return "", false, false
+}
+
+// genDartFromXml generates the argument of the constructor by given property,
+// which reads the value of the property from the element.
+func genDartFromXml(field dartField, siblings []string) string {
+	parse, _, builtIn := genDartFieldCodec(field)
+	fieldType := genDartFieldType(field.Base)
+	var value string
+	switch {
+	case field.Node == "attribute":
+		value = fmt.Sprintf("element.getAttribute(%s)", genDartString(field.XMLName))
+	case field.Node == "text":
+		value = "element.innerText"
+	case field.Node == "group":
+		value = fmt.Sprintf("%s.fromXml(element)", fieldType)
+		if field.Plural {
+			value = "[" + value + "]"
+		}
+		return value
+	case field.Wildcard:
+		value = "element.childElements"
+		if len(siblings) > 0 {
+			value = fmt.Sprintf("%s.where((e) => !const {%s}.contains(e.name.local))", value, strings.Join(siblings, ", "))
+		}
+		if field.Plural {
+			return value + ".toList()"
+		}
+		if field.Optional {
+			return value + ".firstOrNull"
+		}
+		return value + ".first"
+	case field.Plural:
+		if builtIn {
+			return fmt.Sprintf("element.findElements(%s).map((e) => %s).toList()", genDartString(field.XMLName), fmt.Sprintf(parse, "e.innerText"))
+		}
+		return fmt.Sprintf("element.findElements(%s).map(%s.fromXml).toList()", genDartString(field.XMLName), fieldType)
+	case builtIn:
+		value = fmt.Sprintf("element.getElement(%s)", genDartString(field.XMLName))
+		if field.Optional {
+			value += "?.innerText"
+		} else {
+			value += "!.innerText"
+		}
+	default:
+		value = fmt.Sprintf("element.getElement(%s)", genDartString(field.XMLName))
+		if field.Optional {
+			return fmt.Sprintf("switch (%s) { final e? => %s.fromXml(e), _ => null }", value, fieldType)
+		}
+		return fmt.Sprintf("%s.fromXml(%s!)", fieldType, value)
+	}
+	if parse == "%s" {
+		if field.Node == "attribute" && !field.Optional {
+			value += "!"
+		}
+		return value
+	}
+	if field.Optional {
+		return fmt.Sprintf("switch (%s) { final v? => %s, _ => null }", value, fmt.Sprintf(parse, "v"))
+	}
+	if field.Node == "attribute" {
+		value += "!"
+	}
+	return fmt.Sprintf(parse, value)
+}
+
+// genDartBuildXml generates the statement by given property, which writes
+// the value of the property to the element with the builder.
+func genDartBuildXml(field dartField) string {
+	_, format, builtIn := genDartFieldCodec(field)
+	value, statement := field.Name, ""
+	if field.Optional && !field.Plural {
+		value += "!"
+	}
+	switch {
+	case field.Node == "attribute":
+		statement = fmt.Sprintf("builder.attribute(%s, %s);", genDartString(field.XMLName), fmt.Sprintf(format, value))
+	case field.Node == "text":
+		statement = fmt.Sprintf("builder.text(%s);", fmt.Sprintf(format, value))
+	case field.Plural:
+		var item string
+		switch {
+		case field.Node == "group":
+			item = "e.buildXml(builder);"
+		case field.Wildcard:
+			item = "builder.xml(e.toXmlString());"
+		case builtIn:
+			item = fmt.Sprintf("builder.element(%s, nest: %s);", genDartString(field.XMLName), fmt.Sprintf(format, "e"))
+		default:
+			item = fmt.Sprintf("builder.element(%s, nest: () => e.buildXml(builder));", genDartString(field.XMLName))
+		}
+		if field.Optional {
+			value += " ?? const []"
+		}
+		return fmt.Sprintf("\t\tfor (final e in %s) %s\n", value, item)
+	case field.Node == "group":
+		statement = fmt.Sprintf("%s.buildXml(builder);", value)
+	case field.Wildcard:
+		statement = fmt.Sprintf("builder.xml(%s.toXmlString());", value)
+	case builtIn:
+		statement = fmt.Sprintf("builder.element(%s, nest: %s);", genDartString(field.XMLName), fmt.Sprintf(format, value))
+	default:
+		statement = fmt.Sprintf("builder.element(%s, nest: () => %s.buildXml(builder));", genDartString(field.XMLName), value)
+	}
+	if field.Optional {
+		return fmt.Sprintf("\t\tif (%s != null) %s\n", field.Name, statement)
+	}
+	return fmt.Sprintf("\t\t%s\n", statement)
+}
+
+// genDartClass generates the class declaration with properties and the
+// constructor which accepts named parameters for each property, parameters
+// of the non-nullable properties are required. The class declared for the
+// XML element content provides the fromXml factory constructor to read it
+// from the element, the buildXml method to write the properties with the
+// builder which is also used by the classes referencing it, and the toXml
+// method to create the element with given name.
+func genDartClass(name string, fields []dartField, xml bool) string {
+	if len(fields) == 0 && !xml {
+		return fmt.Sprintf("\nclass %s {}\n", name)
+	}
+	var content, constructor string
+	var params []string
+	for _, field := range fields {
+		if field.Optional {
+			content += fmt.Sprintf("\t%s? %s;\n", field.Type, field.Name)
+			params = append(params, fmt.Sprintf("this.%s", field.Name))
+			continue
+		}
+		content += fmt.Sprintf("\t%s %s;\n", field.Type, field.Name)
+		params = append(params, fmt.Sprintf("required this.%s", field.Name))
+	}
+	if constructor = fmt.Sprintf("\t%s();\n", name); len(params) > 0 {
+		constructor = fmt.Sprintf("\t%s({%s});\n", name, strings.Join(params, ", "))
+	}
+	if !xml {
+		return fmt.Sprintf("\nclass %s {\n%s\n%s}\n", name, content, constructor)
+	}
+	var siblings []string
+	for _, field := range fields {
+		if field.Node == "element" && !field.Wildcard {
+			siblings = append(siblings, genDartString(field.XMLName))
+		}
+	}
+	fromXml := fmt.Sprintf("\tfactory %s.fromXml(XmlElement element) => %s();\n", name, name)
+	var buildXml string
+	if len(fields) > 0 {
+		var args string
+		for _, field := range fields {
+			args += fmt.Sprintf("\t\t%s: %s,\n", field.Name, genDartFromXml(field, siblings))
+			buildXml += genDartBuildXml(field)
+		}
+		fromXml = fmt.Sprintf("\tfactory %s.fromXml(XmlElement element) => %s(\n%s\t);\n", name, name, args)
+	}
+	methods := fmt.Sprintf("\n%s\n\tvoid buildXml(XmlBuilder builder) {\n%s\t}\n\n\tXmlElement toXml(String name) {\n\t\tfinal builder = XmlBuilder();\n\t\tbuilder.element(name, nest: () => buildXml(builder));\n\t\treturn builder.buildDocument().rootElement;\n\t}\n", fromXml, buildXml)
+	if content != "" {
+		content += "\n"
+	}
+	return fmt.Sprintf("\nclass %s {\n%s%s%s}\n", name, content, constructor, methods)
+}
+
+// genDartElementField returns the property by given element.
+func (gen *CodeGenerator) genDartElementField(element Element) dartField {
+	base := gen.getBasefromSimpleType(trimNSPrefix(element.Type))
+	if element.Wildcard {
+		base = "XmlElement"
+	}
+	fieldType := genDartFieldType(base)
+	if element.Plural {
+		fieldType = fmt.Sprintf("List<%s>", fieldType)
+	}
+	item, enum, _ := gen.genDartListItem(element.Type)
+	return dartField{Name: genDartPropertyName(element.Name), Type: fieldType, Optional: element.Optional, Plural: element.Plural, Node: "element", XMLName: element.Name, Base: base, Item: item, ItemEnum: enum, Wildcard: element.Wildcard}
+}
+
+// genDartAttributeField returns the property by given attribute.
+func (gen *CodeGenerator) genDartAttributeField(attribute Attribute) dartField {
+	base := gen.getBasefromSimpleType(trimNSPrefix(attribute.Type))
+	item, enum, _ := gen.genDartListItem(attribute.Type)
+	return dartField{Name: genDartPropertyName(attribute.Name) + "Attr", Type: genDartFieldType(base), Optional: attribute.Optional, Node: "attribute", XMLName: attribute.Name, Base: base, Item: item, ItemEnum: enum}
+}
+
+// genDartGroupField returns the property by given reference of the group or
+// attribute group, which is read from and written to the same element.
+func (gen *CodeGenerator) genDartGroupField(name, ref string, plural bool) dartField {
+	base := gen.getBasefromSimpleType(trimNSPrefix(ref))
+	fieldType := genDartFieldType(base)
+	if plural {
+		fieldType = fmt.Sprintf("List<%s>", fieldType)
+	}
+	return dartField{Name: genDartPropertyName(name), Type: fieldType, Plural: plural, Node: "group", Base: base}
+}
+
+// DartSimpleType generates code for simple type XML schema in Dart language
+// syntax.
+func (gen *CodeGenerator) DartSimpleType(v *SimpleType) {
+	if v.List {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			item, _, _ := gen.genDartListItem(v.Name)
+			content := fmt.Sprintf(" = List<%s>;\n", genDartFieldType(item))
+			gen.StructAST[v.Name] = content
+			gen.Field += withDerivationComment(fmt.Sprintf("\ntypedef %s%s", genDartFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final)
+			return
+		}
+	}
+	if v.Union && len(v.MemberTypes) > 0 {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			var fields []dartField
+			for _, memberName := range v.Members {
+				memberType := v.MemberTypes[memberName]
+				if memberType == "" { // fix order issue
+					memberType = gen.getBasefromSimpleType(memberName)
+				}
+				fields = append(fields, dartField{Name: genDartPropertyName(memberName), Type: genDartFieldType(memberType), Optional: true})
+			}
+			gen.StructAST[v.Name] = genDartClass(genDartFieldName(v.Name), fields, false)
+			gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+		}
+		return
+	}
+	if len(v.Restriction.Enum) > 0 {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			fieldName := genDartFieldName(v.Name)
+			var values []string
+			for _, enum := range v.Restriction.Enum {
+				values = append(values, fmt.Sprintf("\t%s(%s)", genDartEnumName(enum), genDartString(enum)))
+			}
+			gen.StructAST[v.Name] = fmt.Sprintf("\nenum %s {\n%s;\n\n\tconst %s(this.value);\n\tfinal String value;\n}\n", fieldName, strings.Join(values, ",\n"), fieldName)
+			gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+		}
+		return
+	}
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		content := fmt.Sprintf(" = %s;\n", genDartFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base))))
+		gen.StructAST[v.Name] = content
+		gen.Field += withDerivationComment(fmt.Sprintf("\ntypedef %s%s", genDartFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final)
+	}
+	return
+}
+
+// DartComplexType generates code for complex type XML schema in Dart
+// language syntax.
+func (gen *CodeGenerator) DartComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var fields []dartField
+		for _, attrGroup := range v.AttributeGroup {
+			fields = append(fields, gen.genDartGroupField(attrGroup.Name, attrGroup.Ref, false))
+		}
+
+		for _, attribute := range v.Attributes {
+			fields = append(fields, gen.genDartAttributeField(attribute))
+		}
+		for _, group := range v.Groups {
+			fields = append(fields, gen.genDartGroupField(group.Name, group.Ref, group.Plural))
+		}
+
+		for _, element := range v.Elements {
+			fields = append(fields, gen.genDartElementField(element))
+		}
+		if base := trimNSPrefix(v.Base); base != "" && !v.Mixed && !gen.isComplexType(base) {
+			item, enum, _ := gen.genDartListItem(base)
+			base = gen.getBasefromSimpleType(base)
+			fields = append(fields, dartField{Name: "value", Type: genDartFieldType(base), Node: "text", Base: base, Item: item, ItemEnum: enum})
+		}
+		gen.StructAST[v.Name] = genDartClass(genDartFieldName(v.Name), fields, true)
+		gen.Field += withDerivationComment(gen.StructAST[v.Name], v.Block, v.Final)
+	}
+	return
+}
+
+// DartGroup generates code for group XML schema in Dart language syntax.
+func (gen *CodeGenerator) DartGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var fields []dartField
+		for _, element := range v.Elements {
+			fields = append(fields, gen.genDartElementField(element))
+		}
+
+		for _, group := range v.Groups {
+			fields = append(fields, gen.genDartGroupField(group.Name, group.Ref, group.Plural))
+		}
+		gen.StructAST[v.Name] = genDartClass(genDartFieldName(v.Name), fields, true)
+		gen.Field += gen.StructAST[v.Name]
+	}
+	return
+}
+
+// DartAttributeGroup generates code for attribute group XML schema in Dart
+// language syntax.
+func (gen *CodeGenerator) DartAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var fields []dartField
+		for _, attribute := range v.Attributes {
+			fields = append(fields, gen.genDartAttributeField(attribute))
+		}
+		gen.StructAST[v.Name] = genDartClass(genDartFieldName(v.Name), fields, true)
+		gen.Field += gen.StructAST[v.Name]
+	}
+	return
+}
+
+// DartElement generates code for element XML schema in Dart language syntax.
+func (gen *CodeGenerator) DartElement(v *Element) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		fieldType := genDartFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Type)))
+		if v.Plural {
+			fieldType = fmt.Sprintf("List<%s>", fieldType)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf(" = %s;\n", fieldType)
+		gen.Field += withDerivationComment(fmt.Sprintf("\ntypedef %s%s", genDartFieldName(v.Name), gen.StructAST[v.Name]), v.Block, v.Final)
+	}
+	return
+}
+
+// DartAttribute generates code for attribute XML schema in Dart language
+// syntax.
+func (gen *CodeGenerator) DartAttribute(v *Attribute) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		fieldType := genDartFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Type)))
+		if v.Plural {
+			fieldType = fmt.Sprintf("List<%s>", fieldType)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf(" = %s;\n", fieldType)
+		gen.Field += fmt.Sprintf("\ntypedef %s%s", genDartFieldName(v.Name), gen.StructAST[v.Name])
+	}
+	return
+}
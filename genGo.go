@@ -10,23 +10,67 @@ package xgen
 
 import (
 	"fmt"
-	"go/format"
-	"os"
-	"reflect"
+	"io"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
+	"unicode"
 )
 
 // CodeGenerator holds code generator overrides and runtime data that are used
 // when generate code from proto tree.
 type CodeGenerator struct {
-	Lang              string
-	File              string
-	Field             string
-	Package           string
-	ImportTime        bool // For Go language
-	ImportEncodingXML bool // For Go language
-	ProtoTree         []interface{}
-	StructAST         map[string]string
+	Lang               string
+	Output             io.Writer
+	File               string
+	FileLayout         string
+	Namespace          string
+	Field              string
+	Package            string
+	Indent             string
+	Formatters         map[string]func([]byte) ([]byte, error)
+	ImportTime         bool              // For Go language
+	ImportEncodingXML  bool              // For Go language
+	ImportStrings      bool              // For Go language
+	ImportFmt          bool              // For Go language
+	ImportRegexp       bool              // For Go language
+	ImportUTF8         bool              // For Go language
+	TimeLayout         map[string]string // For Go language
+	TypeScriptEnum     bool              // For TypeScript language
+	JavaAccessors      bool              // For Java language
+	RoundTripTests     bool              // For Go language
+	GoGenerics         bool              // For Go language
+	GoValidate         bool              // For Go language
+	GoConstructors     bool              // For Go language
+	GoDocument         bool              // For Go language
+	GoDocumentEncoding string            // For Go language
+	GoOptionalPointers bool              // For Go language
+	DryRun             bool
+	Manifest           map[string][]string
+	TypeNamePrefix     string
+	TypeNameSuffix     string
+	ProtoTree          []interface{}
+	StructAST          map[string]string
+	Decls              []Decl
+
+	types    typeIndex
+	xmlNames map[string]string
+
+	// typePackages maps the names of the types in the other namespaces to
+	// the import paths of their Go packages, which is set if the
+	// PackagePerNamespace option is set.
+	typePackages map[string]string
+
+	// declared maps the names of the declarations written into each output
+	// directory to the files of the schemas generating them.
+	declared map[string]string
+
+	// namespaceFiles maps the paths of the files written by the
+	// FileLayoutPerNamespace layout to the declarations of the schemas in
+	// the namespaces.
+	namespaceFiles map[string]*namespaceFile
 }
 
 var goBuildinType = map[string]bool{
@@ -45,10 +89,20 @@ var goBuildinType = map[string]bool{
 	"int32":         true,
 	"int64":         true,
 	"interface":     true,
+	"interface{}":   true,
 	"[]interface{}": true,
 	"string":        true,
 	"[]string":      true,
 	"time.Time":     true,
+	"XSDDate":       true,
+	"XSDDateTime":   true,
+	"XSDDuration":   true,
+	"XSDGDay":       true,
+	"XSDGMonth":     true,
+	"XSDGMonthDay":  true,
+	"XSDGYear":      true,
+	"XSDGYearMonth": true,
+	"XSDTime":       true,
 	"uint":          true,
 	"uint8":         true,
 	"uint16":        true,
@@ -59,39 +113,54 @@ var goBuildinType = map[string]bool{
 // GenGo generate Go programming language source code for XML schema
 // definition files.
 func (gen *CodeGenerator) GenGo() error {
-	for _, ele := range gen.ProtoTree {
-		if ele == nil {
-			continue
-		}
-		funcName := fmt.Sprintf("Go%s", reflect.TypeOf(ele).String()[6:])
-		callFuncByName(gen, funcName, []reflect.Value{reflect.ValueOf(ele)})
-	}
-	f, err := os.Create(gen.File + ".go")
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-	var importPackage, packages string
-	if gen.ImportTime {
-		packages += "\t\"time\"\n"
-	}
-	if gen.ImportEncodingXML {
-		packages += "\t\"encoding/xml\"\n"
-	}
-	if packages != "" {
-		importPackage = fmt.Sprintf("import (\n%s)", packages)
-	}
+	gen.genProtoTree("Go")
+	gen.genGoValidateMethods()
+	gen.genGoIdentityMethods()
+	gen.genGoChoiceMethods()
+	gen.genGoConstructors()
+	gen.genGoDocument()
+	gen.genGoPolymorphicTypes()
+	gen.genGoSubstitutionTypes()
+	gen.genGoNillableTypes()
+	gen.genGoXSDTimeTypes()
+	gen.genGoXSDDurationType()
+	gen.genGoXSDAnyType()
+	gen.genGoListType()
 	packageName := gen.Package
 	if packageName == "" {
 		packageName = "schema"
 	}
-	source, err := format.Source([]byte(fmt.Sprintf("%s\n\npackage %s\n%s%s", copyright, packageName, importPackage, gen.Field)))
-	if err != nil {
-		f.WriteString(fmt.Sprintf("package %s\n%s%s", packageName, importPackage, gen.Field))
-		return err
+	if gen.RoundTripTests && gen.Output == nil {
+		if err := gen.genGoRoundTripTests(packageName); err != nil {
+			return err
+		}
 	}
-	f.Write(source)
-	return err
+	return gen.writeSource(".go", genGoFieldName, func(path, field string) ([]byte, error) {
+		var importPackage, packages string
+		if gen.ImportTime && strings.Contains(field, "time.") {
+			packages += "\t\"time\"\n"
+		}
+		if gen.ImportEncodingXML && strings.Contains(field, "xml.") {
+			packages += "\t\"encoding/xml\"\n"
+		}
+		if gen.ImportStrings && strings.Contains(field, "strings.") {
+			packages += "\t\"strings\"\n"
+		}
+		if gen.ImportFmt && strings.Contains(field, "fmt.") {
+			packages += "\t\"fmt\"\n"
+		}
+		if gen.ImportRegexp && strings.Contains(field, "regexp.") {
+			packages += "\t\"regexp\"\n"
+		}
+		if gen.ImportUTF8 && strings.Contains(field, "utf8.") {
+			packages += "\t\"unicode/utf8\"\n"
+		}
+		packages += gen.genGoPackageImports(field)
+		if packages != "" {
+			importPackage = fmt.Sprintf("import (\n%s)", packages)
+		}
+		return []byte(fmt.Sprintf("%s\n\npackage %s\n%s%s", copyright, packageName, importPackage, field)), nil
+	})
 }
 
 func genGoFieldName(name string) (fieldName string) {
@@ -104,11 +173,82 @@ func genGoFieldName(name string) (fieldName string) {
 	}
 	fieldName = tmp
 	fieldName = strings.Replace(strings.Replace(fieldName, "-", "", -1), "_", "", -1)
+	return genGoExportedName(fieldName)
+}
+
+// genGoExportedName returns the valid exported identifier by given name, the
+// characters which can't be used in the identifiers are removed, and the
+// first letter is upper-cased, so the Go keywords are never produced. The
+// names which don't start with an upper case letter after that, such as the
+// empty names and the names starting with digits, are prefixed with X. The
+// original names are kept in the struct tags.
+func genGoExportedName(name string) string {
+	var exported []rune
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			exported = append(exported, r)
+		}
+	}
+	if len(exported) > 0 {
+		exported[0] = unicode.ToUpper(exported[0])
+	}
+	if len(exported) == 0 || !unicode.IsUpper(exported[0]) {
+		exported = append([]rune{'X'}, exported...)
+	}
+	return string(exported)
+}
+
+// genGoAttributeName returns the field name and the name in the struct tag of
+// the attribute. Attributes in the XML namespace, such as xml:lang, are
+// qualified with the namespace name and named without the Attr suffix.
+func genGoAttributeName(name string) (fieldName, tagName string) {
+	if getNSPrefix(name) == "xml" {
+		return genGoFieldName(trimNSPrefix(name)), xmlNamespace + " " + trimNSPrefix(name)
+	}
+	return genGoFieldName(name) + "Attr", name
+}
+
+// genGoAttributeTag returns the name in the struct tag of the field for the
+// attribute, which is qualified by the namespace of the attribute unless it
+// is unqualified.
+func genGoAttributeTag(attribute Attribute) string {
+	_, tagName := genGoAttributeName(attribute.Name)
+	if attribute.Namespace == "" || getNSPrefix(attribute.Name) == "xml" {
+		return tagName
+	}
+	return attribute.Namespace + " " + trimNSPrefix(attribute.Name)
+}
+
+// genGoElementTag returns the name in the struct tag of the field for the
+// element, which is qualified by the namespace of the element unless it is
+// unqualified.
+func genGoElementTag(element Element) string {
+	if element.Namespace == "" {
+		return element.Name
+	}
+	return element.Namespace + " " + trimNSPrefix(element.Name)
+}
+
+// genGoHintTags returns the struct tags given by the tag hints of the
+// declaration in the xgen namespace, which follow the xml tag of the field,
+// for example <xgen:tag>json:"id"</xgen:tag> in the appinfo.
+func genGoHintTags(hints []Hint) (tags string) {
+	for _, hint := range hints {
+		if hint.Space == xgenNamespace && hint.Name == "tag" && hint.Value != "" {
+			tags += " " + hint.Value
+		}
+	}
 	return
 }
 
-func genGoFieldComment(name string) string {
-	return fmt.Sprintf("\r\n// %s ...\r\n", name)
+// genGoFieldComment generates the comment of the type declaration by given
+// name, the documentation of the schema component follows the name in its
+// own paragraph.
+func genGoFieldComment(name, doc string) string {
+	if doc == "" {
+		return fmt.Sprintf("\r\n// %s ...\r\n", name)
+	}
+	return fmt.Sprintf("\r\n// %s ...\r\n//\n%s", name, genDocComment(doc, "// "))
 }
 
 func genGoFieldType(name string) string {
@@ -121,11 +261,1016 @@ func genGoFieldType(name string) string {
 	}
 	fieldType = strings.Replace(MakeFirstUpperCase(strings.Replace(fieldType, "-", "", -1)), "_", "", -1)
 	if fieldType != "" {
-		return "*" + fieldType
+		return "*" + genGoExportedName(fieldType)
 	}
 	return "interface{}"
 }
 
+// goXSDTimeTypes defines the Go types of the date and time data types in XML
+// schema and the default layout used to marshal and unmarshal the values.
+// The values in the layouts without the timezone may have the timezone as
+// well.
+var goXSDTimeTypes = []struct {
+	Name, XSDType, Layout string
+}{
+	{"XSDDateTime", "dateTime", "2006-01-02T15:04:05Z07:00"},
+	{"XSDDate", "date", "2006-01-02"},
+	{"XSDTime", "time", "15:04:05"},
+	{"XSDGYearMonth", "gYearMonth", "2006-01"},
+	{"XSDGYear", "gYear", "2006"},
+	{"XSDGMonthDay", "gMonthDay", "--01-02"},
+	{"XSDGMonth", "gMonth", "--01"},
+	{"XSDGDay", "gDay", "---02"},
+}
+
+// goXSDTimezoneLayout is the layout of the optional timezone of the date and
+// time data types in XML schema, which is either Z or the offset from UTC.
+const goXSDTimezoneLayout = "Z07:00"
+
+var goXSDTimeTypeTemplate = `
+// %[1]s is the %[2]s data type in XML schema, the value is marshaled
+// with the layout %[3]q, and unmarshaled with or without the timezone,
+// which is UTC if it's absent.
+type %[1]s time.Time
+
+// MarshalText encodes the %[1]s value into the lexical representation.
+func (t %[1]s) MarshalText() ([]byte, error) {
+%[5]s	return []byte(time.Time(t).Format(%[3]q)), nil
+}
+
+// UnmarshalText decodes the lexical representation into the %[1]s value.
+func (t *%[1]s) UnmarshalText(text []byte) error {
+	value := strings.TrimSpace(string(text))
+	if value == "" {
+		*t = %[1]s{}
+		return nil
+	}
+	v, err := time.Parse(%[3]q, value)
+	if err != nil {
+		// the timezone is optional in the lexical representation.
+		var zoneErr error
+		if v, zoneErr = time.Parse(%[4]q, value); zoneErr != nil {
+			return err
+		}
+	}
+	*t = %[1]s(v)
+	return nil
+}
+`
+
+var goPolymorphicTypeTemplate = `
+// %[1]sInterface is implemented by %[1]s and the types derived from it.
+type %[1]sInterface interface {
+	is%[1]s()
+}
+%[2]s` + goPolymorphicRegistryTemplate + `
+// %[1]sElement holds the element declared with the %[1]s type, the concrete
+// type of the value is selected by the xsi:type attribute of the element.
+type %[1]sElement struct {
+	Value %[1]sInterface
+}
+
+// UnmarshalXML decodes the element into the type selected by the xsi:type
+// attribute, or %[1]s if the attribute is absent or names a type which isn't
+// registered.
+func (e *%[1]sElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value %[1]sInterface = &%[1]s{}
+	name := %[3]q
+	if newValue, typeName := new%[1]sType(start); newValue != nil {
+		value, name = newValue, typeName
+	}
+	start.Name.Local = name
+` + goPolymorphicDecodeTemplate
+
+var goAbstractTypeTemplate = `
+// %[1]sInterface is implemented by the types derived from the abstract %[1]s
+// type, which is only embedded by them.
+type %[1]sInterface interface {
+	is%[1]s()
+}
+%[2]s` + goPolymorphicRegistryTemplate + `
+// %[1]sElement holds the element declared with the abstract %[1]s type, the
+// concrete type of the value is selected by the xsi:type attribute of the
+// element.
+type %[1]sElement struct {
+	Value %[1]sInterface
+}
+
+// UnmarshalXML decodes the element into the type selected by the xsi:type
+// attribute, an error is returned if the attribute is absent or doesn't name
+// a registered concrete type derived from %[1]s.
+func (e *%[1]sElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	value, name := new%[1]sType(start)
+	if value == nil {
+		return fmt.Errorf("%%s: the concrete type of the abstract type %[3]s must be selected by xsi:type", start.Name.Local)
+	}
+	start.Name.Local = name
+` + goPolymorphicDecodeTemplate
+
+var goPolymorphicRegistryTemplate = `
+// %[1]sTypes maps the qualified names of the types which may be selected by
+// the xsi:type attribute of the %[1]sElement to the functions creating their
+// values, the types derived from %[3]s in other schemas may be registered to
+// it as well.
+var %[1]sTypes = map[xml.Name]func() %[1]sInterface{
+%[4]s}
+
+// new%[1]sType returns the value of the registered type selected by the
+// xsi:type attribute of the element and the local name of the type. The
+// prefix of the type name is resolved by the namespace declarations of the
+// element, or the type is looked up in the target namespace of the schema
+// and then by the local name if they don't declare it, since the
+// declarations of the ancestors aren't known.
+func new%[1]sType(start xml.StartElement) (%[1]sInterface, string) {
+	for _, attr := range start.Attr {
+		if attr.Name.Space != "http://www.w3.org/2001/XMLSchema-instance" || attr.Name.Local != "type" {
+			continue
+		}
+		prefix, local := "", strings.TrimSpace(attr.Value)
+		if i := strings.Index(local, ":"); i >= 0 {
+			prefix, local = local[:i], local[i+1:]
+		}
+		for _, ns := range start.Attr {
+			if ns.Name.Space == "xmlns" && ns.Name.Local == prefix || prefix == "" && ns.Name.Space == "" && ns.Name.Local == "xmlns" {
+				if newValue, ok := %[1]sTypes[xml.Name{Space: ns.Value, Local: local}]; ok {
+					return newValue(), local
+				}
+			}
+		}
+		if newValue, ok := %[1]sTypes[xml.Name{Space: %[6]q, Local: local}]; ok {
+			return newValue(), local
+		}
+		for name, newValue := range %[1]sTypes {
+			if name.Local == local {
+				return newValue(), local
+			}
+		}
+	}
+	return nil, ""
+}
+`
+
+var goPolymorphicDecodeTemplate = `	if err := d.DecodeElement(value, &start); err != nil {
+		return err
+	}
+	e.Value = value
+	return nil
+}
+
+// MarshalXML encodes the value of the element, the xsi:type attribute will
+// be added for the derived types, whose namespace is declared with the xt
+// prefix.
+func (e %[1]sElement) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	if e.Value == nil {
+		return nil
+	}
+	var typeName xml.Name
+	switch e.Value.(type) {
+%[5]s	}
+	if typeName.Local != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: "http://www.w3.org/2001/XMLSchema-instance"})
+		if typeName.Space != "" {
+			start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:xt"}, Value: typeName.Space})
+			typeName.Local = "xt:" + typeName.Local
+		}
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xsi:type"}, Value: typeName.Local})
+	}
+	return enc.EncodeElement(e.Value, start)
+}
+`
+
+// genGoPolymorphicTypes generates an interface for each complex type which
+// has derived types, which is implemented by the types in the hierarchy, and
+// the element type which selects the concrete type by the xsi:type attribute
+// when unmarshaling. The concrete types are registered by their qualified
+// names in the target namespace, which the types in other schemas may be
+// added to. The abstract types don't implement the interfaces, so that only
+// the concrete types can be held by the elements.
+func (gen *CodeGenerator) genGoPolymorphicTypes() {
+	derivedTypes := getDerivedTypes(gen.ProtoTree)
+	abstractTypes := map[string]bool{}
+	for _, ele := range gen.ProtoTree {
+		if complexType, ok := ele.(*ComplexType); ok && complexType.Abstract {
+			abstractTypes[complexType.Name] = true
+		}
+	}
+	for _, ele := range gen.ProtoTree {
+		complexType, ok := ele.(*ComplexType)
+		if !ok || len(derivedTypes[complexType.Name]) == 0 {
+			continue
+		}
+		fieldName := genGoFieldName(complexType.Name)
+		template, methods := goPolymorphicTypeTemplate, fmt.Sprintf("\nfunc (*%s) is%s() {}\n", fieldName, fieldName)
+		if complexType.Abstract {
+			template, methods = goAbstractTypeTemplate, "\n"
+			gen.ImportFmt = true
+		}
+		var registry, marshalCases string
+		if !complexType.Abstract {
+			registry += fmt.Sprintf("\t{Space: %q, Local: %q}: func() %sInterface { return &%s{} },\n", gen.Namespace, complexType.Name, fieldName, fieldName)
+		}
+		for _, derivedType := range derivedTypes[complexType.Name] {
+			if abstractTypes[derivedType] {
+				continue
+			}
+			derivedName := genGoFieldName(derivedType)
+			methods += fmt.Sprintf("func (*%s) is%s() {}\n", derivedName, fieldName)
+			registry += fmt.Sprintf("\t{Space: %q, Local: %q}: func() %sInterface { return &%s{} },\n", gen.Namespace, derivedType, fieldName, derivedName)
+			marshalCases += fmt.Sprintf("\tcase *%s:\n\t\ttypeName = xml.Name{Space: %q, Local: %q}\n", derivedName, gen.Namespace, derivedType)
+		}
+		start := len(gen.Field)
+		gen.Field += fmt.Sprintf(template, fieldName, methods, complexType.Name, registry, marshalCases, gen.Namespace)
+		gen.Decls = append(gen.Decls, Decl{Name: fieldName + "Element", Source: gen.Field[start:]})
+		gen.ImportEncodingXML = true
+		gen.ImportStrings = true
+	}
+}
+
+// isGoValidatedAttribute reports whether the use of the attribute is checked
+// by the Validate method of the complex type, which is generated if the
+// GoValidate of the code generator is set. The fields of the required,
+// prohibited and fixed attributes are declared as pointers, so an absent
+// attribute is distinguished from the one with an empty or zero value.
+func (gen *CodeGenerator) isGoValidatedAttribute(attribute Attribute) bool {
+	return gen.GoValidate && (!attribute.Optional || attribute.Prohibited || attribute.Fixed)
+}
+
+// goValidatedChild reports whether the child element is validated by the
+// Validate method of the parent, and whether the element is declared with a
+// polymorphic type, which is validated if the concrete type of the value has
+// the Validate method. The elements of the generic lists and the types in
+// the other packages are not validated.
+func (gen *CodeGenerator) goValidatedChild(element Element, validated map[string]bool) (polymorphic, ok bool) {
+	typeName := trimNSPrefix(element.Type)
+	if element.Wildcard {
+		return
+	}
+	if _, ok = gen.goListItem(element.Type); ok {
+		return false, false
+	}
+	if _, ok = gen.typePackages[typeName]; ok {
+		return false, false
+	}
+	if head, members := gen.substitutionGroup(element); head != nil {
+		for _, member := range members {
+			ok = ok || validated[trimNSPrefix(member.Type)]
+		}
+		return true, ok
+	}
+	if derivedTypes, ok := getDerivedTypes(gen.ProtoTree)[typeName]; ok {
+		for _, derivedType := range derivedTypes {
+			if validated[derivedType] {
+				return true, true
+			}
+		}
+		return true, validated[typeName]
+	}
+	return false, validated[typeName]
+}
+
+// goValidatedTypes returns the names of the complex types which have the
+// Validate method, that are the types with required, prohibited or fixed
+// attributes, fixed elements, choices, assertions or values restricted by
+// facets, and the types which embed or contain the elements of them.
+func (gen *CodeGenerator) goValidatedTypes() map[string]bool {
+	validated := map[string]bool{}
+	for changed := true; changed; {
+		changed = false
+		for _, ele := range gen.ProtoTree {
+			v, ok := ele.(*ComplexType)
+			if !ok || validated[v.Name] {
+				continue
+			}
+			if _, ok := gen.goListItem(v.Name); ok {
+				continue
+			}
+			for _, attribute := range v.Attributes {
+				validated[v.Name] = validated[v.Name] || gen.isGoValidatedAttribute(attribute) || gen.goFacetType(attribute.Type) != nil
+			}
+			if _, qualified := gen.typePackages[trimNSPrefix(v.Base)]; v.Extension && !qualified {
+				validated[v.Name] = validated[v.Name] || validated[trimNSPrefix(v.Base)]
+			}
+			for _, element := range v.Elements {
+				_, ok := gen.goValidatedChild(element, validated)
+				validated[v.Name] = validated[v.Name] || ok || !element.Wildcard && (gen.goFacetType(element.Type) != nil || element.Fixed && !element.Plural)
+			}
+			if !v.Mixed && !gen.isComplexType(trimNSPrefix(v.Base)) {
+				validated[v.Name] = validated[v.Name] || gen.goFacetType(v.Base) != nil
+			}
+			validated[v.Name] = validated[v.Name] || len(v.Choices) > 0 || len(v.Assertions) > 0
+			changed = changed || validated[v.Name]
+		}
+	}
+	return validated
+}
+
+// genGoValidateMethods generates the Validate method for the complex types
+// if the GoValidate of the code generator is set. The method checks the use
+// of the attributes, the choices and the facets of the simple types, which
+// isn't enforced by the XML decoder, an error is returned if a required
+// attribute is absent, a prohibited attribute is present, a present
+// attribute or element differs from its fixed value, more than one element
+// of a choice is present, or a value violates the facets of its type in the
+// element or its descendants. The XML schema 1.1 assertions are checked by
+// the hooks set by the users.
+func (gen *CodeGenerator) genGoValidateMethods() {
+	if !gen.GoValidate {
+		return
+	}
+	validated := gen.goValidatedTypes()
+	names := map[string]bool{}
+	for _, ele := range gen.ProtoTree {
+		v, ok := ele.(*ComplexType)
+		if !ok || !validated[v.Name] || names[v.Name] {
+			continue
+		}
+		names[v.Name] = true
+		var content string
+		if base := trimNSPrefix(v.Base); v.Extension && validated[base] {
+			content += fmt.Sprintf("\tif err := v.%s.Validate(); err != nil {\n\t\treturn err\n\t}\n", genGoFieldName(base))
+		}
+		for _, attribute := range v.Attributes {
+			fieldName, _ := genGoAttributeName(attribute.Name)
+			if gen.isGoValidatedAttribute(attribute) && attribute.Prohibited {
+				content += fmt.Sprintf("\tif v.%s != nil {\n\t\treturn fmt.Errorf(%q)\n\t}\n", fieldName, fmt.Sprintf("%s: prohibited attribute %s is present", v.Name, attribute.Name))
+			} else if gen.isGoValidatedAttribute(attribute) && !attribute.Optional {
+				content += fmt.Sprintf("\tif v.%s == nil {\n\t\treturn fmt.Errorf(%q)\n\t}\n", fieldName, fmt.Sprintf("%s: required attribute %s is absent", v.Name, attribute.Name))
+			}
+			if attribute.Fixed && !attribute.Prohibited {
+				content += gen.genGoFixedCheck(v.Name+": attribute "+attribute.Name, fieldName, gen.genGoType(attribute.Type), attribute.Type, attribute.Default, true)
+			}
+			if !attribute.Prohibited {
+				content += gen.genGoFacetCheck(fieldName, attribute.Type, false, strings.HasPrefix(gen.genGoAttributeType(attribute), "*"))
+			}
+		}
+		derivedTypes := getDerivedTypes(gen.ProtoTree)
+		for _, element := range v.Elements {
+			if !element.Wildcard {
+				fieldType, pointer := gen.genGoOptionalElementType(v, element, derivedTypes)
+				if nillable := strings.TrimPrefix(fieldType, "*"); nillable == goNillableType(gen.genGoType(element.Type)) {
+					// checks the value of the element unless it's nil.
+					if check := gen.genGoFacetCheck(genGoFieldName(element.Name)+".Value", element.Type, false, false); check != "" {
+						content += fmt.Sprintf("\tif v.%[1]s != nil && !v.%[1]s.Nil {\n\t%[2]s\t}\n", genGoFieldName(element.Name), strings.Replace(check, "\n\t", "\n\t\t", -1))
+					}
+					continue
+				}
+				if element.Fixed && !element.Plural {
+					content += gen.genGoFixedCheck(v.Name+": element "+element.Name, genGoFieldName(element.Name), strings.TrimPrefix(fieldType, "*"), element.Type, element.Default, pointer)
+				}
+				content += gen.genGoFacetCheck(genGoFieldName(element.Name), element.Type, element.Plural, pointer)
+			}
+			polymorphic, ok := gen.goValidatedChild(element, validated)
+			if !ok {
+				continue
+			}
+			value, check := "v."+genGoFieldName(element.Name), "\tif err := %s.Validate(); err != nil {\n\t\treturn err\n\t}\n"
+			if polymorphic {
+				check = "\tif value, ok := %s.Value.(interface{ Validate() error }); ok {\n\t\tif err := value.Validate(); err != nil {\n\t\t\treturn err\n\t\t}\n\t}\n"
+			}
+			if element.Plural {
+				value, check = "item", strings.Replace(check, "\n\t", "\n\t\t", -1)
+				content += fmt.Sprintf("\tfor _, item := range v.%s {\n\t%s\t}\n", genGoFieldName(element.Name), fmt.Sprintf(check, value))
+				continue
+			}
+			content += fmt.Sprintf(check, value)
+		}
+		if !v.Mixed && !gen.isComplexType(trimNSPrefix(v.Base)) {
+			content += gen.genGoFacetCheck("Value", v.Base, false, false)
+		}
+		content += gen.genGoChoiceCheck(v)
+		fieldName := genGoFieldName(v.Name)
+		start := len(gen.Field)
+		if len(v.Assertions) > 0 {
+			content += fmt.Sprintf(goAssertCheckTemplate, fieldName)
+			gen.Field += fmt.Sprintf(goAssertHookTemplate, fieldName, "*"+fieldName)
+		}
+		gen.Field += fmt.Sprintf(goValidateTemplate, fieldName, content)
+		gen.Decls = append(gen.Decls, Decl{Name: fieldName + "Validate", Source: gen.Field[start:]})
+		gen.ImportFmt = true
+	}
+}
+
+// genGoFixedCheck returns the check of the field holding the attribute or
+// element with the fixed value, the absent ones tracked by the pointers are
+// not checked. The values which can't be represented by the type of the
+// field are not checked either.
+func (gen *CodeGenerator) genGoFixedCheck(subject, fieldName, fieldType, typeName, value string, pointer bool) string {
+	literal, ok := gen.genGoLiteral(fieldType, typeName, value)
+	if !ok {
+		return ""
+	}
+	check := fmt.Sprintf("\tif v.%s != %s {\n\t\treturn fmt.Errorf(%q)\n\t}\n", fieldName, literal, fmt.Sprintf("%s must have the fixed value %s", subject, value))
+	if pointer {
+		check = fmt.Sprintf("\tif v.%[1]s != nil && *v.%[1]s != %[2]s {\n\t\treturn fmt.Errorf(%[3]q)\n\t}\n", fieldName, literal, fmt.Sprintf("%s must have the fixed value %s", subject, value))
+	}
+	return check
+}
+
+var goAssertHookTemplate = `
+// Assert%[1]s checks the XML schema 1.1 assertions of the %[1]s, which are
+// not evaluated by the generated code, it's called by the Validate method
+// unless it's nil.
+var Assert%[1]s func(v %[2]s) error
+`
+
+var goAssertCheckTemplate = "\tif Assert%[1]s != nil {\n\t\treturn Assert%[1]s(v)\n\t}\n"
+
+var goValidateTemplate = `
+// Validate checks the use and the fixed values of the attributes and
+// elements, the choices and the facets of the values of the %[1]s and its
+// descendants, an error is returned if a required attribute is absent, a
+// prohibited attribute is present, a value differs from the fixed one, the
+// elements of a choice are not mutually exclusive, or a value violates the
+// facets of its type.
+func (v *%[1]s) Validate() error {
+	if v == nil {
+		return nil
+	}
+%[2]s	return nil
+}
+`
+
+var goRoundTripTestTemplate = `%[1]s
+
+package %[2]s
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// roundTrip%[3]s unmarshals the sample XML document of the given type from
+// the testdata directory into v, marshals it again and compares both
+// documents ignoring insignificant whitespace, namespace declarations and
+// the order of attributes. The test is skipped if there is no sample.
+func roundTrip%[3]s(t *testing.T, name string, v interface{}) {
+	sample, err := ioutil.ReadFile(filepath.Join("testdata", name+".xml"))
+	if err != nil {
+		t.Skipf("no sample for %%s: %%s", name, err)
+	}
+	if err = xml.Unmarshal(sample, v); err != nil {
+		t.Fatal(err)
+	}
+	output, err := xml.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := normalizeXML%[3]s(sample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual, err := normalizeXML%[3]s(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected != actual {
+		t.Errorf("round trip of %%s mismatch:\n--- expected\n%%s\n--- actual\n%%s", name, expected, actual)
+	}
+}
+
+// normalizeXML%[3]s returns the tokens of the XML document one per line.
+func normalizeXML%[3]s(data []byte) (string, error) {
+	var tokens []string
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return strings.Join(tokens, "\n"), nil
+		}
+		if err != nil {
+			return "", err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			var attrs []string
+			for _, attr := range token.Attr {
+				if attr.Name.Space == "xmlns" || attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					continue
+				}
+				attrs = append(attrs, fmt.Sprintf("%%s=%%q", attr.Name.Local, attr.Value))
+			}
+			sort.Strings(attrs)
+			tokens = append(tokens, "<"+strings.Join(append([]string{token.Name.Local}, attrs...), " ")+">")
+		case xml.EndElement:
+			tokens = append(tokens, "</"+token.Name.Local+">")
+		case xml.CharData:
+			if text := strings.TrimSpace(string(token)); text != "" {
+				tokens = append(tokens, text)
+			}
+		}
+	}
+}
+%[4]s`
+
+// genGoRoundTripTests generates the test file next to the generated source
+// code, which has a test for each complex type that unmarshals the sample
+// XML document named after the type in the testdata directory, marshals it
+// again and compares the result with the sample.
+func (gen *CodeGenerator) genGoRoundTripTests(packageName string) error {
+	var tests string
+	var typeNames []string
+	names := map[string]bool{}
+	for _, ele := range gen.ProtoTree {
+		complexType, ok := ele.(*ComplexType)
+		if !ok || names[complexType.Name] {
+			continue
+		}
+		names[complexType.Name] = true
+		fieldName := genGoFieldName(complexType.Name)
+		typeNames = append(typeNames, fieldName)
+		tests += fmt.Sprintf("\nfunc TestRoundTrip%s(t *testing.T) {\n\troundTrip%s(t, %q, &%s{})\n}\n",
+			fieldName, genGoFieldName(filepath.Base(gen.File)), complexType.Name, fieldName)
+	}
+	if tests == "" {
+		return nil
+	}
+	return gen.writeFile(gen.File+"_test.go", tests, typeNames, gen.postRender(func(path, field string) ([]byte, error) {
+		return []byte(fmt.Sprintf(goRoundTripTestTemplate, copyright, packageName, genGoFieldName(filepath.Base(gen.File)), field)), nil
+	}))
+}
+
+// genGoXSDTimeTypes generates the declarations of the date and time types
+// which are referenced in the generated code. The layout of each type can be
+// overridden by the TimeLayout of the code generator, which is keyed by the
+// name of the data type in XML schema, such as "dateTime" or "gYear".
+func (gen *CodeGenerator) genGoXSDTimeTypes() {
+	for _, timeType := range goXSDTimeTypes {
+		if !regexp.MustCompile(`\b` + timeType.Name + `\b`).MatchString(gen.Field) {
+			continue
+		}
+		layout := timeType.Layout
+		if gen.TimeLayout[timeType.XSDType] != "" {
+			layout = gen.TimeLayout[timeType.XSDType]
+		}
+		// the values are unmarshaled by the layout with the timezone if it's
+		// absent from the layout, or without it otherwise.
+		alternative, marshal := strings.TrimSuffix(layout, goXSDTimezoneLayout), ""
+		if alternative == layout {
+			alternative = layout + goXSDTimezoneLayout
+			marshal = fmt.Sprintf("\tif time.Time(t).Location() != time.UTC {\n\t\t// keeps the timezone of the value.\n\t\treturn []byte(time.Time(t).Format(%q)), nil\n\t}\n", alternative)
+		}
+		start := len(gen.Field)
+		gen.Field += fmt.Sprintf(goXSDTimeTypeTemplate, timeType.Name, timeType.XSDType, layout, alternative, marshal)
+		gen.Decls = append(gen.Decls, Decl{Name: timeType.Name, Source: gen.Field[start:]})
+		gen.ImportTime, gen.ImportStrings = true, true
+	}
+}
+
+var goXSDDurationTypeTemplate = `
+// XSDDuration is the duration data type in XML schema, the value is marshaled
+// and unmarshaled in the lexical representation PnYnMnDTnHnMnS. The years and
+// months are kept apart from the other parts, since their lengths vary.
+type XSDDuration struct {
+	Negative bool
+	Years    int
+	Months   int
+	Days     int
+	Hours    int
+	Minutes  int
+	Seconds  float64
+}
+
+// xsdDurationLexical matches the lexical representation of the XSDDuration.
+var xsdDurationLexical = regexp.MustCompile(` + "`" + `^(-)?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d*)?|\.\d+)S)?)?$` + "`" + `)
+
+// Duration returns the XSDDuration as the time.Duration, false is returned
+// if the XSDDuration has years or months.
+func (d XSDDuration) Duration() (time.Duration, bool) {
+	if d.Years != 0 || d.Months != 0 {
+		return 0, false
+	}
+	v := time.Duration(d.Days)*24*time.Hour + time.Duration(d.Hours)*time.Hour + time.Duration(d.Minutes)*time.Minute + time.Duration(d.Seconds*float64(time.Second))
+	if d.Negative {
+		v = -v
+	}
+	return v, true
+}
+
+// MarshalText encodes the XSDDuration value into the lexical representation,
+// the parts with zero values are omitted.
+func (d XSDDuration) MarshalText() ([]byte, error) {
+	var date, clock string
+	for _, part := range []struct {
+		value      int
+		designator string
+		clock      bool
+	}{{d.Years, "Y", false}, {d.Months, "M", false}, {d.Days, "D", false}, {d.Hours, "H", true}, {d.Minutes, "M", true}} {
+		if part.value == 0 {
+			continue
+		}
+		if part.clock {
+			clock += fmt.Sprint(part.value) + part.designator
+			continue
+		}
+		date += fmt.Sprint(part.value) + part.designator
+	}
+	if d.Seconds != 0 || date == "" && clock == "" {
+		clock += fmt.Sprint(d.Seconds) + "S"
+	}
+	if clock != "" {
+		clock = "T" + clock
+	}
+	if d.Negative {
+		return []byte("-P" + date + clock), nil
+	}
+	return []byte("P" + date + clock), nil
+}
+
+// UnmarshalText decodes the lexical representation into the XSDDuration
+// value.
+func (d *XSDDuration) UnmarshalText(text []byte) error {
+	value := strings.TrimSpace(string(text))
+	if value == "" {
+		*d = XSDDuration{}
+		return nil
+	}
+	match := xsdDurationLexical.FindStringSubmatch(value)
+	if match == nil || strings.HasSuffix(value, "P") || strings.HasSuffix(value, "T") {
+		return fmt.Errorf("invalid duration %q", value)
+	}
+	v := XSDDuration{Negative: match[1] != ""}
+	for i, part := range []*int{&v.Years, &v.Months, &v.Days, &v.Hours, &v.Minutes} {
+		if match[i+2] == "" {
+			continue
+		}
+		if _, err := fmt.Sscan(match[i+2], part); err != nil {
+			return fmt.Errorf("invalid duration %q: %v", value, err)
+		}
+	}
+	if match[7] != "" {
+		if _, err := fmt.Sscan(match[7], &v.Seconds); err != nil {
+			return fmt.Errorf("invalid duration %q: %v", value, err)
+		}
+	}
+	*d = v
+	return nil
+}
+`
+
+// genGoXSDDurationType generates the declaration of the duration type if
+// it's referenced in the generated code.
+func (gen *CodeGenerator) genGoXSDDurationType() {
+	if !regexp.MustCompile(`\bXSDDuration\b`).MatchString(gen.Field) {
+		return
+	}
+	start := len(gen.Field)
+	gen.Field += goXSDDurationTypeTemplate
+	gen.Decls = append(gen.Decls, Decl{Name: "XSDDuration", Source: gen.Field[start:]})
+	gen.ImportTime, gen.ImportStrings, gen.ImportFmt, gen.ImportRegexp = true, true, true, true
+}
+
+var goXSDAnyTypeTemplate = `
+// XSDAny holds the element matched by the wildcard in XML schema, the name,
+// attributes and content of the element are kept as they are, so the element
+// is marshaled back unchanged.
+type XSDAny struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr ` + "`xml:\",any,attr\"`" + `
+	InnerXML string     ` + "`xml:\",innerxml\"`" + `
+}
+
+// MarshalXML encodes the element with its attributes and content, the names
+// in the namespaces declared by the element are written with the declared
+// prefixes, which the content may refer to.
+func (a XSDAny) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	prefixes := map[string]string{}
+	for _, attr := range a.Attrs {
+		if attr.Name.Space == "xmlns" {
+			prefixes[attr.Value] = attr.Name.Local
+		}
+	}
+	qualify := func(name xml.Name) xml.Name {
+		if prefix, ok := prefixes[name.Space]; ok && name.Space != "" {
+			return xml.Name{Local: prefix + ":" + name.Local}
+		}
+		return name
+	}
+	start = xml.StartElement{Name: qualify(a.XMLName)}
+	for _, attr := range a.Attrs {
+		switch {
+		case attr.Name.Space == "xmlns":
+			attr.Name = xml.Name{Local: "xmlns:" + attr.Name.Local}
+		case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+			if attr.Value == start.Name.Space {
+				continue
+			}
+		default:
+			attr.Name = qualify(attr.Name)
+		}
+		start.Attr = append(start.Attr, attr)
+	}
+	return e.EncodeElement(struct {
+		InnerXML string ` + "`xml:\",innerxml\"`" + `
+	}{a.InnerXML}, start)
+}
+`
+
+var goXSDAnyAttrTypeTemplate = `
+// XSDAnyAttr holds the attribute matched by the attribute wildcard in XML
+// schema. The namespace declarations are not matched by the wildcard, they
+// are unmarshaled as well but omitted when marshaling, since the encoder
+// declares the namespaces of the names itself.
+type XSDAnyAttr xml.Attr
+
+// UnmarshalXMLAttr decodes the attribute as it is.
+func (a *XSDAnyAttr) UnmarshalXMLAttr(attr xml.Attr) error {
+	*a = XSDAnyAttr(attr)
+	return nil
+}
+
+// MarshalXMLAttr encodes the attribute, or nothing for the namespace
+// declarations.
+func (a XSDAnyAttr) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if a.Name.Space == "xmlns" || a.Name.Space == "" && a.Name.Local == "xmlns" {
+		return xml.Attr{}, nil
+	}
+	return xml.Attr(a), nil
+}
+`
+
+// genGoXSDAnyType generates the declarations of the types which hold the
+// elements and attributes matched by the wildcards if they're referenced in
+// the generated code.
+func (gen *CodeGenerator) genGoXSDAnyType() {
+	for _, decl := range []Decl{{Name: "XSDAny", Source: goXSDAnyTypeTemplate}, {Name: "XSDAnyAttr", Source: goXSDAnyAttrTypeTemplate}} {
+		if !regexp.MustCompile(`\b` + decl.Name + `\b`).MatchString(gen.Field) {
+			continue
+		}
+		gen.Field += decl.Source
+		gen.Decls = append(gen.Decls, decl)
+		gen.ImportEncodingXML = true
+	}
+}
+
+// genGoWildcardField returns the field which holds the elements matched by
+// the wildcard element, the field is a slice if the wildcard may repeat, or
+// a pointer otherwise so it's omitted if there is no element matched.
+func genGoWildcardField(element Element) string {
+	fieldType := "*XSDAny"
+	if element.Plural {
+		fieldType = "[]XSDAny"
+	}
+	return fmt.Sprintf("\t%s\t%s\t`xml:\",any\"%s`\n", genGoFieldName(element.Name), fieldType, genGoHintTags(element.Hints))
+}
+
+var goListTypeTemplate = `
+// ListItemName is implemented by the types which name the repeating child
+// element of the List.
+type ListItemName interface {
+	ListItemName() string
+}
+
+// List holds the values of the repeating child element of a wrapper element,
+// the child element is named by N.
+type List[T any, N ListItemName] []T
+
+// UnmarshalXML decodes the child elements of the wrapper element, other
+// elements are skipped.
+func (l *List[T, N]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var name N
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			if element.Name.Local != name.ListItemName() {
+				if err := d.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+			var item T
+			if err := d.DecodeElement(&item, &element); err != nil {
+				return err
+			}
+			*l = append(*l, item)
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// MarshalXML encodes the values as the child elements of the wrapper
+// element.
+func (l List[T, N]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	var name N
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, item := range l {
+		if err := e.EncodeElement(item, xml.StartElement{Name: xml.Name{Local: name.ListItemName()}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+`
+
+var goListItemNameTemplate = `
+// %[1]s names the %[2]s child element of the List.
+type %[1]s struct{}
+
+// ListItemName returns the name of the child element.
+func (%[1]s) ListItemName() string { return %[2]q }
+`
+
+// genGoListType generates the declaration of the generic List type if it's
+// referenced by the wrapper types in the generated code.
+func (gen *CodeGenerator) genGoListType() {
+	if !gen.GoGenerics || !regexp.MustCompile(`\bList\[`).MatchString(gen.Field) {
+		return
+	}
+	start := len(gen.Field)
+	gen.Field += goListTypeTemplate
+	gen.Decls = append(gen.Decls, Decl{Name: "List", Source: gen.Field[start:]})
+	gen.ImportEncodingXML = true
+}
+
+// goListItem returns the child element of the complex type with the name if
+// the type is a wrapper, which contains nothing but a single repeating child
+// element, and the GoGenerics of the code generator is set. Wrapper types are
+// declared as the generic List type instead of a struct.
+func (gen *CodeGenerator) goListItem(name string) (*Element, bool) {
+	if !gen.GoGenerics {
+		return nil, false
+	}
+	for _, ele := range gen.ProtoTree {
+		v, ok := ele.(*ComplexType)
+		if !ok || v.Name != trimNSPrefix(name) {
+			continue
+		}
+		if len(v.Elements) != 1 || !v.Elements[0].Plural || len(v.Attributes) > 0 || len(v.AttributeGroup) > 0 ||
+			len(v.Groups) > 0 || v.Base != "" || v.Mixed || len(getDerivedTypes(gen.ProtoTree)[v.Name]) > 0 {
+			return nil, false
+		}
+		return &v.Elements[0], true
+	}
+	return nil, false
+}
+
+// genGoListTypeRef returns the instantiation of the generic List type by given
+// child element of the wrapper type, and declares the type which names the
+// child element.
+func (gen *CodeGenerator) genGoListTypeRef(item *Element) string {
+	itemType := strings.TrimPrefix(gen.genGoType(item.Type), "*")
+	if itemType == "time.Time" {
+		gen.ImportTime = true
+	}
+	if _, ok := getDerivedTypes(gen.ProtoTree)[trimNSPrefix(item.Type)]; ok {
+		itemType = genGoFieldName(trimNSPrefix(item.Type)) + "Element"
+	}
+	itemName := genGoFieldName(item.Name) + "Name"
+	if _, ok := gen.StructAST["ListItemName "+item.Name]; !ok {
+		gen.StructAST["ListItemName "+item.Name] = itemName
+		gen.Field += fmt.Sprintf(goListItemNameTemplate, itemName, item.Name)
+	}
+	return fmt.Sprintf("List[%s, %s]", itemType, itemName)
+}
+
+// genGoTypeDef returns the type definition of the given type, date and time
+// types are declared as alias to keep their methods.
+func genGoTypeDef(fieldType string) string {
+	if strings.HasPrefix(fieldType, "XSD") && goBuildinType[fieldType] {
+		return "= " + fieldType
+	}
+	return fieldType
+}
+
+var goEnumTemplate = `type %[1]s string
+
+// The enumerations of %[1]s.
+const (
+%[2]s)
+
+// String returns the value of the %[1]s.
+func (v %[1]s) String() string {
+	return string(v)
+}
+
+// Parse%[1]s parses the %[1]s value, an error is returned if
+// the value is not one of the enumerations.
+func Parse%[1]s(s string) (%[1]s, error) {
+	switch v := %[1]s(s); v {
+	case %[3]s:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid %[1]s value %%q", s)
+}
+
+// MarshalText encodes the %[1]s value into the text.
+func (v %[1]s) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText decodes the text into the %[1]s value, an error is
+// returned if the text is not one of the enumerations.
+func (v *%[1]s) UnmarshalText(text []byte) error {
+	value, err := Parse%[1]s(string(text))
+	if err != nil {
+		return err
+	}
+	*v = value
+	return nil
+}
+`
+
+// isGoEnumType reports whether the simple type with the name is restricted
+// by enumerations of string, which is declared as the named string type with
+// a constant for each enumeration.
+func (gen *CodeGenerator) isGoEnumType(name string) bool {
+	for _, ele := range gen.ProtoTree {
+		if v, ok := ele.(*SimpleType); ok && v.Name == name {
+			return !v.List && !v.Union && len(v.Restriction.Enum) > 0 &&
+				gen.getBasefromSimpleType(trimNSPrefix(v.Base)) == "string"
+		}
+	}
+	return false
+}
+
+// isGoNormalizedType reports whether the simple type with the name is
+// declared as the named string type which normalizes the white space in its
+// values on decoding.
+func (gen *CodeGenerator) isGoNormalizedType(name string) bool {
+	return isNormalizedSimpleType(name, gen.ProtoTree) && gen.getBasefromSimpleType(name) == "string"
+}
+
+// genGoType returns the Go type of the definition by given type name, the
+// enumerations and the simple types normalizing the white space are
+// referenced by their named types, and other simple types are replaced by
+// their base types.
+func (gen *CodeGenerator) genGoType(name string) string {
+	if gen.isGoEnumType(trimNSPrefix(name)) || gen.isGoNormalizedType(trimNSPrefix(name)) {
+		return genGoFieldName(trimNSPrefix(name))
+	}
+	fieldType := genGoFieldType(gen.getBasefromSimpleType(trimNSPrefix(name)))
+	if typePackage, ok := gen.typePackages[trimNSPrefix(name)]; ok && strings.HasPrefix(fieldType, "*") {
+		if gen.TypeNamePrefix != "" || gen.TypeNameSuffix != "" {
+			fieldType = "*" + gen.TypeNamePrefix + fieldType[1:] + gen.TypeNameSuffix
+		}
+		return "*" + path.Base(typePackage) + "." + fieldType[1:]
+	}
+	return fieldType
+}
+
+// genGoPackageImports returns the import declarations of the packages of the
+// types in the other namespaces which are referenced in the source.
+func (gen *CodeGenerator) genGoPackageImports(field string) (imports string) {
+	var typePackages []string
+	for _, typePackage := range gen.typePackages {
+		typePackages = append(typePackages, typePackage)
+	}
+	sort.Strings(typePackages)
+	for i, typePackage := range typePackages {
+		if i > 0 && typePackage == typePackages[i-1] {
+			continue
+		}
+		if regexp.MustCompile(`\b` + path.Base(typePackage) + `\.[A-Z]`).MatchString(field) {
+			imports += fmt.Sprintf("\t%q\n", typePackage)
+		}
+	}
+	return
+}
+
+// genGoEnum generates the declaration of the enumeration type by given type
+// name and values. The constants are named after the type and the values,
+// and the type implements the encoding.TextMarshaler and
+// encoding.TextUnmarshaler, so the values are validated by the XML, JSON and
+// other text based encoders.
+func (gen *CodeGenerator) genGoEnum(fieldName string, enums []string) string {
+	var constants string
+	var names []string
+	seen := map[string]bool{}
+	for _, enum := range enums {
+		var constName string
+		for _, str := range strings.FieldsFunc(enum, func(r rune) bool {
+			return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+		}) {
+			constName += MakeFirstUpperCase(str)
+		}
+		if constName == "" {
+			constName = "Empty"
+		}
+		constName = fieldName + constName
+		for i, name := 2, constName; seen[constName]; i++ {
+			constName = fmt.Sprintf("%s%d", name, i)
+		}
+		seen[constName] = true
+		names = append(names, constName)
+		constants += fmt.Sprintf("\t%s %s = %q\n", constName, fieldName, enum)
+	}
+	gen.ImportFmt = true
+	return fmt.Sprintf(goEnumTemplate, fieldName, constants, strings.Join(names, ", "))
+}
+
 var copyright = `// Copyright 2020 The xgen Authors. All rights reserved.
 //
 // DO NOT EDIT: generated by xgen XSD generator
@@ -138,58 +1283,111 @@ var copyright = `// Copyright 2020 The xgen Authors. All rights reserved.
 func (gen *CodeGenerator) GoSimpleType(v *SimpleType) {
 	if v.List {
 		if _, ok := gen.StructAST[v.Name]; !ok {
-			fieldType := genGoFieldType(getBasefromSimpleType(trimNSPrefix(v.Base), gen.ProtoTree))
+			fieldType := genGoFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))
 			if fieldType == "time.Time" {
 				gen.ImportTime = true
 			}
 			content := fmt.Sprintf(" []%s\n", genGoFieldType(fieldType))
 			gen.StructAST[v.Name] = content
 			fieldName := genGoFieldName(v.Name)
-			gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName), fieldName, gen.StructAST[v.Name])
+			gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName, v.Doc)+genDerivationComment("", v.Final), fieldName, gen.StructAST[v.Name]) + gen.genGoSimpleListMethods(fieldName, genGoFieldType(fieldType), v)
 			return
 		}
 	}
 	if v.Union && len(v.MemberTypes) > 0 {
 		if _, ok := gen.StructAST[v.Name]; !ok {
-			content := " struct {\n"
 			fieldName := genGoFieldName(v.Name)
-			if fieldName != v.Name {
-				gen.ImportEncodingXML = true
-				content += fmt.Sprintf("\tXMLName\txml.Name\t`xml:\"%s\"`\n", v.Name)
-			}
-			for memberName, memberType := range v.MemberTypes {
-				if memberType == "" { // fix order issue
-					memberType = getBasefromSimpleType(memberName, gen.ProtoTree)
-				}
-				content += fmt.Sprintf("\t%s\t%s\n", genGoFieldName(memberName), genGoFieldType(memberType))
-			}
-			content += "}\n"
+			content, methods := gen.genGoUnion(fieldName, v)
 			gen.StructAST[v.Name] = content
-			gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName), fieldName, gen.StructAST[v.Name])
+			gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName, v.Doc)+genDerivationComment("", v.Final), fieldName, gen.StructAST[v.Name]) + methods
+		}
+		return
+	}
+	if gen.isGoEnumType(v.Name) {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			gen.StructAST[v.Name] = " string\n"
+			fieldName := genGoFieldName(v.Name)
+			gen.Field += genGoFieldComment(fieldName, v.Doc) + genDerivationComment("", v.Final) + gen.genGoEnum(fieldName, v.Restriction.Enum) + gen.genGoWhiteSpaceMethods(v)
 		}
 		return
 	}
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		content := fmt.Sprintf(" %s\n", genGoFieldType(getBasefromSimpleType(trimNSPrefix(v.Base), gen.ProtoTree)))
+		content := fmt.Sprintf(" %s\n", genGoTypeDef(genGoFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))))
 		gen.StructAST[v.Name] = content
 		fieldName := genGoFieldName(v.Name)
-		gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName), fieldName, gen.StructAST[v.Name])
+		gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName, v.Doc)+genDerivationComment("", v.Final)+genAssertionComment(v.Restriction.Assertions), fieldName, gen.StructAST[v.Name]) + gen.genGoWhiteSpaceMethods(v) + gen.genGoFacetMethods(v)
 	}
 	return
 }
 
+var goWhiteSpaceTemplate = `
+// UnmarshalXML decodes the element into the %[1]s, the white space in the
+// value is %[2]s as specified by the whiteSpace facet.
+func (v *%[1]s) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value string
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	return v.UnmarshalXMLAttr(xml.Attr{Value: value})
+}
+
+// UnmarshalXMLAttr decodes the attribute into the %[1]s, the white space in
+// the value is %[2]s as specified by the whiteSpace facet.
+func (v *%[1]s) UnmarshalXMLAttr(attr xml.Attr) error {
+%[3]s}
+`
+
+// genGoWhiteSpaceMethods generates the methods which normalize the white
+// space in the values of the simple type on decoding. The tabs, line feeds
+// and carriage returns are replaced by spaces for the replace, and the
+// leading and trailing spaces are trimmed and the runs of spaces are
+// squashed into single spaces for the collapse. The normalized values of
+// the enumerations are checked by the UnmarshalText method.
+func (gen *CodeGenerator) genGoWhiteSpaceMethods(v *SimpleType) string {
+	if !gen.isGoNormalizedType(v.Name) {
+		return ""
+	}
+	gen.ImportEncodingXML, gen.ImportStrings = true, true
+	fieldName, normalization := genGoFieldName(v.Name), "collapsed"
+	value := "strings.Join(strings.FieldsFunc(attr.Value, func(r rune) bool {\n\t\treturn r == ' ' || r == '\\t' || r == '\\n' || r == '\\r'\n\t}), \" \")"
+	if v.Restriction.WhiteSpace == "replace" {
+		normalization = "replaced"
+		value = "strings.Map(func(r rune) rune {\n\t\tif r == '\\t' || r == '\\n' || r == '\\r' {\n\t\t\treturn ' '\n\t\t}\n\t\treturn r\n\t}, attr.Value)"
+	}
+	if gen.isGoEnumType(v.Name) {
+		return fmt.Sprintf(goWhiteSpaceTemplate, fieldName, normalization, fmt.Sprintf("\treturn v.UnmarshalText([]byte(%s))\n", value))
+	}
+	return fmt.Sprintf(goWhiteSpaceTemplate, fieldName, normalization, fmt.Sprintf("\t*v = %s(%s)\n\treturn nil\n", fieldName, value))
+}
+
 // GoComplexType generates code for complex type XML schema in Go language
 // syntax.
 func (gen *CodeGenerator) GoComplexType(v *ComplexType) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		content := " struct {\n"
 		fieldName := genGoFieldName(v.Name)
-		if fieldName != v.Name {
+		if item, ok := gen.goListItem(v.Name); ok {
+			gen.StructAST[v.Name] = fmt.Sprintf(" = %s\n", gen.genGoListTypeRef(item))
+			gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName, v.Doc)+genDerivationComment(v.Block, v.Final), fieldName, gen.StructAST[v.Name])
+			return
+		}
+		content := " struct {\n"
+		// the anonymous types are named by the path of the local element,
+		// which is named by the field referring to it. The global element
+		// is qualified by the target namespace, so the root element of the
+		// marshaled document is in the namespace.
+		if xmlName := gen.xmlName(v.Name); (fieldName != xmlName || v.Namespace != "") && !v.Anonymous {
 			gen.ImportEncodingXML = true
-			content += fmt.Sprintf("\tXMLName\txml.Name\t`xml:\"%s\"`\n", v.Name)
+			content += fmt.Sprintf("\tXMLName\txml.Name\t`xml:\"%s\"`\n", strings.TrimSpace(v.Namespace+" "+xmlName))
 		}
+		if v.Extension {
+			// embeds the base complex type to inherit its fields.
+			if baseType := gen.genGoType(v.Base); strings.HasPrefix(baseType, "*") {
+				content += fmt.Sprintf("\t%s\n", baseType[1:])
+			}
+		}
+		derivedTypes := getDerivedTypes(gen.ProtoTree)
 		for _, attrGroup := range v.AttributeGroup {
-			fieldType := getBasefromSimpleType(trimNSPrefix(attrGroup.Ref), gen.ProtoTree)
+			fieldType := gen.getBasefromSimpleType(trimNSPrefix(attrGroup.Ref))
 			if fieldType == "time.Time" {
 				gen.ImportTime = true
 			}
@@ -201,53 +1399,196 @@ func (gen *CodeGenerator) GoComplexType(v *ComplexType) {
 			if attribute.Optional {
 				optional = `,omitempty`
 			}
-			fieldType := genGoFieldType(getBasefromSimpleType(trimNSPrefix(attribute.Type), gen.ProtoTree))
-			if fieldType == "time.Time" {
-				gen.ImportTime = true
-			}
-			content += fmt.Sprintf("\t%sAttr\t%s\t`xml:\"%s,attr%s\"`\n", genGoFieldName(attribute.Name), fieldType, attribute.Name, optional)
+			fieldName, _ := genGoAttributeName(attribute.Name)
+			content += genDocComment(attribute.Doc, "\t// ")
+			content += fmt.Sprintf("\t%s\t%s\t`xml:\"%s,attr%s\"%s`\n", fieldName, gen.genGoAttributeType(attribute), genGoAttributeTag(attribute), optional, genGoHintTags(attribute.Hints))
+		}
+		if gen.isGoNillableComplexType(v.Name) {
+			content += "\tNil\tbool\t" + xsiNilTag + "\n"
 		}
 		for _, group := range v.Groups {
 			var plural string
 			if group.Plural {
 				plural = "[]"
 			}
-			content += fmt.Sprintf("\t%s\t%s%s\n", genGoFieldName(group.Name), plural, genGoFieldType(getBasefromSimpleType(trimNSPrefix(group.Ref), gen.ProtoTree)))
+			content += fmt.Sprintf("\t%s\t%s%s\n", genGoFieldName(group.Name), plural, genGoFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref))))
 		}
 
+		wildcard := false
 		for _, element := range v.Elements {
-			var plural string
-			if element.Plural {
-				plural = "[]"
+			wildcard = wildcard || element.Wildcard
+		}
+		for _, element := range v.Elements {
+			content += genDocComment(element.Doc, "\t// ")
+			if element.Wildcard {
+				content += genGoWildcardField(element)
+				continue
 			}
-			fieldType := genGoFieldType(getBasefromSimpleType(trimNSPrefix(element.Type), gen.ProtoTree))
-			if fieldType == "time.Time" {
-				gen.ImportTime = true
+			if head, _ := gen.substitutionGroup(element); head != nil && !wildcard {
+				// matches the elements of the substitution group by name
+				// in the field of the first head element.
+				wildcard = true
+				content += fmt.Sprintf("\t%s\t%s\t`xml:\",any\"%s`\n", genGoFieldName(element.Name), gen.genGoElementType(element, derivedTypes), genGoHintTags(element.Hints))
+				continue
+			}
+			if fieldType, pointer := gen.genGoOptionalElementType(v, element, derivedTypes); pointer {
+				content += fmt.Sprintf("\t%s\t%s\t`xml:\"%s,omitempty\"%s`\n", genGoFieldName(element.Name), fieldType, genGoElementTag(element), genGoHintTags(element.Hints))
+				continue
 			}
-			content += fmt.Sprintf("\t%s\t%s%s\t`xml:\"%s\"`\n", genGoFieldName(element.Name), plural, fieldType, element.Name)
+			// the optional elements declared with the pointers or the
+			// lists are omitted as well if they're nil.
+			var optional string
+			if element.Optional && !element.Plural {
+				optional = `,omitempty`
+			}
+			content += fmt.Sprintf("\t%s\t%s\t`xml:\"%s%s\"%s`\n", genGoFieldName(element.Name), gen.genGoElementType(element, derivedTypes), genGoElementTag(element), optional, genGoHintTags(element.Hints))
+		}
+		if v.Mixed {
+			content += "\tValue\tstring\t`xml:\",chardata\"`\n"
+		} else if valueType := gen.genGoCharDataType(v); valueType != "" {
+			content += fmt.Sprintf("\tValue\t%s\t`xml:\",chardata\"`\n", valueType)
+		}
+		if v.AnyAttribute {
+			// keeps the attributes matched by the attribute wildcard, the
+			// declared attributes are held by their own fields.
+			content += "\tAnyAttrs\t[]XSDAnyAttr\t`xml:\",any,attr\"`\n"
 		}
 		content += "}\n"
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName), fieldName, gen.StructAST[v.Name])
+		gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName, v.Doc)+genDerivationComment(v.Block, v.Final)+genAssertionComment(v.Assertions), fieldName, gen.StructAST[v.Name])
 	}
 	return
 }
 
+// genGoAttributeType returns the type of the field for the attribute of the
+// complex type.
+func (gen *CodeGenerator) genGoAttributeType(attribute Attribute) string {
+	fieldType := gen.genGoType(attribute.Type)
+	if fieldType == "time.Time" {
+		gen.ImportTime = true
+	}
+	if (gen.isGoValidatedAttribute(attribute) || gen.goFacetType(attribute.Type) != nil) && !strings.HasPrefix(fieldType, "*") && !strings.HasPrefix(fieldType, "[]") {
+		// tracks the presence of the attribute by the pointer.
+		fieldType = "*" + fieldType
+	}
+	return gen.genGoOptionalPointer(fieldType, attribute.Optional)
+}
+
+// genGoOptionalPointer returns the pointer to the type of the field for the
+// optional element or attribute if the GoOptionalPointers of the code
+// generator is set, so that the absent value is distinguished from the zero
+// value. The pointers and the lists are returned as they are.
+func (gen *CodeGenerator) genGoOptionalPointer(fieldType string, optional bool) string {
+	if gen.GoOptionalPointers && optional && !strings.HasPrefix(fieldType, "*") && !strings.HasPrefix(fieldType, "[]") {
+		return "*" + fieldType
+	}
+	return fieldType
+}
+
+// genGoElementType returns the type of the field for the child element of
+// the complex type, the elements declared with the types which have derived
+// types are held by the polymorphic element types, the head elements of the
+// substitution groups are held by the substitution group types, and the
+// nillable elements with the simple types are held by the nillable types.
+func (gen *CodeGenerator) genGoElementType(element Element, derivedTypes map[string][]string) string {
+	var plural string
+	if element.Plural {
+		plural = "[]"
+	}
+	if head, _ := gen.substitutionGroup(element); head != nil {
+		return plural + genGoFieldName(head.Name) + "Substitution"
+	}
+	fieldType := gen.genGoType(element.Type)
+	if fieldType == "time.Time" {
+		gen.ImportTime = true
+	}
+	if _, ok := derivedTypes[trimNSPrefix(element.Type)]; ok {
+		fieldType = genGoFieldName(trimNSPrefix(element.Type)) + "Element"
+	}
+	if _, ok := gen.goListItem(element.Type); ok {
+		fieldType = genGoFieldName(trimNSPrefix(element.Type))
+	}
+	if element.Nillable && !element.Plural && fieldType == gen.genGoType(element.Type) && !strings.HasPrefix(fieldType, "*") && !strings.HasPrefix(fieldType, "[]") {
+		// the nil element is distinguished from the empty one by the
+		// holder of the value.
+		fieldType = "*" + goNillableType(fieldType)
+	}
+	return plural + fieldType
+}
+
+// genGoOptionalElementType returns the type of the field for the child
+// element of the complex type, and whether the field is the pointer tracking
+// the presence of the element. The optional elements which can't repeat are
+// declared as the pointers, so the absent ones are distinguished from the
+// ones with an empty or zero value, and so are the elements of a choice, so
+// only the present one is encoded.
+func (gen *CodeGenerator) genGoOptionalElementType(v *ComplexType, element Element, derivedTypes map[string][]string) (string, bool) {
+	fieldType := gen.genGoElementType(element, derivedTypes)
+	if goChoiceElement(v, element) == nil && !element.Optional || element.Plural || element.Wildcard {
+		return fieldType, false
+	}
+	if gen.isGoPolymorphicElement(element, derivedTypes) || strings.HasPrefix(fieldType, "*") || strings.HasPrefix(fieldType, "[]") {
+		return fieldType, false
+	}
+	return "*" + fieldType, true
+}
+
+// genGoCharDataType returns the type of the character data for the complex
+// type with simple content. The value type of the complex type derived by
+// restriction is resolved through the inheritance chain, and the one derived
+// by extension of a complex type is inherited by embedding the base type.
+func (gen *CodeGenerator) genGoCharDataType(v *ComplexType) string {
+	for visited := map[string]bool{}; v != nil && v.Base != "" && !visited[v.Name]; {
+		visited[v.Name] = true
+		baseType := gen.genGoType(v.Base)
+		if !strings.HasPrefix(baseType, "*") {
+			return baseType
+		}
+		if v.Extension {
+			return ""
+		}
+		var base *ComplexType
+		for _, ele := range gen.ProtoTree {
+			if complexType, ok := ele.(*ComplexType); ok && complexType.Name == trimNSPrefix(v.Base) {
+				base = complexType
+			}
+		}
+		v = base
+	}
+	return ""
+}
+
 // GoGroup generates code for group XML schema in Go language syntax.
 func (gen *CodeGenerator) GoGroup(v *Group) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		content := " struct {\n"
 		fieldName := genGoFieldName(v.Name)
-		if fieldName != v.Name {
+		if xmlName := gen.xmlName(v.Name); fieldName != xmlName {
 			gen.ImportEncodingXML = true
-			content += fmt.Sprintf("\tXMLName\txml.Name\t`xml:\"%s\"`\n", v.Name)
+			content += fmt.Sprintf("\tXMLName\txml.Name\t`xml:\"%s\"`\n", xmlName)
 		}
 		for _, element := range v.Elements {
+			content += genDocComment(element.Doc, "\t// ")
+			if element.Wildcard {
+				content += genGoWildcardField(element)
+				continue
+			}
 			var plural string
 			if element.Plural {
 				plural = "[]"
 			}
-			content += fmt.Sprintf("\t%s\t%s%s\n", genGoFieldName(element.Name), plural, genGoFieldType(getBasefromSimpleType(trimNSPrefix(element.Type), gen.ProtoTree)))
+			var tags string
+			if element.Optional && !element.Plural {
+				tags = fmt.Sprintf(` xml:"%s,omitempty"`, genGoElementTag(element))
+			}
+			if tags += genGoHintTags(element.Hints); tags != "" {
+				tags = "\t`" + strings.TrimPrefix(tags, " ") + "`"
+			}
+			fieldType := gen.genGoType(element.Type)
+			if plural == "" {
+				fieldType = gen.genGoOptionalPointer(fieldType, element.Optional)
+			}
+			content += fmt.Sprintf("\t%s\t%s%s%s\n", genGoFieldName(element.Name), plural, fieldType, tags)
 		}
 
 		for _, group := range v.Groups {
@@ -255,12 +1596,12 @@ func (gen *CodeGenerator) GoGroup(v *Group) {
 			if group.Plural {
 				plural = "[]"
 			}
-			content += fmt.Sprintf("\t%s\t%s%s\n", genGoFieldName(group.Name), plural, genGoFieldType(getBasefromSimpleType(trimNSPrefix(group.Ref), gen.ProtoTree)))
+			content += fmt.Sprintf("\t%s\t%s%s\n", genGoFieldName(group.Name), plural, genGoFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref))))
 		}
 
 		content += "}\n"
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName), fieldName, gen.StructAST[v.Name])
+		gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName, v.Doc), fieldName, gen.StructAST[v.Name])
 	}
 	return
 }
@@ -271,20 +1612,22 @@ func (gen *CodeGenerator) GoAttributeGroup(v *AttributeGroup) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		content := " struct {\n"
 		fieldName := genGoFieldName(v.Name)
-		if fieldName != v.Name {
+		if xmlName := gen.xmlName(v.Name); fieldName != xmlName {
 			gen.ImportEncodingXML = true
-			content += fmt.Sprintf("\tXMLName\txml.Name\t`xml:\"%s\"`\n", v.Name)
+			content += fmt.Sprintf("\tXMLName\txml.Name\t`xml:\"%s\"`\n", xmlName)
 		}
 		for _, attribute := range v.Attributes {
 			var optional string
 			if attribute.Optional {
 				optional = `,omitempty`
 			}
-			content += fmt.Sprintf("\t%sAttr\t%s\t`xml:\"%s,attr%s\"`\n", genGoFieldName(attribute.Name), genGoFieldType(getBasefromSimpleType(trimNSPrefix(attribute.Type), gen.ProtoTree)), attribute.Name, optional)
+			fieldName, _ := genGoAttributeName(attribute.Name)
+			content += genDocComment(attribute.Doc, "\t// ")
+			content += fmt.Sprintf("\t%s\t%s\t`xml:\"%s,attr%s\"%s`\n", fieldName, gen.genGoOptionalPointer(gen.genGoType(attribute.Type), attribute.Optional), genGoAttributeTag(attribute), optional, genGoHintTags(attribute.Hints))
 		}
 		content += "}\n"
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName), fieldName, gen.StructAST[v.Name])
+		gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName, v.Doc), fieldName, gen.StructAST[v.Name])
 	}
 	return
 }
@@ -296,10 +1639,14 @@ func (gen *CodeGenerator) GoElement(v *Element) {
 		if v.Plural {
 			plural = "[]"
 		}
-		content := fmt.Sprintf("\t%s%s\n", plural, genGoFieldType(getBasefromSimpleType(trimNSPrefix(v.Type), gen.ProtoTree)))
+		fieldType := gen.genGoType(v.Type)
+		if plural == "" {
+			fieldType = genGoTypeDef(fieldType)
+		}
+		content := fmt.Sprintf("\t%s%s\n", plural, fieldType)
 		gen.StructAST[v.Name] = content
 		fieldName := genGoFieldName(v.Name)
-		gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName), fieldName, gen.StructAST[v.Name])
+		gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName, v.Doc)+genDerivationComment(v.Block, v.Final), fieldName, gen.StructAST[v.Name])
 	}
 	return
 }
@@ -311,10 +1658,14 @@ func (gen *CodeGenerator) GoAttribute(v *Attribute) {
 		if v.Plural {
 			plural = "[]"
 		}
-		content := fmt.Sprintf("\t%s%s\n", plural, genGoFieldType(getBasefromSimpleType(trimNSPrefix(v.Type), gen.ProtoTree)))
+		fieldType := gen.genGoType(v.Type)
+		if plural == "" {
+			fieldType = genGoTypeDef(fieldType)
+		}
+		content := fmt.Sprintf("\t%s%s\n", plural, fieldType)
 		gen.StructAST[v.Name] = content
 		fieldName := genGoFieldName(v.Name)
-		gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName), fieldName, gen.StructAST[v.Name])
+		gen.Field += fmt.Sprintf("%stype %s%s", genGoFieldComment(fieldName, v.Doc), fieldName, gen.StructAST[v.Name])
 	}
 	return
 }
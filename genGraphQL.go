@@ -0,0 +1,257 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var graphQLBuildInType = map[string]bool{
+	"Boolean":   true,
+	"Float":     true,
+	"ID":        true,
+	"Int":       true,
+	"String":    true,
+	"[String!]": true,
+}
+
+// graphQLScalarType defines the custom scalars which are declared in the
+// generated schema if they are used by any field.
+var graphQLScalarType = map[string]bool{
+	"Date":     true,
+	"DateTime": true,
+	"Duration": true,
+	"Time":     true,
+}
+
+// graphQLFieldType matches the named type of the fields in the generated
+// schema.
+var graphQLFieldType = regexp.MustCompile(`(?m)^\t\w+: \[?(\w+)`)
+
+// GenGraphQL generate GraphQL schema definition language source code for XML
+// schema definition files. Complex types are declared as object types, simple
+// types with enumerations are declared as enum types, and other simple types
+// are replaced by their base types.
+func (gen *CodeGenerator) GenGraphQL() error {
+	gen.genProtoTree("GraphQL")
+	return gen.writeSource(".graphql", genGraphQLFieldName, func(path, field string) ([]byte, error) {
+		var scalars []string
+		declared := map[string]bool{}
+		for _, match := range graphQLFieldType.FindAllStringSubmatch(field, -1) {
+			if graphQLScalarType[match[1]] && !declared[match[1]] {
+				declared[match[1]] = true
+				scalars = append(scalars, match[1])
+			}
+		}
+		sort.Strings(scalars)
+		var declaration string
+		for _, scalar := range scalars {
+			declaration += fmt.Sprintf("\nscalar %s\n", scalar)
+		}
+		return []byte(fmt.Sprintf("%s\n%s%s", strings.Replace(copyright, "//", "#", -1), declaration, field)), nil
+	})
+}
+
+func genGraphQLFieldName(name string) (fieldName string) {
+	for _, str := range strings.Split(name, ":") {
+		fieldName += MakeFirstUpperCase(str)
+	}
+	var tmp string
+	for _, str := range strings.Split(fieldName, ".") {
+		tmp += MakeFirstUpperCase(str)
+	}
+	fieldName = tmp
+	fieldName = strings.Replace(strings.Replace(fieldName, "-", "", -1), "_", "", -1)
+	return
+}
+
+// genGraphQLPropertyName generates the lower camel case field name of the
+// object type by given name.
+func genGraphQLPropertyName(name string) string {
+	fieldName := genGraphQLFieldName(name)
+	if fieldName == "" {
+		return fieldName
+	}
+	return strings.ToLower(fieldName[:1]) + fieldName[1:]
+}
+
+func genGraphQLFieldType(name string) string {
+	if _, ok := graphQLBuildInType[name]; ok {
+		return name
+	}
+	if _, ok := graphQLScalarType[name]; ok {
+		return name
+	}
+	var fieldType string
+	for _, str := range strings.Split(name, ".") {
+		fieldType += MakeFirstUpperCase(str)
+	}
+	fieldType = strings.Replace(MakeFirstUpperCase(strings.Replace(fieldType, "-", "", -1)), "_", "", -1)
+	if fieldType != "" {
+		return fieldType
+	}
+	return "String"
+}
+
+// genGraphQLEnumName generates the upper case enum value by given
+// enumeration value, characters which are not allowed in the name will be
+// replaced with underscores.
+func genGraphQLEnumName(value string) string {
+	enumName := strings.ToUpper(strings.Join(strings.FieldsFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}), "_"))
+	if enumName == "" || enumName[0] >= '0' && enumName[0] <= '9' {
+		return "VALUE_" + enumName
+	}
+	return enumName
+}
+
+// genGraphQLTypeRef generates the type reference of the field by given type
+// name in the schema. Simple types without enumerations have no counterpart
+// in GraphQL, so their base types are used instead, and simple types derived
+// by list are converted to the list types.
+func (gen *CodeGenerator) genGraphQLTypeRef(name string) string {
+	name = trimNSPrefix(name)
+	for _, ele := range gen.ProtoTree {
+		if v, ok := ele.(*SimpleType); ok && v.Name == name {
+			if v.List {
+				if len(v.Restriction.Enum) > 0 {
+					return fmt.Sprintf("[%s!]", gen.genGraphQLListItemName(v.Name))
+				}
+				if base := trimNSPrefix(v.Base); isEnumSimpleType(base, gen.ProtoTree) {
+					return fmt.Sprintf("[%s!]", genGraphQLFieldName(base))
+				}
+				return fmt.Sprintf("[%s!]", genGraphQLFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base))))
+			}
+			if len(v.Restriction.Enum) > 0 || v.Union {
+				return genGraphQLFieldName(v.Name)
+			}
+			break
+		}
+	}
+	return genGraphQLFieldType(gen.getBasefromSimpleType(name))
+}
+
+// genGraphQLListItemName generates the name of the enum type declared for
+// the anonymous enumeration items of the list simple type by given name of
+// the list, which is the name without the List suffix, or the name suffixed
+// with Item if there is no such suffix or the name is taken by another type.
+func (gen *CodeGenerator) genGraphQLListItemName(name string) string {
+	itemName := strings.TrimSuffix(name, "List")
+	if itemName == "" || itemName == name || isEnumSimpleType(itemName, gen.ProtoTree) || gen.isComplexType(itemName) {
+		itemName = name + "Item"
+	}
+	return genGraphQLFieldName(itemName)
+}
+
+// genGraphQLField generates the field definition of the object type, the
+// non-null modifier is omitted for the optional fields, and the repeating
+// fields are declared as list types.
+func genGraphQLField(name, fieldType string, plural, optional bool) string {
+	if plural {
+		fieldType = fmt.Sprintf("[%s!]", fieldType)
+	}
+	if !optional {
+		fieldType += "!"
+	}
+	return fmt.Sprintf("\t%s: %s\n", genGraphQLPropertyName(name), fieldType)
+}
+
+// GraphQLSimpleType generates code for simple type XML schema in GraphQL
+// language syntax. The anonymous enumeration items of the list are declared
+// as the enum type named after the items.
+func (gen *CodeGenerator) GraphQLSimpleType(v *SimpleType) {
+	if v.Union && len(v.MemberTypes) > 0 {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			gen.StructAST[v.Name] = fmt.Sprintf("\nscalar %s\n", genGraphQLFieldName(v.Name))
+			gen.Field += gen.StructAST[v.Name]
+		}
+		return
+	}
+	if len(v.Restriction.Enum) > 0 {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			var content string
+			for _, enum := range v.Restriction.Enum {
+				content += fmt.Sprintf("\t%s\n", genGraphQLEnumName(enum))
+			}
+			enumName := genGraphQLFieldName(v.Name)
+			if v.List {
+				enumName = gen.genGraphQLListItemName(v.Name)
+			}
+			gen.StructAST[v.Name] = fmt.Sprintf("\nenum %s {\n%s}\n", enumName, content)
+			gen.Field += gen.StructAST[v.Name]
+		}
+	}
+	return
+}
+
+// GraphQLComplexType generates code for complex type XML schema in GraphQL
+// language syntax.
+func (gen *CodeGenerator) GraphQLComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var content string
+		for _, attrGroup := range v.AttributeGroup {
+			content += genGraphQLField(attrGroup.Name, gen.genGraphQLTypeRef(attrGroup.Ref), false, false)
+		}
+
+		// Object types can't be derived in GraphQL, the fields of the base
+		// types are included in the derived type instead.
+		elements, attributes := gen.complexTypeContent(v)
+
+		for _, attribute := range attributes {
+			content += genGraphQLField(attribute.Name+"Attr", gen.genGraphQLTypeRef(attribute.Type), attribute.Plural, attribute.Optional)
+		}
+
+		for _, group := range v.Groups {
+			content += genGraphQLField(group.Name, gen.genGraphQLTypeRef(group.Ref), group.Plural, false)
+		}
+
+		for _, element := range elements {
+			content += genGraphQLField(element.Name, gen.genGraphQLTypeRef(element.Type), element.Plural, element.Optional)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf("\ntype %s {\n%s}\n", genGraphQLFieldName(v.Name), content)
+		gen.Field += gen.StructAST[v.Name]
+	}
+	return
+}
+
+// GraphQLGroup generates code for group XML schema in GraphQL language
+// syntax.
+func (gen *CodeGenerator) GraphQLGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var content string
+		for _, element := range v.Elements {
+			content += genGraphQLField(element.Name, gen.genGraphQLTypeRef(element.Type), element.Plural, element.Optional)
+		}
+
+		for _, group := range v.Groups {
+			content += genGraphQLField(group.Name, gen.genGraphQLTypeRef(group.Ref), group.Plural, false)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf("\ntype %s {\n%s}\n", genGraphQLFieldName(v.Name), content)
+		gen.Field += gen.StructAST[v.Name]
+	}
+	return
+}
+
+// GraphQLAttributeGroup generates code for attribute group XML schema in
+// GraphQL language syntax.
+func (gen *CodeGenerator) GraphQLAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var content string
+		for _, attribute := range v.Attributes {
+			content += genGraphQLField(attribute.Name+"Attr", gen.genGraphQLTypeRef(attribute.Type), attribute.Plural, attribute.Optional)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf("\ntype %s {\n%s}\n", genGraphQLFieldName(v.Name), content)
+		gen.Field += gen.StructAST[v.Name]
+	}
+	return
+}
@@ -0,0 +1,298 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GenJSONSchema generate JSON Schema (draft 2020-12) document for XML schema
+// definition files, all of the definitions are declared in the $defs of the
+// document and referenced by each other.
+func (gen *CodeGenerator) GenJSONSchema() error {
+	gen.genProtoTree("JSONSchema")
+	return gen.writeSource(".json", genOpenAPIFieldName, func(path, field string) ([]byte, error) {
+		defs := "{}"
+		if field != "" {
+			defs = fmt.Sprintf("{\n%s\n\t}", strings.TrimSuffix(field, ",\n"))
+		}
+		return []byte(fmt.Sprintf("{\n\t\"$schema\": \"https://json-schema.org/draft/2020-12/schema\",\n\t\"$comment\": \"DO NOT EDIT: generated by xgen XSD generator\",\n\t\"title\": %s,\n\t\"$defs\": %s\n}\n",
+			genJSONString(filepath.Base(path)), defs)), nil
+	})
+}
+
+// genJSONString returns the JSON string literal of the value.
+func genJSONString(value string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(value)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// genJSONSchemaObject generates the JSON object by given members at the
+// indentation depth.
+func genJSONSchemaObject(members []string, depth int) string {
+	if len(members) == 0 {
+		return "{}"
+	}
+	indent := strings.Repeat("\t", depth)
+	return fmt.Sprintf("{\n%s\t%s\n%s}", indent, strings.Join(members, ",\n\t"+indent), indent)
+}
+
+// genJSONSchemaType returns the members of the schema of the type by given
+// name at the indentation depth. The build-in types are declared with the
+// type and format, the types with the "[]" prefix are declared as arrays,
+// and the other types are referenced to the definitions.
+func genJSONSchemaType(name string, depth int) []string {
+	if name == "" {
+		name = "string"
+	}
+	if strings.HasPrefix(name, "[]") {
+		return []string{`"type": "array"`, `"items": ` + genJSONSchemaObject(genJSONSchemaType(name[2:], depth+1), depth+1)}
+	}
+	parts := strings.SplitN(name, "/", 2)
+	if !openAPIBuildInType[parts[0]] {
+		return []string{fmt.Sprintf(`"$ref": %s`, genJSONString("#/$defs/"+genOpenAPIFieldName(trimNSPrefix(name))))}
+	}
+	members := []string{fmt.Sprintf(`"type": %s`, genJSONString(parts[0]))}
+	if len(parts) == 2 {
+		members = append(members, fmt.Sprintf(`"format": %s`, genJSONString(parts[1])))
+	}
+	return members
+}
+
+// genJSONSchemaProperties generates the members of the object schema by
+// given properties at the indentation depth, the required properties are
+// listed in the required member of the schema.
+func genJSONSchemaProperties(properties []openAPIProperty, depth int) []string {
+	members := []string{`"type": "object"`}
+	if len(properties) == 0 {
+		return members
+	}
+	var fields, required []string
+	for _, property := range properties {
+		fieldType := property.Type
+		if property.Plural {
+			fieldType = "[]" + fieldType
+		}
+		fields = append(fields, fmt.Sprintf("%s: %s", genJSONString(property.Name), genJSONSchemaObject(genJSONSchemaType(fieldType, depth+2), depth+2)))
+		if property.Required {
+			required = append(required, genJSONString(property.Name))
+		}
+	}
+	members = append(members, `"properties": `+genJSONSchemaObject(fields, depth+1))
+	if len(required) > 0 {
+		members = append(members, fmt.Sprintf(`"required": [%s]`, strings.Join(required, ", ")))
+	}
+	return members
+}
+
+// genJSONSchemaEnum returns the JSON literal of the enumeration value of the
+// simple type by given JSON type of its base type, the values which can't be
+// represented by the type are declared as strings.
+func genJSONSchemaEnum(value, baseType string) string {
+	switch baseType {
+	case "integer", "number":
+		if f, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+			return strconv.FormatFloat(f, 'g', -1, 64)
+		}
+	case "boolean":
+		switch strings.TrimSpace(value) {
+		case "true", "1":
+			return "true"
+		case "false", "0":
+			return "false"
+		}
+	}
+	return genJSONString(value)
+}
+
+// genJSONSchemaFacets returns the members of the schema declaring the facets
+// of the restriction, the lengths of the lists are declared as the number of
+// the items.
+func genJSONSchemaFacets(restriction Restriction, baseType string, list bool) (members []string) {
+	if list {
+		if restriction.MinLength > 0 {
+			members = append(members, fmt.Sprintf(`"minItems": %d`, restriction.MinLength))
+		}
+		if restriction.HasMaxLength {
+			members = append(members, fmt.Sprintf(`"maxItems": %d`, restriction.MaxLength))
+		}
+		return
+	}
+	if len(restriction.Enum) > 0 {
+		var enums []string
+		for _, enum := range restriction.Enum {
+			enums = append(enums, genJSONSchemaEnum(enum, baseType))
+		}
+		members = append(members, fmt.Sprintf(`"enum": [%s]`, strings.Join(enums, ", ")))
+	}
+	if restriction.MinLength > 0 {
+		members = append(members, fmt.Sprintf(`"minLength": %d`, restriction.MinLength))
+	}
+	if restriction.HasMaxLength {
+		members = append(members, fmt.Sprintf(`"maxLength": %d`, restriction.MaxLength))
+	}
+	if restriction.Pattern != nil {
+		members = append(members, fmt.Sprintf(`"pattern": %s`, genJSONString(restriction.Pattern.String())))
+	}
+	if restriction.HasMin {
+		keyword := "minimum"
+		if restriction.MinExclusive {
+			keyword = "exclusiveMinimum"
+		}
+		members = append(members, fmt.Sprintf(`"%s": %s`, keyword, strconv.FormatFloat(restriction.Min, 'g', -1, 64)))
+	}
+	if restriction.HasMax {
+		keyword := "maximum"
+		if restriction.MaxExclusive {
+			keyword = "exclusiveMaximum"
+		}
+		members = append(members, fmt.Sprintf(`"%s": %s`, keyword, strconv.FormatFloat(restriction.Max, 'g', -1, 64)))
+	}
+	return
+}
+
+// genJSONSchemaDef appends the definition by given name and members of the
+// schema to the generated code.
+func (gen *CodeGenerator) genJSONSchemaDef(name string, members []string) {
+	gen.StructAST[name] = fmt.Sprintf("\t\t%s: %s,\n", genJSONString(genOpenAPIFieldName(name)), genJSONSchemaObject(members, 2))
+	gen.Field += gen.StructAST[name]
+}
+
+// JSONSchemaSimpleType generates code for simple type XML schema in JSON
+// Schema document. The facets of the restriction are declared by the
+// validation keywords of JSON Schema along with its base type.
+func (gen *CodeGenerator) JSONSchemaSimpleType(v *SimpleType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	baseType := strings.SplitN(gen.getBasefromSimpleType(trimNSPrefix(v.Base)), "/", 2)[0]
+	var members []string
+	switch {
+	case v.List:
+		members = append(genJSONSchemaType("[]"+v.Base, 2), genJSONSchemaFacets(v.Restriction, baseType, true)...)
+	case v.Union && len(v.MemberTypes) > 0:
+		var memberNames, memberSchemas []string
+		for memberName := range v.MemberTypes {
+			memberNames = append(memberNames, memberName)
+		}
+		sort.Strings(memberNames)
+		for _, memberName := range memberNames {
+			memberType := v.MemberTypes[memberName]
+			if memberType == "" { // fix order issue
+				memberType = gen.getBasefromSimpleType(memberName)
+			}
+			memberSchemas = append(memberSchemas, genJSONSchemaObject(genJSONSchemaType(memberType, 4), 4))
+		}
+		members = []string{fmt.Sprintf("\"anyOf\": [\n\t\t\t\t%s\n\t\t\t]", strings.Join(memberSchemas, ",\n\t\t\t\t"))}
+	default:
+		members = append(genJSONSchemaType(v.Base, 2), genJSONSchemaFacets(v.Restriction, baseType, false)...)
+	}
+	gen.genJSONSchemaDef(v.Name, members)
+}
+
+// JSONSchemaComplexType generates code for complex type XML schema in JSON
+// Schema document. The complex type derived by extension of a complex type
+// is declared as the combination of the base type and its own properties.
+func (gen *CodeGenerator) JSONSchemaComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var properties []openAPIProperty
+	for _, attrGroup := range v.AttributeGroup {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(attrGroup.Name), Type: attrGroup.Ref, Required: true})
+	}
+
+	for _, attribute := range v.Attributes {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(attribute.Name + "Attr"), Type: attribute.Type, Plural: attribute.Plural, Required: !attribute.Optional})
+	}
+
+	for _, group := range v.Groups {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(group.Name), Type: group.Ref, Plural: group.Plural, Required: true})
+	}
+
+	for _, element := range v.Elements {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(element.Name), Type: element.Type, Plural: element.Plural, Required: !element.Optional})
+	}
+	base := trimNSPrefix(v.Base)
+	if v.Mixed {
+		properties = append(properties, openAPIProperty{Name: "value", Type: "string"})
+	} else if base != "" && !gen.isComplexType(base) {
+		properties = append(properties, openAPIProperty{Name: "value", Type: base, Required: true})
+	}
+	members := genJSONSchemaProperties(properties, 2)
+	if v.Extension && gen.isComplexType(base) {
+		members = []string{fmt.Sprintf("\"allOf\": [\n\t\t\t\t%s,\n\t\t\t\t%s\n\t\t\t]", genJSONSchemaObject(genJSONSchemaType(base, 4), 4), genJSONSchemaObject(genJSONSchemaProperties(properties, 4), 4))}
+	}
+	gen.genJSONSchemaDef(v.Name, members)
+}
+
+// JSONSchemaGroup generates code for group XML schema in JSON Schema
+// document.
+func (gen *CodeGenerator) JSONSchemaGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var properties []openAPIProperty
+	for _, element := range v.Elements {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(element.Name), Type: element.Type, Plural: element.Plural, Required: !element.Optional})
+	}
+
+	for _, group := range v.Groups {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(group.Name), Type: group.Ref, Plural: group.Plural, Required: true})
+	}
+	gen.genJSONSchemaDef(v.Name, genJSONSchemaProperties(properties, 2))
+}
+
+// JSONSchemaAttributeGroup generates code for attribute group XML schema in
+// JSON Schema document.
+func (gen *CodeGenerator) JSONSchemaAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var properties []openAPIProperty
+	for _, attribute := range v.Attributes {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(attribute.Name + "Attr"), Type: attribute.Type, Plural: attribute.Plural, Required: !attribute.Optional})
+	}
+	gen.genJSONSchemaDef(v.Name, genJSONSchemaProperties(properties, 2))
+}
+
+// JSONSchemaElement generates code for element XML schema in JSON Schema
+// document.
+func (gen *CodeGenerator) JSONSchemaElement(v *Element) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	fieldType := v.Type
+	if v.Plural {
+		fieldType = "[]" + fieldType
+	}
+	gen.genJSONSchemaDef(v.Name, genJSONSchemaType(fieldType, 2))
+}
+
+// JSONSchemaAttribute generates code for attribute XML schema in JSON Schema
+// document.
+func (gen *CodeGenerator) JSONSchemaAttribute(v *Attribute) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	fieldType := v.Type
+	if v.Plural {
+		fieldType = "[]" + fieldType
+	}
+	gen.genJSONSchemaDef(v.Name, genJSONSchemaType(fieldType, 2))
+}
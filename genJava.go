@@ -10,55 +10,73 @@ package xgen
 
 import (
 	"fmt"
-	"os"
-	"reflect"
+	"sort"
 	"strings"
 )
 
 var javaBuildInType = map[string]bool{
-	"Boolean":      true,
-	"Byte":         true,
-	"Character":    true,
-	"List<String>": true,
-	"List<Byte>":   true,
-	"Float":        true,
-	"Integer":      true,
-	"Short":        true,
-	"String":       true,
-	"QName":        true,
-	"Long":         true,
+	"Boolean":                     true,
+	"Byte":                        true,
+	"Character":                   true,
+	"List<String>":                true,
+	"List<Byte>":                  true,
+	"Float":                       true,
+	"Integer":                     true,
+	"Short":                       true,
+	"String":                      true,
+	"QName":                       true,
+	"Long":                        true,
+	"Object":                      true,
+	"javax.xml.datatype.Duration": true,
 }
 
 // GenJava generate Java programming language source code for XML schema
-// definition files.
+// definition files. Simple types with enumerations are declared as the JAXB
+// enums, and the substitution groups are declared as the sealed interfaces
+// implemented by the classes of their members, which requires Java 17. The
+// abstract complex types are declared as the abstract classes extended by
+// the classes of the types derived from them by extension.
 func (gen *CodeGenerator) GenJava() error {
-	for _, ele := range gen.ProtoTree {
-		if ele == nil {
-			continue
-		}
-		funcName := fmt.Sprintf("Java%s", reflect.TypeOf(ele).String()[6:])
-		callFuncByName(gen, funcName, []reflect.Value{reflect.ValueOf(ele)})
-	}
-	f, err := os.Create(gen.File + ".java")
-	if err != nil {
-		return err
-	}
-	defer f.Close()
+	gen.genProtoTree("Java")
 	packageName := gen.Package
 	if packageName == "" {
 		packageName = "schema"
 	}
-	var importPackage = `import java.util.ArrayList;
-import java.util.List;
-import javax.xml.bind.annotation.XmlAccessType;
-import javax.xml.bind.annotation.XmlAccessorType;
-import javax.xml.bind.annotation.XmlAttribute;
-import javax.xml.bind.annotation.XmlElement;
-import javax.xml.bind.annotation.XmlSchemaType;
-import javax.xml.bind.annotation.XmlType;`
+	return gen.writeSource(".java", genJavaFieldName, func(path, field string) ([]byte, error) {
+		imports := []string{
+			"java.util.ArrayList",
+			"java.util.List",
+			"javax.xml.bind.annotation.XmlAccessType",
+			"javax.xml.bind.annotation.XmlAccessorType",
+			"javax.xml.bind.annotation.XmlAttribute",
+			"javax.xml.bind.annotation.XmlElement",
+			"javax.xml.bind.annotation.XmlMixed",
+			"javax.xml.bind.annotation.XmlRootElement",
+			"javax.xml.bind.annotation.XmlSchemaType",
+			"javax.xml.bind.annotation.XmlType",
+		}
+		for annotation, packages := range javaAnnotationImports {
+			if strings.Contains(field, annotation) {
+				imports = append(imports, packages...)
+			}
+		}
+		sort.Strings(imports)
+		var importPackage string
+		for _, name := range imports {
+			importPackage += fmt.Sprintf("import %s;\n", name)
+		}
+		return []byte(fmt.Sprintf("%s\n\npackage %s;\n\n%s%s", copyright, packageName, importPackage, field)), nil
+	})
+}
 
-	f.Write([]byte(fmt.Sprintf("%s\n\npackage %s;\n\n%s\n%s", copyright, packageName, importPackage, gen.Field)))
-	return err
+// javaAnnotationImports defines the packages imported if the annotation is
+// used in the generated code, in addition to the ones always imported.
+var javaAnnotationImports = map[string][]string{
+	"@XmlAnyAttribute\n": {"java.util.Map", "javax.xml.bind.annotation.XmlAnyAttribute", "javax.xml.namespace.QName"},
+	"@XmlAnyElement(":    {"javax.xml.bind.annotation.XmlAnyElement"},
+	"@XmlElements(":      {"javax.xml.bind.annotation.XmlElements"},
+	"@XmlEnum\n":         {"javax.xml.bind.annotation.XmlEnum", "javax.xml.bind.annotation.XmlEnumValue"},
+	"@XmlSeeAlso(":       {"javax.xml.bind.annotation.XmlSeeAlso"},
 }
 
 func genJavaFieldName(name string) (fieldName string) {
@@ -89,39 +107,242 @@ func genJavaFieldType(name string) string {
 	return "void"
 }
 
+// genJavaEnumName generates the upper case enum constant by given
+// enumeration value, characters which are not allowed in the identifier will
+// be replaced with underscores.
+func genJavaEnumName(value string) string {
+	enumName := strings.ToUpper(strings.Join(strings.FieldsFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}), "_"))
+	if enumName == "" || enumName[0] >= '0' && enumName[0] <= '9' {
+		return "VALUE_" + enumName
+	}
+	return enumName
+}
+
+// genJavaType returns the type of the field by given type name, the
+// enumerations are declared as the Java enums.
+func (gen *CodeGenerator) genJavaType(name string) string {
+	return gen.getEnumOrBaseType(name)
+}
+
+// genJavaWildcardField returns the field which holds the elements matched by
+// the wildcard element, the elements of the classes known to the JAXB
+// context are unmarshaled into them, and others are kept as DOM elements.
+func genJavaWildcardField(element Element) javaField {
+	fieldType := "Object"
+	if element.Plural {
+		fieldType = "List<Object>"
+	}
+	return javaField{Annotation: "@XmlAnyElement(lax = true)", Type: fieldType, Name: genJavaFieldName(element.Name)}
+}
+
+// genJavaSeeAlso generates the XmlSeeAlso annotation of the abstract complex
+// type, which lists the classes extending it, so that they can be selected by
+// the xsi:type attribute when unmarshaling.
+func (gen *CodeGenerator) genJavaSeeAlso(v *ComplexType) string {
+	if !v.Abstract {
+		return ""
+	}
+	var classes []string
+	for _, ele := range gen.ProtoTree {
+		if derived, ok := ele.(*ComplexType); ok && gen.getAbstractBase(derived) == v.Name {
+			classes = append(classes, genJavaFieldName(derived.Name)+".class")
+		}
+	}
+	if len(classes) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("@XmlSeeAlso({%s})\n", strings.Join(classes, ", "))
+}
+
+// genJavaSubstitutionTypes returns the classes of the members of the
+// substitution group headed by the element, or nil if the element isn't the
+// head of a substitution group or any of the members isn't declared with a
+// complex type, which can't implement the interface of the group.
+func (gen *CodeGenerator) genJavaSubstitutionTypes(element Element) (types []string) {
+	head, members := gen.substitutionGroup(element)
+	if head == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	for _, member := range members {
+		typeName := trimNSPrefix(member.Type)
+		if !gen.isComplexType(typeName) {
+			return nil
+		}
+		if !seen[typeName] {
+			seen[typeName] = true
+			types = append(types, genJavaFieldName(typeName))
+		}
+	}
+	return
+}
+
+// genJavaSubstitutionInterfaces returns the interfaces of the substitution
+// groups which have a member declared with the complex type.
+func (gen *CodeGenerator) genJavaSubstitutionInterfaces(typeName string) (interfaces []string) {
+	for _, ele := range gen.ProtoTree {
+		if head, ok := ele.(*Element); ok {
+			for _, memberType := range gen.genJavaSubstitutionTypes(*head) {
+				if memberType == genJavaFieldName(typeName) {
+					interfaces = append(interfaces, genJavaFieldName(head.Name)+"Substitution")
+				}
+			}
+		}
+	}
+	return
+}
+
+// genJavaSubstitutionField returns the field holding the elements of the
+// substitution group referenced by the child element of the complex type, the
+// class of the value is selected by the name of the element.
+func (gen *CodeGenerator) genJavaSubstitutionField(element Element) (field javaField, ok bool) {
+	if len(gen.genJavaSubstitutionTypes(element)) == 0 {
+		return
+	}
+	head, members := gen.substitutionGroup(element)
+	var annotations []string
+	for _, member := range members {
+		annotations = append(annotations, fmt.Sprintf("@XmlElement(name = \"%s\", type = %s.class)", member.Name, genJavaFieldName(trimNSPrefix(member.Type))))
+	}
+	fieldType := genJavaFieldName(head.Name) + "Substitution"
+	if element.Plural {
+		fieldType = fmt.Sprintf("List<%s>", fieldType)
+	}
+	return javaField{Annotation: fmt.Sprintf("@XmlElements({%s})", strings.Join(annotations, ", ")), Type: fieldType, Name: genJavaFieldName(element.Name)}, true
+}
+
+// genJavaNamespace generates the namespace parameter of the JAXB annotations
+// by the target namespace of the schema.
+func (gen *CodeGenerator) genJavaNamespace() string {
+	if gen.Namespace == "" {
+		return ""
+	}
+	return fmt.Sprintf(", namespace = \"%s\"", gen.Namespace)
+}
+
+// genJavaDeclName generates the name and namespace parameters of the JAXB
+// annotations for the field of the element or attribute declaration, the
+// namespace is given if the declaration is qualified by its form.
+func genJavaDeclName(name, namespace string) string {
+	if namespace == "" {
+		return fmt.Sprintf("name = \"%s\"", name)
+	}
+	return fmt.Sprintf("name = \"%s\", namespace = \"%s\"", trimNSPrefix(name), namespace)
+}
+
+// genJavaRequired generates the required parameter of the JAXB annotations.
+func genJavaRequired(optional bool) string {
+	if optional {
+		return "required = false"
+	}
+	return "required = true"
+}
+
+// genJavaNillable returns the nillable argument of the XmlElement annotation
+// for the nillable element, which is marshaled with xsi:nil if it's null.
+func genJavaNillable(nillable bool) string {
+	if nillable {
+		return ", nillable = true"
+	}
+	return ""
+}
+
+// javaField defines a field of the generated Java class.
+type javaField struct {
+	Doc        string
+	Annotation string
+	Type       string
+	Name       string
+}
+
+// genJavaClassBody generates the class body with the fields. The fields are
+// protected by default, if the JavaAccessors of the code generator is set,
+// the fields are private and accessed by the JavaBean-style getters and
+// setters. The list fields only have a getter, which creates the list if it
+// is null, as the classes generated by JAXB.
+func (gen *CodeGenerator) genJavaClassBody(fields []javaField) string {
+	modifier := "protected"
+	if gen.JavaAccessors {
+		modifier = "private"
+	}
+	content := " {\n"
+	for _, field := range fields {
+		content += genBlockDocComment(field.Doc, "\t")
+		if field.Annotation != "" {
+			content += fmt.Sprintf("\t%s\n", field.Annotation)
+		}
+		content += fmt.Sprintf("\t%s %s %s;\n", modifier, field.Type, field.Name)
+	}
+	if gen.JavaAccessors {
+		for _, field := range fields {
+			if strings.HasPrefix(field.Type, "List<") {
+				content += fmt.Sprintf("\n\tpublic %s get%s() {\n\t\tif (%s == null) {\n\t\t\t%s = new ArrayList<%s>();\n\t\t}\n\t\treturn this.%s;\n\t}\n",
+					field.Type, field.Name, field.Name, field.Name, field.Type[5:len(field.Type)-1], field.Name)
+				continue
+			}
+			getter := "get"
+			if field.Type == "Boolean" {
+				getter = "is"
+			}
+			content += fmt.Sprintf("\n\tpublic %s %s%s() {\n\t\treturn %s;\n\t}\n", field.Type, getter, field.Name, field.Name)
+			content += fmt.Sprintf("\n\tpublic void set%s(%s value) {\n\t\tthis.%s = value;\n\t}\n", field.Name, field.Type, field.Name)
+		}
+	}
+	return content + "}\n"
+}
+
 // JavaSimpleType generates code for simple type XML schema in Java language
 // syntax.
 func (gen *CodeGenerator) JavaSimpleType(v *SimpleType) {
 	if v.List {
 		if _, ok := gen.StructAST[v.Name]; !ok {
-			fieldType := genJavaFieldType(getBasefromSimpleType(trimNSPrefix(v.Base), gen.ProtoTree))
+			fieldType := genJavaFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))
 			content := fmt.Sprintf("\tprotected List<%s> %s;\n", fieldType, genJavaFieldName(v.Name))
 			gen.StructAST[v.Name] = content
-			gen.Field += fmt.Sprintf("\n@XmlAccessorType(XmlAccessType.FIELD)\n@XmlAttribute(required = true, name = \"%s\")\npublic class %s {\n%s}\n", v.Name, genJavaFieldName(v.Name), gen.StructAST[v.Name])
+			gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\n@XmlAccessorType(XmlAccessType.FIELD)\n@XmlAttribute(required = true, name = \"%s\")\npublic class %s {\n%s}\n", v.Name, genJavaFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), genBlockDocComment(v.Doc, ""))
 			return
 		}
 	}
 	if v.Union && len(v.MemberTypes) > 0 {
 		if _, ok := gen.StructAST[v.Name]; !ok {
 			content := " {\n"
-			for memberName, memberType := range v.MemberTypes {
+			for _, memberName := range v.Members {
+				memberType := v.MemberTypes[memberName]
 				if memberType == "" { // fix order issue
-					memberType = getBasefromSimpleType(memberName, gen.ProtoTree)
+					memberType = gen.getBasefromSimpleType(memberName)
 				}
 				fieldType := genJavaFieldType(memberType)
 				content += fmt.Sprintf("\t@XmlElement(required = true)\n\tprotected %s %s;\n", fieldType, genJavaFieldName(memberName))
 			}
 			content += "}\n"
 			gen.StructAST[v.Name] = content
-			gen.Field += fmt.Sprintf("\npublic class %s%s", genJavaFieldName(v.Name), gen.StructAST[v.Name])
+			gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\npublic class %s%s", genJavaFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), genBlockDocComment(v.Doc, ""))
+		}
+		return
+	}
+	if len(v.Restriction.Enum) > 0 {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			var constants []string
+			names := map[string]int{}
+			for _, enum := range v.Restriction.Enum {
+				enumName := genJavaEnumName(enum)
+				if names[enumName]++; names[enumName] > 1 {
+					enumName = fmt.Sprintf("%s_%d", enumName, names[enumName])
+				}
+				constants = append(constants, fmt.Sprintf("\t@XmlEnumValue(%q)\n\t%s", enum, enumName))
+			}
+			gen.StructAST[v.Name] = fmt.Sprintf(" {\n%s\n}\n", strings.Join(constants, ",\n"))
+			gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\n@XmlType(name = \"%s\"%s)\n@XmlEnum\npublic enum %s%s", v.Name, gen.genJavaNamespace(), genJavaFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), genBlockDocComment(v.Doc, ""))
 		}
 		return
 	}
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		fieldType := genJavaFieldType(getBasefromSimpleType(trimNSPrefix(v.Base), gen.ProtoTree))
+		fieldType := genJavaFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))
 		content := fmt.Sprintf("\tprotected %s %s;\n", fieldType, genJavaFieldName(v.Name))
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\n@XmlAccessorType(XmlAccessType.FIELD)\n@XmlAttribute(required = true, name = \"%s\")\npublic class %s {\n%s}\n", v.Name, genJavaFieldName(v.Name), gen.StructAST[v.Name])
+		gen.Field += withDocComment(withAssertionComment(withDerivationComment(fmt.Sprintf("\n@XmlAccessorType(XmlAccessType.FIELD)\n@XmlAttribute(required = true, name = \"%s\")\npublic class %s {\n%s}\n", v.Name, genJavaFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), v.Restriction.Assertions), genBlockDocComment(v.Doc, ""))
 	}
 	return
 }
@@ -130,38 +351,70 @@ func (gen *CodeGenerator) JavaSimpleType(v *SimpleType) {
 // syntax.
 func (gen *CodeGenerator) JavaComplexType(v *ComplexType) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		content := " {\n"
+		var fields []javaField
 		for _, attrGroup := range v.AttributeGroup {
-			fieldType := getBasefromSimpleType(trimNSPrefix(attrGroup.Ref), gen.ProtoTree)
-			content += fmt.Sprintf("\t@XmlElement(required = true)\n\tprotected %s %s;\n", genJavaFieldType(fieldType), genJavaFieldName(attrGroup.Name))
+			fieldType := gen.getBasefromSimpleType(trimNSPrefix(attrGroup.Ref))
+			fields = append(fields, javaField{Annotation: "@XmlElement(required = true)", Type: genJavaFieldType(fieldType), Name: genJavaFieldName(attrGroup.Name)})
 		}
 
 		for _, attribute := range v.Attributes {
-			var required = ", required = true"
-			if attribute.Optional {
-				required = ""
-			}
-			fieldType := genJavaFieldType(getBasefromSimpleType(trimNSPrefix(attribute.Type), gen.ProtoTree))
-			content += fmt.Sprintf("\t@XmlAttribute(name = \"%s\"%s)\n\tprotected %sAttr %s;\n", attribute.Name, required, fieldType, genJavaFieldName(attribute.Name))
+			fieldType := genJavaFieldType(gen.genJavaType(attribute.Type))
+			fields = append(fields, javaField{Doc: attribute.Doc, Annotation: fmt.Sprintf("@XmlAttribute(%s, %s)", genJavaDeclName(attribute.Name, attribute.Namespace), genJavaRequired(attribute.Optional)), Type: fieldType, Name: genJavaFieldName(attribute.Name) + "Attr"})
 		}
 		for _, group := range v.Groups {
-			var fieldType = genJavaFieldType(getBasefromSimpleType(trimNSPrefix(group.Ref), gen.ProtoTree))
+			var fieldType = genJavaFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref)))
 			if group.Plural {
 				fieldType = fmt.Sprintf("List<%s>", fieldType)
 			}
-			content += fmt.Sprintf("\tprotected %s %s;\n", fieldType, genJavaFieldName(group.Name))
+			fields = append(fields, javaField{Type: fieldType, Name: genJavaFieldName(group.Name)})
 		}
 
 		for _, element := range v.Elements {
-			fieldType := genJavaFieldType(getBasefromSimpleType(trimNSPrefix(element.Type), gen.ProtoTree))
+			if element.Wildcard {
+				fields = append(fields, genJavaWildcardField(element))
+				continue
+			}
+			if field, ok := gen.genJavaSubstitutionField(element); ok {
+				fields = append(fields, field)
+				continue
+			}
+			fieldType := genJavaFieldType(gen.genJavaType(element.Type))
 			if element.Plural {
 				fieldType = fmt.Sprintf("List<%s>", fieldType)
 			}
-			content += fmt.Sprintf("\t@XmlElement(required = true, name = \"%s\")\n\tprotected %s %s;\n", element.Name, fieldType, genJavaFieldName(element.Name))
+			fields = append(fields, javaField{Doc: element.Doc, Annotation: fmt.Sprintf("@XmlElement(%s, %s%s)", genJavaRequired(element.Optional), genJavaDeclName(element.Name, element.Namespace), genJavaNillable(element.Nillable)), Type: fieldType, Name: genJavaFieldName(element.Name)})
 		}
-		content += "}\n"
-		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\npublic class %s%s", genJavaFieldName(v.Name), gen.StructAST[v.Name])
+		if v.Mixed {
+			fields = append(fields, javaField{Annotation: "@XmlMixed", Type: "List<String>", Name: "Value"})
+		}
+		if v.AnyAttribute {
+			fields = append(fields, javaField{Annotation: "@XmlAnyAttribute", Type: "Map<QName, String>", Name: "OtherAttributes"})
+		}
+		gen.StructAST[v.Name] = gen.genJavaClassBody(fields)
+		declaration, modifier := genJavaFieldName(v.Name), ""
+		if v.Abstract {
+			modifier = "abstract "
+		}
+		if base := gen.getAbstractBase(v); base != "" {
+			declaration += " extends " + genJavaFieldName(base)
+		}
+		if interfaces := gen.genJavaSubstitutionInterfaces(v.Name); len(interfaces) > 0 {
+			// the classes permitted by the sealed interfaces of the
+			// substitution groups must be final or non-sealed.
+			modifier = "final "
+			if v.Abstract {
+				modifier = "abstract non-sealed "
+			}
+			declaration += " implements " + strings.Join(interfaces, ", ")
+		}
+		declaration = fmt.Sprintf("%spublic %sclass %s", gen.genJavaSeeAlso(v), modifier, declaration)
+		if v.Anonymous {
+			// the anonymous types of the local elements can't be the root
+			// elements, and they are not named in the schema.
+			gen.Field += withDocComment(withAssertionComment(withDerivationComment(fmt.Sprintf("\n@XmlAccessorType(XmlAccessType.FIELD)\n@XmlType(name = \"\"%s)\n%s%s", gen.genJavaNamespace(), declaration, gen.StructAST[v.Name]), v.Block, v.Final), v.Assertions), genBlockDocComment(v.Doc, ""))
+			return
+		}
+		gen.Field += withDocComment(withAssertionComment(withDerivationComment(fmt.Sprintf("\n@XmlAccessorType(XmlAccessType.FIELD)\n@XmlRootElement(name = \"%s\"%s)\n@XmlType(name = \"%s\"%s)\n%s%s", v.Name, gen.genJavaNamespace(), v.Name, gen.genJavaNamespace(), declaration, gen.StructAST[v.Name]), v.Block, v.Final), v.Assertions), genBlockDocComment(v.Doc, ""))
 	}
 	return
 }
@@ -169,26 +422,29 @@ func (gen *CodeGenerator) JavaComplexType(v *ComplexType) {
 // JavaGroup generates code for group XML schema in Java language syntax.
 func (gen *CodeGenerator) JavaGroup(v *Group) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		content := " {\n"
+		var fields []javaField
 		for _, element := range v.Elements {
-			var fieldType = genJavaFieldType(getBasefromSimpleType(trimNSPrefix(element.Type), gen.ProtoTree))
+			if element.Wildcard {
+				fields = append(fields, genJavaWildcardField(element))
+				continue
+			}
+			var fieldType = genJavaFieldType(gen.genJavaType(element.Type))
 			if element.Plural {
 				fieldType = fmt.Sprintf("List<%s>", fieldType)
 			}
-			content += fmt.Sprintf("\t@XmlElement(required = true, name = \"%s\")\n\tprotected %s %s;\n", element.Name, fieldType, genJavaFieldName(element.Name))
+			fields = append(fields, javaField{Doc: element.Doc, Annotation: fmt.Sprintf("@XmlElement(%s, %s%s)", genJavaRequired(element.Optional), genJavaDeclName(element.Name, element.Namespace), genJavaNillable(element.Nillable)), Type: fieldType, Name: genJavaFieldName(element.Name)})
 		}
 
 		for _, group := range v.Groups {
-			var fieldType = genJavaFieldType(getBasefromSimpleType(trimNSPrefix(group.Ref), gen.ProtoTree))
+			var fieldType = genJavaFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref)))
 			if group.Plural {
 				fieldType = fmt.Sprintf("List<%s>", fieldType)
 			}
-			content += fmt.Sprintf("\tprotected %s %s;\n", fieldType, genJavaFieldName(group.Name))
+			fields = append(fields, javaField{Type: fieldType, Name: genJavaFieldName(group.Name)})
 		}
 
-		content += "}\n"
-		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\npublic class %s%s", genJavaFieldName(v.Name), gen.StructAST[v.Name])
+		gen.StructAST[v.Name] = gen.genJavaClassBody(fields)
+		gen.Field += withDocComment(fmt.Sprintf("\npublic class %s%s", genJavaFieldName(v.Name), gen.StructAST[v.Name]), genBlockDocComment(v.Doc, ""))
 	}
 	return
 }
@@ -197,18 +453,13 @@ func (gen *CodeGenerator) JavaGroup(v *Group) {
 // syntax.
 func (gen *CodeGenerator) JavaAttributeGroup(v *AttributeGroup) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		content := " {\n"
+		var fields []javaField
 		for _, attribute := range v.Attributes {
-			var required = ", required = true"
-			if attribute.Optional {
-				required = ""
-			}
-			fieldType := genJavaFieldType(getBasefromSimpleType(trimNSPrefix(attribute.Type), gen.ProtoTree))
-			content += fmt.Sprintf("\t@XmlAttribute(name = \"%s\"%s)\n\tprotected %sAttr %s;\n", attribute.Name, required, fieldType, genJavaFieldName(attribute.Name))
+			fieldType := genJavaFieldType(gen.genJavaType(attribute.Type))
+			fields = append(fields, javaField{Doc: attribute.Doc, Annotation: fmt.Sprintf("@XmlAttribute(%s, %s)", genJavaDeclName(attribute.Name, attribute.Namespace), genJavaRequired(attribute.Optional)), Type: fieldType, Name: genJavaFieldName(attribute.Name) + "Attr"})
 		}
-		content += "}\n"
-		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\npublic class %s%s", genJavaFieldName(v.Name), gen.StructAST[v.Name])
+		gen.StructAST[v.Name] = gen.genJavaClassBody(fields)
+		gen.Field += withDocComment(fmt.Sprintf("\npublic class %s%s", genJavaFieldName(v.Name), gen.StructAST[v.Name]), genBlockDocComment(v.Doc, ""))
 	}
 	return
 }
@@ -216,13 +467,16 @@ func (gen *CodeGenerator) JavaAttributeGroup(v *AttributeGroup) {
 // JavaElement generates code for element XML schema in Java language syntax.
 func (gen *CodeGenerator) JavaElement(v *Element) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		var fieldType = genJavaFieldType(getBasefromSimpleType(trimNSPrefix(v.Type), gen.ProtoTree))
+		var fieldType = genJavaFieldType(gen.genJavaType(v.Type))
 		if v.Plural {
 			fieldType = fmt.Sprintf("List<%s>", fieldType)
 		}
 		content := fmt.Sprintf("\tprotected %s %s;\n", fieldType, genJavaFieldName(v.Name))
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\n@XmlAccessorType(XmlAccessType.FIELD)\n@XmlElement(required = true, name = \"%s\")\npublic class %s {\n%s}\n", v.Name, genJavaFieldName(v.Name), gen.StructAST[v.Name])
+		gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\n@XmlAccessorType(XmlAccessType.FIELD)\n@XmlRootElement(name = \"%s\"%s)\npublic class %s {\n%s}\n", v.Name, gen.genJavaNamespace(), genJavaFieldName(v.Name), gen.StructAST[v.Name]), v.Block, v.Final), genBlockDocComment(v.Doc, ""))
+		if types := gen.genJavaSubstitutionTypes(*v); len(types) > 0 {
+			gen.Field += fmt.Sprintf("\npublic sealed interface %sSubstitution permits %s {\n}\n", genJavaFieldName(v.Name), strings.Join(types, ", "))
+		}
 	}
 	return
 }
@@ -230,13 +484,13 @@ func (gen *CodeGenerator) JavaElement(v *Element) {
 // JavaAttribute generates code for attribute XML schema in Java language syntax.
 func (gen *CodeGenerator) JavaAttribute(v *Attribute) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		var fieldType = genJavaFieldType(getBasefromSimpleType(trimNSPrefix(v.Type), gen.ProtoTree))
+		var fieldType = genJavaFieldType(gen.genJavaType(v.Type))
 		if v.Plural {
 			fieldType = fmt.Sprintf("List<%s>", fieldType)
 		}
 		content := fmt.Sprintf("\tprotected %s %s;\n", fieldType, genJavaFieldName(v.Name))
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\n@XmlAccessorType(XmlAccessType.FIELD)\n@XmlAttribute(required = true, name = \"%s\")\npublic class %s {\n%s}\n", v.Name, genJavaFieldName(v.Name), gen.StructAST[v.Name])
+		gen.Field += withDocComment(fmt.Sprintf("\n@XmlAccessorType(XmlAccessType.FIELD)\n@XmlAttribute(required = true, name = \"%s\")\npublic class %s {\n%s}\n", v.Name, genJavaFieldName(v.Name), gen.StructAST[v.Name]), genBlockDocComment(v.Doc, ""))
 	}
 	return
 }
@@ -0,0 +1,277 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"strings"
+)
+
+var kotlinBuildInType = map[string]bool{
+	"Any":                         true,
+	"Boolean":                     true,
+	"Byte":                        true,
+	"ByteArray":                   true,
+	"Double":                      true,
+	"Float":                       true,
+	"Int":                         true,
+	"Long":                        true,
+	"Short":                       true,
+	"String":                      true,
+	"List<String>":                true,
+	"java.time.LocalDateTime":     true,
+	"javax.xml.datatype.Duration": true,
+}
+
+var kotlinKeywords = map[string]bool{
+	"as": true, "break": true, "class": true, "continue": true, "do": true,
+	"else": true, "false": true, "for": true, "fun": true, "if": true,
+	"in": true, "interface": true, "is": true, "null": true, "object": true,
+	"package": true, "return": true, "super": true, "this": true,
+	"throw": true, "true": true, "try": true, "typealias": true,
+	"typeof": true, "val": true, "var": true, "when": true, "while": true,
+}
+
+// GenKotlin generate Kotlin programming language source code for XML schema
+// definition files. Complex types are declared as data classes, simple types
+// with enumerations are declared as enum classes, and other simple types are
+// declared as type aliases.
+func (gen *CodeGenerator) GenKotlin() error {
+	gen.genProtoTree("Kotlin")
+	packageName := gen.Package
+	if packageName == "" {
+		packageName = "schema"
+	}
+	return gen.writeSource(".kt", genKotlinFieldName, func(path, field string) ([]byte, error) {
+		return []byte(fmt.Sprintf("%s\n\npackage %s\n%s", copyright, packageName, field)), nil
+	})
+}
+
+func genKotlinFieldName(name string) (fieldName string) {
+	for _, str := range strings.Split(name, ":") {
+		fieldName += MakeFirstUpperCase(str)
+	}
+	var tmp string
+	for _, str := range strings.Split(fieldName, ".") {
+		tmp += MakeFirstUpperCase(str)
+	}
+	fieldName = tmp
+	fieldName = strings.Replace(strings.Replace(fieldName, "-", "", -1), "_", "", -1)
+	return
+}
+
+// genKotlinPropertyName generates the lower camel case property name of the
+// data class by given name, keywords will be quoted with backticks.
+func genKotlinPropertyName(name string) string {
+	fieldName := genKotlinFieldName(name)
+	if fieldName == "" {
+		return fieldName
+	}
+	fieldName = strings.ToLower(fieldName[:1]) + fieldName[1:]
+	if kotlinKeywords[fieldName] {
+		return "`" + fieldName + "`"
+	}
+	return fieldName
+}
+
+func genKotlinFieldType(name string) string {
+	if _, ok := kotlinBuildInType[name]; ok {
+		return name
+	}
+	var fieldType string
+	for _, str := range strings.Split(name, ".") {
+		fieldType += MakeFirstUpperCase(str)
+	}
+	fieldType = strings.Replace(MakeFirstUpperCase(strings.Replace(fieldType, "-", "", -1)), "_", "", -1)
+	if fieldType != "" {
+		return fieldType
+	}
+	return "Any"
+}
+
+// genKotlinEnumName generates the upper case enum constant by given
+// enumeration value, characters which are not allowed in the identifier will
+// be replaced with underscores.
+func genKotlinEnumName(value string) string {
+	enumName := strings.ToUpper(strings.Join(strings.FieldsFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}), "_"))
+	if enumName == "" || enumName[0] >= '0' && enumName[0] <= '9' {
+		return "VALUE_" + enumName
+	}
+	return enumName
+}
+
+// genKotlinType returns the type of the property by given type name, the
+// enumerations are declared as the enum classes.
+func (gen *CodeGenerator) genKotlinType(name string) string {
+	return gen.getEnumOrBaseType(name)
+}
+
+// genKotlinDataClass generates the data class declaration by given name and
+// properties, the class without properties is declared as a regular class
+// since data classes require at least one property.
+func genKotlinDataClass(name string, properties []string) string {
+	if len(properties) == 0 {
+		return fmt.Sprintf("\nclass %s\n", name)
+	}
+	return fmt.Sprintf("\ndata class %s(\n\tval %s\n)\n", name, strings.Join(properties, ",\n\tval "))
+}
+
+// genKotlinProperty generates the property of the data class, the optional
+// properties are declared as the nullable types, and the repeating ones are
+// declared as lists.
+func genKotlinProperty(name, fieldType string, plural, optional bool) string {
+	fieldType = genKotlinFieldType(fieldType)
+	if plural {
+		return fmt.Sprintf("%s: List<%s> = emptyList()", genKotlinPropertyName(name), fieldType)
+	}
+	if optional {
+		return fmt.Sprintf("%s: %s? = null", genKotlinPropertyName(name), fieldType)
+	}
+	return fmt.Sprintf("%s: %s", genKotlinPropertyName(name), fieldType)
+}
+
+// KotlinSimpleType generates code for simple type XML schema in Kotlin
+// language syntax.
+func (gen *CodeGenerator) KotlinSimpleType(v *SimpleType) {
+	if v.List {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			fieldType := genKotlinFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))
+			content := fmt.Sprintf(" = List<%s>\n", fieldType)
+			gen.StructAST[v.Name] = content
+			gen.Field += withDerivationComment(fmt.Sprintf("\ntypealias %s%s", genKotlinFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final)
+			return
+		}
+	}
+	if v.Union && len(v.MemberTypes) > 0 {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			var properties []string
+			for _, memberName := range v.Members {
+				memberType := v.MemberTypes[memberName]
+				if memberType == "" { // fix order issue
+					memberType = gen.getBasefromSimpleType(memberName)
+				}
+				properties = append(properties, genKotlinProperty(memberName, memberType, false, true))
+			}
+			gen.StructAST[v.Name] = genKotlinDataClass(genKotlinFieldName(v.Name), properties)
+			gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+		}
+		return
+	}
+	if len(v.Restriction.Enum) > 0 {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			var constants []string
+			for _, enum := range v.Restriction.Enum {
+				constants = append(constants, fmt.Sprintf("\t%s(%q)", genKotlinEnumName(enum), enum))
+			}
+			gen.StructAST[v.Name] = fmt.Sprintf("\nenum class %s(val value: String) {\n%s;\n}\n", genKotlinFieldName(v.Name), strings.Join(constants, ",\n"))
+			gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+		}
+		return
+	}
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		content := fmt.Sprintf(" = %s\n", genKotlinFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base))))
+		gen.StructAST[v.Name] = content
+		gen.Field += withDerivationComment(fmt.Sprintf("\ntypealias %s%s", genKotlinFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final)
+	}
+	return
+}
+
+// KotlinComplexType generates code for complex type XML schema in Kotlin
+// language syntax.
+func (gen *CodeGenerator) KotlinComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var properties []string
+		for _, attrGroup := range v.AttributeGroup {
+			properties = append(properties, genKotlinProperty(attrGroup.Name, gen.genKotlinType(attrGroup.Ref), false, false))
+		}
+
+		// Data classes can't be derived from each other, the properties of
+		// the base types are included in the derived type instead.
+		elements, attributes := gen.complexTypeContent(v)
+		for _, attribute := range attributes {
+			properties = append(properties, genKotlinProperty(attribute.Name+"Attr", gen.genKotlinType(attribute.Type), false, attribute.Optional))
+		}
+
+		for _, group := range v.Groups {
+			properties = append(properties, genKotlinProperty(group.Name, gen.genKotlinType(group.Ref), group.Plural, false))
+		}
+
+		for _, element := range elements {
+			properties = append(properties, genKotlinProperty(element.Name, gen.genKotlinType(element.Type), element.Plural, element.Optional))
+		}
+		if v.Mixed {
+			// the text segments of the mixed content.
+			properties = append(properties, genKotlinProperty("text", "String", true, false))
+		}
+		gen.StructAST[v.Name] = genKotlinDataClass(genKotlinFieldName(v.Name), properties)
+		gen.Field += withDerivationComment(gen.StructAST[v.Name], v.Block, v.Final)
+	}
+	return
+}
+
+// KotlinGroup generates code for group XML schema in Kotlin language syntax.
+func (gen *CodeGenerator) KotlinGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var properties []string
+		for _, element := range v.Elements {
+			properties = append(properties, genKotlinProperty(element.Name, gen.genKotlinType(element.Type), element.Plural, element.Optional))
+		}
+
+		for _, group := range v.Groups {
+			properties = append(properties, genKotlinProperty(group.Name, gen.genKotlinType(group.Ref), group.Plural, false))
+		}
+		gen.StructAST[v.Name] = genKotlinDataClass(genKotlinFieldName(v.Name), properties)
+		gen.Field += gen.StructAST[v.Name]
+	}
+	return
+}
+
+// KotlinAttributeGroup generates code for attribute group XML schema in
+// Kotlin language syntax.
+func (gen *CodeGenerator) KotlinAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var properties []string
+		for _, attribute := range v.Attributes {
+			properties = append(properties, genKotlinProperty(attribute.Name+"Attr", gen.genKotlinType(attribute.Type), false, attribute.Optional))
+		}
+		gen.StructAST[v.Name] = genKotlinDataClass(genKotlinFieldName(v.Name), properties)
+		gen.Field += gen.StructAST[v.Name]
+	}
+	return
+}
+
+// KotlinElement generates code for element XML schema in Kotlin language
+// syntax.
+func (gen *CodeGenerator) KotlinElement(v *Element) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		fieldType := genKotlinFieldType(gen.genKotlinType(v.Type))
+		if v.Plural {
+			fieldType = fmt.Sprintf("List<%s>", fieldType)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf(" = %s\n", fieldType)
+		gen.Field += withDerivationComment(fmt.Sprintf("\ntypealias %s%s", genKotlinFieldName(v.Name), gen.StructAST[v.Name]), v.Block, v.Final)
+	}
+	return
+}
+
+// KotlinAttribute generates code for attribute XML schema in Kotlin language
+// syntax.
+func (gen *CodeGenerator) KotlinAttribute(v *Attribute) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		fieldType := genKotlinFieldType(gen.genKotlinType(v.Type))
+		if v.Plural {
+			fieldType = fmt.Sprintf("List<%s>", fieldType)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf(" = %s\n", fieldType)
+		gen.Field += fmt.Sprintf("\ntypealias %s%s", genKotlinFieldName(v.Name), gen.StructAST[v.Name])
+	}
+	return
+}
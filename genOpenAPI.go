@@ -0,0 +1,262 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var openAPIBuildInType = map[string]bool{
+	"boolean": true,
+	"integer": true,
+	"number":  true,
+	"object":  true,
+	"string":  true,
+}
+
+// openAPIProperty defines a property of the generated object schema.
+type openAPIProperty struct {
+	Name     string
+	Type     string
+	Plural   bool
+	Required bool
+}
+
+// GenOpenAPI generate OpenAPI document in YAML for XML schema definition
+// files, all of the definitions are declared as the component schemas.
+func (gen *CodeGenerator) GenOpenAPI() error {
+	// YAML doesn't allow tabs for indentation.
+	if strings.Contains(gen.Indent, "\t") {
+		gen.Indent = ""
+	}
+	gen.genProtoTree("OpenAPI")
+	return gen.writeSource(".yaml", genOpenAPIFieldName, func(path, field string) ([]byte, error) {
+		return []byte(fmt.Sprintf("%s\n\nopenapi: 3.0.3\ninfo:\n\ttitle: %s\n\tversion: 1.0.0\npaths: {}\ncomponents:\n\tschemas:\n%s",
+			strings.Replace(copyright, "//", "#", -1), strconv.Quote(filepath.Base(path)), field)), nil
+	})
+}
+
+func genOpenAPIFieldName(name string) (fieldName string) {
+	for _, str := range strings.Split(name, ":") {
+		fieldName += MakeFirstUpperCase(str)
+	}
+	var tmp string
+	for _, str := range strings.Split(fieldName, ".") {
+		tmp += MakeFirstUpperCase(str)
+	}
+	fieldName = tmp
+	fieldName = strings.Replace(fieldName, "-", "", -1)
+	return
+}
+
+// genOpenAPIPropertyName generates the lower camel case property name of the
+// object schema by given name.
+func genOpenAPIPropertyName(name string) string {
+	fieldName := genOpenAPIFieldName(name)
+	if fieldName == "" {
+		return fieldName
+	}
+	return strings.ToLower(fieldName[:1]) + fieldName[1:]
+}
+
+// genOpenAPITypeSchema generates the schema of the type by given name at the
+// indentation depth. The build-in types are declared with the type and
+// format, the types with the "[]" prefix are declared as arrays, and the
+// other types are referenced to the component schemas.
+func genOpenAPITypeSchema(name string, depth int) string {
+	indent := strings.Repeat("\t", depth)
+	if name == "" {
+		name = "string"
+	}
+	if strings.HasPrefix(name, "[]") {
+		return fmt.Sprintf("%stype: array\n%sitems:\n%s", indent, indent, genOpenAPITypeSchema(name[2:], depth+1))
+	}
+	parts := strings.SplitN(name, "/", 2)
+	if !openAPIBuildInType[parts[0]] {
+		return fmt.Sprintf("%s$ref: '#/components/schemas/%s'\n", indent, genOpenAPIFieldName(name))
+	}
+	schema := fmt.Sprintf("%stype: %s\n", indent, parts[0])
+	if len(parts) == 2 {
+		schema += fmt.Sprintf("%sformat: %s\n", indent, parts[1])
+	}
+	return schema
+}
+
+// genOpenAPIObject generates the object schema by given properties at the
+// indentation depth, the required properties are listed in the required
+// field of the schema.
+func genOpenAPIObject(properties []openAPIProperty, depth int) string {
+	indent := strings.Repeat("\t", depth)
+	schema := indent + "type: object\n"
+	if len(properties) == 0 {
+		return schema
+	}
+	schema += indent + "properties:\n"
+	var required string
+	for _, property := range properties {
+		fieldType := property.Type
+		if property.Plural {
+			fieldType = "[]" + fieldType
+		}
+		schema += fmt.Sprintf("%s\t%s:\n%s", indent, property.Name, genOpenAPITypeSchema(fieldType, depth+2))
+		if property.Required {
+			required += fmt.Sprintf("%s\t- %s\n", indent, property.Name)
+		}
+	}
+	if required != "" {
+		schema += indent + "required:\n" + required
+	}
+	return schema
+}
+
+// genOpenAPIType returns the type of the definition by given type name, the
+// enumerations are declared as the component schemas with the enum field.
+func (gen *CodeGenerator) genOpenAPIType(name string) string {
+	return gen.getEnumOrBaseType(name)
+}
+
+// OpenAPISimpleType generates code for simple type XML schema in OpenAPI
+// document.
+func (gen *CodeGenerator) OpenAPISimpleType(v *SimpleType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var schema string
+	switch {
+	case v.List:
+		schema = genOpenAPITypeSchema("[]"+gen.genOpenAPIType(v.Base), 3)
+	case v.Union && len(v.MemberTypes) > 0:
+		var memberNames []string
+		for memberName := range v.MemberTypes {
+			memberNames = append(memberNames, memberName)
+		}
+		sort.Strings(memberNames)
+		schema = "\t\t\tanyOf:\n"
+		for _, memberName := range memberNames {
+			memberType := v.MemberTypes[memberName]
+			if memberType == "" { // fix order issue
+				memberType = gen.getBasefromSimpleType(memberName)
+			}
+			schema += "\t\t\t\t-\n" + genOpenAPITypeSchema(memberType, 5)
+		}
+	default:
+		baseType := gen.genOpenAPIType(v.Base)
+		schema = genOpenAPITypeSchema(baseType, 3)
+		if len(v.Restriction.Enum) > 0 {
+			schema += "\t\t\tenum:\n"
+			for _, enum := range v.Restriction.Enum {
+				if strings.HasPrefix(baseType, "string") || baseType == "" {
+					enum = strconv.Quote(enum)
+				}
+				schema += fmt.Sprintf("\t\t\t\t- %s\n", enum)
+			}
+		}
+	}
+	gen.StructAST[v.Name] = fmt.Sprintf("\t\t%s:\n%s", genOpenAPIFieldName(v.Name), schema)
+	gen.Field += gen.StructAST[v.Name]
+}
+
+// OpenAPIComplexType generates code for complex type XML schema in OpenAPI
+// document. The complex type derived by extension of a complex type is
+// declared as the combination of the base type and its own properties.
+func (gen *CodeGenerator) OpenAPIComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var properties []openAPIProperty
+	for _, attrGroup := range v.AttributeGroup {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(attrGroup.Name), Type: gen.genOpenAPIType(attrGroup.Ref), Required: true})
+	}
+
+	for _, attribute := range v.Attributes {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(attribute.Name + "Attr"), Type: gen.genOpenAPIType(attribute.Type), Plural: attribute.Plural, Required: !attribute.Optional})
+	}
+
+	for _, group := range v.Groups {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(group.Name), Type: gen.genOpenAPIType(group.Ref), Plural: group.Plural, Required: true})
+	}
+
+	for _, element := range v.Elements {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(element.Name), Type: gen.genOpenAPIType(element.Type), Plural: element.Plural, Required: !element.Optional})
+	}
+	base := trimNSPrefix(v.Base)
+	if v.Mixed {
+		properties = append(properties, openAPIProperty{Name: "value", Type: "string"})
+	} else if base != "" && !gen.isComplexType(base) {
+		properties = append(properties, openAPIProperty{Name: "value", Type: gen.genOpenAPIType(base), Required: true})
+	}
+	schema := genOpenAPIObject(properties, 3)
+	if v.Extension && gen.isComplexType(base) {
+		schema = fmt.Sprintf("\t\t\tallOf:\n\t\t\t\t- $ref: '#/components/schemas/%s'\n\t\t\t\t-\n%s", genOpenAPIFieldName(base), genOpenAPIObject(properties, 5))
+	}
+	gen.StructAST[v.Name] = fmt.Sprintf("\t\t%s:\n%s", genOpenAPIFieldName(v.Name), schema)
+	gen.Field += gen.StructAST[v.Name]
+}
+
+// OpenAPIGroup generates code for group XML schema in OpenAPI document.
+func (gen *CodeGenerator) OpenAPIGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var properties []openAPIProperty
+	for _, element := range v.Elements {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(element.Name), Type: gen.genOpenAPIType(element.Type), Plural: element.Plural, Required: !element.Optional})
+	}
+
+	for _, group := range v.Groups {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(group.Name), Type: gen.genOpenAPIType(group.Ref), Plural: group.Plural, Required: true})
+	}
+	gen.StructAST[v.Name] = fmt.Sprintf("\t\t%s:\n%s", genOpenAPIFieldName(v.Name), genOpenAPIObject(properties, 3))
+	gen.Field += gen.StructAST[v.Name]
+}
+
+// OpenAPIAttributeGroup generates code for attribute group XML schema in
+// OpenAPI document.
+func (gen *CodeGenerator) OpenAPIAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var properties []openAPIProperty
+	for _, attribute := range v.Attributes {
+		properties = append(properties, openAPIProperty{Name: genOpenAPIPropertyName(attribute.Name + "Attr"), Type: gen.genOpenAPIType(attribute.Type), Plural: attribute.Plural, Required: !attribute.Optional})
+	}
+	gen.StructAST[v.Name] = fmt.Sprintf("\t\t%s:\n%s", genOpenAPIFieldName(v.Name), genOpenAPIObject(properties, 3))
+	gen.Field += gen.StructAST[v.Name]
+}
+
+// OpenAPIElement generates code for element XML schema in OpenAPI document.
+func (gen *CodeGenerator) OpenAPIElement(v *Element) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	fieldType := gen.genOpenAPIType(v.Type)
+	if v.Plural {
+		fieldType = "[]" + fieldType
+	}
+	gen.StructAST[v.Name] = fmt.Sprintf("\t\t%s:\n%s", genOpenAPIFieldName(v.Name), genOpenAPITypeSchema(fieldType, 3))
+	gen.Field += gen.StructAST[v.Name]
+}
+
+// OpenAPIAttribute generates code for attribute XML schema in OpenAPI
+// document.
+func (gen *CodeGenerator) OpenAPIAttribute(v *Attribute) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	fieldType := gen.genOpenAPIType(v.Type)
+	if v.Plural {
+		fieldType = "[]" + fieldType
+	}
+	gen.StructAST[v.Name] = fmt.Sprintf("\t\t%s:\n%s", genOpenAPIFieldName(v.Name), genOpenAPITypeSchema(fieldType, 3))
+	gen.Field += gen.StructAST[v.Name]
+}
@@ -0,0 +1,290 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var phpBuildInType = map[string]bool{
+	"array":               true,
+	"bool":                true,
+	"float":               true,
+	"int":                 true,
+	"mixed":               true,
+	"string":              true,
+	"\\DateInterval":      true,
+	"\\DateTimeImmutable": true,
+}
+
+// phpProperty defines a promoted constructor property of the generated
+// class.
+type phpProperty struct {
+	Name     string
+	Type     string
+	Plural   bool
+	Optional bool
+}
+
+// GenPHP generate PHP programming language source code for XML schema
+// definition files. Complex types are declared as the classes with readonly
+// properties promoted in the constructor, and simple types with enumerations
+// are declared as the string backed enums. PHP has no type aliases, so the
+// references to other simple types are replaced by their base types, and the
+// unions are declared as the union types of their member types. The code
+// requires PHP 8.1 or later.
+func (gen *CodeGenerator) GenPHP() error {
+	gen.genProtoTree("PHP")
+	packageName := gen.Package
+	if packageName == "" {
+		packageName = "schema"
+	}
+	return gen.writeSource(".php", genPHPClassName, func(path, field string) ([]byte, error) {
+		return []byte(fmt.Sprintf("<?php\n\n%s\n\ndeclare(strict_types=1);\n\nnamespace %s;\n%s", copyright, genPHPNamespace(packageName), field)), nil
+	})
+}
+
+// genPHPNamespace generates the namespace by given package name, the
+// segments separated by slashes or dots are declared as the sub-namespaces.
+func genPHPNamespace(packageName string) string {
+	var segments []string
+	for _, segment := range strings.FieldsFunc(packageName, func(r rune) bool { return r == '/' || r == '.' }) {
+		if segment = genPHPClassName(segment); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return strings.Join(segments, "\\")
+}
+
+func genPHPClassName(name string) (fieldName string) {
+	for _, str := range strings.Split(name, ":") {
+		fieldName += MakeFirstUpperCase(str)
+	}
+	var tmp string
+	for _, str := range strings.Split(fieldName, ".") {
+		tmp += MakeFirstUpperCase(str)
+	}
+	fieldName = tmp
+	fieldName = strings.Replace(strings.Replace(fieldName, "-", "", -1), "_", "", -1)
+	return
+}
+
+// genPHPPropertyName generates the lower camel case property name by given
+// name, the name this is suffixed with an underscore since it can't be used
+// as a parameter.
+func genPHPPropertyName(name string) string {
+	fieldName := genPHPClassName(name)
+	if fieldName == "" {
+		return fieldName
+	}
+	fieldName = strings.ToLower(fieldName[:1]) + fieldName[1:]
+	if fieldName == "this" {
+		return fieldName + "_"
+	}
+	return fieldName
+}
+
+func genPHPFieldType(name string) string {
+	if _, ok := phpBuildInType[name]; ok || strings.Contains(name, "|") {
+		return name
+	}
+	if fieldType := genPHPClassName(name); fieldType != "" {
+		return fieldType
+	}
+	return "mixed"
+}
+
+// genPHPEnumName generates the enum case name by given enumeration value,
+// characters which are not allowed in the identifier will be removed, and
+// the names which don't start with a letter or are reserved are prefixed
+// with Value.
+func genPHPEnumName(value string) string {
+	var enumName string
+	for _, str := range strings.FieldsFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		enumName += MakeFirstUpperCase(str)
+	}
+	if enumName == "" || enumName[0] >= '0' && enumName[0] <= '9' || strings.EqualFold(enumName, "class") {
+		return "Value" + enumName
+	}
+	return enumName
+}
+
+// genPHPString generates the single quoted PHP string literal by given
+// value.
+func genPHPString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// genPHPType returns the type of the property by given type name. The lists
+// are declared as arrays, the unions are declared as the union types of their
+// member types, and the enumerations are declared as the PHP enums.
+func (gen *CodeGenerator) genPHPType(name string) string {
+	name = trimNSPrefix(name)
+	for _, ele := range gen.ProtoTree {
+		if v, ok := ele.(*SimpleType); ok && v.Name == name {
+			if v.List {
+				return "array"
+			}
+			if v.Union && len(v.MemberTypes) > 0 {
+				members := map[string]bool{}
+				for _, memberName := range v.Members {
+					memberType := v.MemberTypes[memberName]
+					if memberType == "" { // fix order issue
+						memberType = gen.getBasefromSimpleType(memberName)
+					}
+					for _, member := range strings.Split(genPHPFieldType(gen.genPHPType(memberType)), "|") {
+						members[member] = true
+					}
+				}
+				if members["mixed"] {
+					return "mixed"
+				}
+				var memberTypes []string
+				for member := range members {
+					memberTypes = append(memberTypes, member)
+				}
+				sort.Strings(memberTypes)
+				return strings.Join(memberTypes, "|")
+			}
+			break
+		}
+	}
+	return gen.getEnumOrBaseType(name)
+}
+
+// genPHPClass generates the class declaration by given name and properties.
+// The properties are promoted in the constructor as readonly properties, the
+// required ones are declared first since the optional parameters must follow
+// them. The repeating properties are declared as arrays which default to empty
+// arrays with the type of their items in the documentation comment, and the
+// optional ones are nullable and default to null.
+func genPHPClass(name string, properties []phpProperty) string {
+	if len(properties) == 0 {
+		return fmt.Sprintf("\nclass %s\n{\n}\n", name)
+	}
+	var required, optional []string
+	var doc string
+	for _, property := range properties {
+		fieldType := genPHPFieldType(property.Type)
+		switch {
+		case property.Plural:
+			doc += fmt.Sprintf("\t * @param list<%s> $%s\n", fieldType, property.Name)
+			optional = append(optional, fmt.Sprintf("\t\tpublic readonly array $%s = [],\n", property.Name))
+		case property.Optional:
+			if fieldType != "mixed" {
+				if strings.Contains(fieldType, "|") {
+					fieldType += "|null"
+				} else {
+					fieldType = "?" + fieldType
+				}
+			}
+			optional = append(optional, fmt.Sprintf("\t\tpublic readonly %s $%s = null,\n", fieldType, property.Name))
+		default:
+			required = append(required, fmt.Sprintf("\t\tpublic readonly %s $%s,\n", fieldType, property.Name))
+		}
+	}
+	if doc != "" {
+		doc = fmt.Sprintf("\t/**\n%s\t */\n", doc)
+	}
+	return fmt.Sprintf("\nclass %s\n{\n%s\tpublic function __construct(\n%s\t) {\n\t}\n}\n", name, doc, strings.Join(append(required, optional...), ""))
+}
+
+// PHPSimpleType generates code for simple type XML schema in PHP language
+// syntax. Only the enumerations are declared, the references to other simple
+// types are replaced by their types.
+func (gen *CodeGenerator) PHPSimpleType(v *SimpleType) {
+	if _, ok := gen.StructAST[v.Name]; ok || v.List || v.Union || len(v.Restriction.Enum) == 0 {
+		return
+	}
+	var content string
+	names := map[string]int{}
+	for _, enum := range v.Restriction.Enum {
+		enumName := genPHPEnumName(enum)
+		if names[enumName]++; names[enumName] > 1 {
+			enumName = fmt.Sprintf("%s%d", enumName, names[enumName])
+		}
+		content += fmt.Sprintf("\tcase %s = %s;\n", enumName, genPHPString(enum))
+	}
+	gen.StructAST[v.Name] = fmt.Sprintf("\nenum %s: string\n{\n%s}\n", genPHPClassName(v.Name), content)
+	gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+	return
+}
+
+// PHPComplexType generates code for complex type XML schema in PHP language
+// syntax.
+func (gen *CodeGenerator) PHPComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var properties []phpProperty
+	for _, attrGroup := range v.AttributeGroup {
+		properties = append(properties, phpProperty{Name: genPHPPropertyName(attrGroup.Name), Type: gen.genPHPType(attrGroup.Ref)})
+	}
+
+	// The promoted properties of the parent constructor can't be passed
+	// through without redeclaring them, the properties of the base types are
+	// included in the derived type instead.
+	elements, attributes := gen.complexTypeContent(v)
+	for _, attribute := range attributes {
+		properties = append(properties, phpProperty{Name: genPHPPropertyName(attribute.Name + "Attr"), Type: gen.genPHPType(attribute.Type), Plural: attribute.Plural, Optional: attribute.Optional})
+	}
+
+	for _, group := range v.Groups {
+		properties = append(properties, phpProperty{Name: genPHPPropertyName(group.Name), Type: gen.genPHPType(group.Ref), Plural: group.Plural})
+	}
+
+	for _, element := range elements {
+		properties = append(properties, phpProperty{Name: genPHPPropertyName(element.Name), Type: gen.genPHPType(element.Type), Plural: element.Plural, Optional: element.Optional})
+	}
+	if v.Mixed {
+		properties = append(properties, phpProperty{Name: "value", Type: "string", Optional: true})
+	} else if baseName := trimNSPrefix(v.Base); baseName != "" && !gen.isComplexType(baseName) {
+		properties = append(properties, phpProperty{Name: "value", Type: gen.genPHPType(baseName)})
+	}
+	gen.StructAST[v.Name] = genPHPClass(genPHPClassName(v.Name), properties)
+	gen.Field += withDerivationComment(gen.StructAST[v.Name], v.Block, v.Final)
+	return
+}
+
+// PHPGroup generates code for group XML schema in PHP language syntax.
+func (gen *CodeGenerator) PHPGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var properties []phpProperty
+	for _, element := range v.Elements {
+		properties = append(properties, phpProperty{Name: genPHPPropertyName(element.Name), Type: gen.genPHPType(element.Type), Plural: element.Plural, Optional: element.Optional})
+	}
+
+	for _, group := range v.Groups {
+		properties = append(properties, phpProperty{Name: genPHPPropertyName(group.Name), Type: gen.genPHPType(group.Ref), Plural: group.Plural})
+	}
+	gen.StructAST[v.Name] = genPHPClass(genPHPClassName(v.Name), properties)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
+
+// PHPAttributeGroup generates code for attribute group XML schema in PHP
+// language syntax.
+func (gen *CodeGenerator) PHPAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var properties []phpProperty
+	for _, attribute := range v.Attributes {
+		properties = append(properties, phpProperty{Name: genPHPPropertyName(attribute.Name + "Attr"), Type: gen.genPHPType(attribute.Type), Plural: attribute.Plural, Optional: attribute.Optional})
+	}
+	gen.StructAST[v.Name] = genPHPClass(genPHPClassName(v.Name), properties)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
@@ -0,0 +1,233 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var protobufScalarType = map[string]bool{
+	"bool":                     true,
+	"bytes":                    true,
+	"double":                   true,
+	"float":                    true,
+	"google.protobuf.Duration": true,
+	"int32":                    true,
+	"int64":                    true,
+	"string":                   true,
+	"uint32":                   true,
+	"uint64":                   true,
+}
+
+// protobufField defines a field of the generated message.
+type protobufField struct {
+	Name     string
+	Type     string
+	Repeated bool
+}
+
+// GenProtobuf generate Protocol Buffers (proto3) definitions for XML schema
+// definition files. Complex types are declared as messages, and simple types
+// with enumerations are declared as enums. Since there are no type aliases in
+// Protocol Buffers, the references to other simple types, elements and
+// attributes are replaced by their types. The fields are numbered in the
+// order of declaration.
+func (gen *CodeGenerator) GenProtobuf() error {
+	gen.genProtoTree("Protobuf")
+	packageName := gen.Package
+	if packageName == "" {
+		packageName = "schema"
+	}
+	return gen.writeSource(".proto", genProtobufMessageName, func(path, field string) ([]byte, error) {
+		var imports string
+		if strings.Contains(field, "google.protobuf.Duration") {
+			imports = "\nimport \"google/protobuf/duration.proto\";\n"
+		}
+		return []byte(fmt.Sprintf("%s\n\nsyntax = \"proto3\";\n\npackage %s;\n%s%s", copyright, packageName, imports, field)), nil
+	})
+}
+
+func genProtobufMessageName(name string) (fieldName string) {
+	for _, str := range strings.Split(name, ":") {
+		fieldName += MakeFirstUpperCase(str)
+	}
+	var tmp string
+	for _, str := range strings.Split(fieldName, ".") {
+		tmp += MakeFirstUpperCase(str)
+	}
+	fieldName = tmp
+	fieldName = strings.Replace(strings.Replace(fieldName, "-", "", -1), "_", "", -1)
+	return
+}
+
+// genProtobufEnumName generates the upper snake case enum value name by given
+// enum type name and enumeration value. The names are prefixed with the enum
+// type name, since the enum values are scoped to the package rather than the
+// enum in Protocol Buffers.
+func genProtobufEnumName(typeName, value string) string {
+	return strings.ToUpper(strings.Trim(genSnakeCaseName(typeName)+"_"+genSnakeCaseName(value), "_"))
+}
+
+// genProtobufFieldType returns the type of the field by given type name, and
+// reports whether the field is repeated for the list types.
+func genProtobufFieldType(name string) (string, bool) {
+	if strings.HasPrefix(name, "repeated ") {
+		fieldType, _ := genProtobufFieldType(strings.TrimPrefix(name, "repeated "))
+		return fieldType, true
+	}
+	if protobufScalarType[name] {
+		return name, false
+	}
+	if fieldType := genProtobufMessageName(name); fieldType != "" {
+		return fieldType, false
+	}
+	return "string", false
+}
+
+// genProtobufMessage generates the message declaration by given name and
+// fields, the fields are numbered from 1 in the order of them.
+func genProtobufMessage(name string, fields []protobufField) string {
+	if len(fields) == 0 {
+		return fmt.Sprintf("\nmessage %s {}\n", name)
+	}
+	var content string
+	for i, field := range fields {
+		fieldType, repeated := genProtobufFieldType(field.Type)
+		label := ""
+		if field.Repeated || repeated {
+			label = "repeated "
+		}
+		content += fmt.Sprintf("\t%s%s %s = %d;\n", label, fieldType, genSnakeCaseName(field.Name), i+1)
+	}
+	return fmt.Sprintf("\nmessage %s {\n%s}\n", name, content)
+}
+
+// ProtobufSimpleType generates code for simple type XML schema in Protocol
+// Buffers syntax. The list is declared as the message with a repeated value
+// field, and the union is declared as the message with a oneof of its
+// member types.
+func (gen *CodeGenerator) ProtobufSimpleType(v *SimpleType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	if v.List {
+		gen.StructAST[v.Name] = genProtobufMessage(genProtobufMessageName(v.Name), []protobufField{
+			{Name: "value", Type: gen.genProtobufType(v.Base), Repeated: true},
+		})
+		gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+		return
+	}
+	if v.Union && len(v.MemberTypes) > 0 {
+		var memberNames []string
+		for memberName := range v.MemberTypes {
+			memberNames = append(memberNames, memberName)
+		}
+		sort.Strings(memberNames)
+		var content string
+		for i, memberName := range memberNames {
+			memberType := v.MemberTypes[memberName]
+			if memberType == "" { // fix order issue
+				memberType = gen.getBasefromSimpleType(memberName)
+			}
+			fieldType, _ := genProtobufFieldType(memberType)
+			content += fmt.Sprintf("\t\t%s %s = %d;\n", fieldType, genSnakeCaseName(memberName), i+1)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf("\nmessage %s {\n\toneof value {\n%s\t}\n}\n", genProtobufMessageName(v.Name), content)
+		gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+		return
+	}
+	if len(v.Restriction.Enum) > 0 {
+		content := fmt.Sprintf("\t%s = 0;\n", genProtobufEnumName(v.Name, "unspecified"))
+		for i, enum := range v.Restriction.Enum {
+			content += fmt.Sprintf("\t%s = %d;\n", genProtobufEnumName(v.Name, enum), i+1)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf("\nenum %s {\n%s}\n", genProtobufMessageName(v.Name), content)
+		gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+	}
+	return
+}
+
+// genProtobufType returns the type of the definition by given type name, the
+// enumerations are declared as the Protocol Buffers enums.
+func (gen *CodeGenerator) genProtobufType(name string) string {
+	return gen.getEnumOrBaseType(name)
+}
+
+// ProtobufComplexType generates code for complex type XML schema in Protocol
+// Buffers syntax. The complex type derived from a complex type contains the
+// base message as its first field.
+func (gen *CodeGenerator) ProtobufComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var fields []protobufField
+	base := trimNSPrefix(v.Base)
+	if base != "" && gen.isComplexType(base) {
+		fields = append(fields, protobufField{Name: base, Type: base})
+	}
+	for _, attrGroup := range v.AttributeGroup {
+		fields = append(fields, protobufField{Name: attrGroup.Name, Type: gen.genProtobufType(attrGroup.Ref)})
+	}
+
+	for _, attribute := range v.Attributes {
+		fields = append(fields, protobufField{Name: attribute.Name + "Attr", Type: gen.genProtobufType(attribute.Type), Repeated: attribute.Plural})
+	}
+
+	for _, group := range v.Groups {
+		fields = append(fields, protobufField{Name: group.Name, Type: gen.genProtobufType(group.Ref), Repeated: group.Plural})
+	}
+
+	for _, element := range v.Elements {
+		fields = append(fields, protobufField{Name: element.Name, Type: gen.genProtobufType(element.Type), Repeated: element.Plural})
+	}
+	if v.Mixed {
+		fields = append(fields, protobufField{Name: "value", Type: "string"})
+	} else if base != "" && !gen.isComplexType(base) {
+		fields = append(fields, protobufField{Name: "value", Type: gen.genProtobufType(base)})
+	}
+	gen.StructAST[v.Name] = genProtobufMessage(genProtobufMessageName(v.Name), fields)
+	gen.Field += withDerivationComment(gen.StructAST[v.Name], v.Block, v.Final)
+	return
+}
+
+// ProtobufGroup generates code for group XML schema in Protocol Buffers
+// syntax.
+func (gen *CodeGenerator) ProtobufGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var fields []protobufField
+	for _, element := range v.Elements {
+		fields = append(fields, protobufField{Name: element.Name, Type: gen.genProtobufType(element.Type), Repeated: element.Plural})
+	}
+
+	for _, group := range v.Groups {
+		fields = append(fields, protobufField{Name: group.Name, Type: gen.genProtobufType(group.Ref), Repeated: group.Plural})
+	}
+	gen.StructAST[v.Name] = genProtobufMessage(genProtobufMessageName(v.Name), fields)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
+
+// ProtobufAttributeGroup generates code for attribute group XML schema in
+// Protocol Buffers syntax.
+func (gen *CodeGenerator) ProtobufAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var fields []protobufField
+	for _, attribute := range v.Attributes {
+		fields = append(fields, protobufField{Name: attribute.Name + "Attr", Type: gen.genProtobufType(attribute.Type), Repeated: attribute.Plural})
+	}
+	gen.StructAST[v.Name] = genProtobufMessage(genProtobufMessageName(v.Name), fields)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
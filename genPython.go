@@ -0,0 +1,277 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var pythonBuildInType = map[string]bool{
+	"bool":               true,
+	"bytes":              true,
+	"datetime.date":      true,
+	"datetime.datetime":  true,
+	"datetime.time":      true,
+	"datetime.timedelta": true,
+	"float":              true,
+	"int":                true,
+	"list[str]":          true,
+	"object":             true,
+	"str":                true,
+}
+
+// pythonKeywords defines the Python keywords, and the names of the modules
+// used in the class bodies of the generated code, which can't be used as the
+// names of the fields.
+var pythonKeywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true,
+	"assert": true, "async": true, "await": true, "break": true,
+	"class": true, "continue": true, "def": true, "del": true, "elif": true,
+	"else": true, "except": true, "finally": true, "for": true,
+	"from": true, "global": true, "if": true, "import": true, "in": true,
+	"is": true, "lambda": true, "nonlocal": true, "not": true, "or": true,
+	"pass": true, "raise": true, "return": true, "try": true, "while": true,
+	"with": true, "yield": true,
+	"dataclasses": true, "datetime": true, "enum": true,
+}
+
+// pythonField defines a field of the generated data class.
+type pythonField struct {
+	Name     string
+	Type     string
+	Plural   bool
+	Optional bool
+}
+
+// GenPython generate Python programming language source code for XML schema
+// definition files. Complex types are declared as the data classes with type
+// hints, simple types with enumerations are declared as the string enums,
+// and other simple types are declared as type aliases. The fields are
+// keyword-only, so the code requires Python 3.10 or later.
+func (gen *CodeGenerator) GenPython() error {
+	gen.genProtoTree("Python")
+	return gen.writeSource(".py", genPythonClassName, func(path, field string) ([]byte, error) {
+		var imports string
+		for _, module := range []string{"dataclasses", "datetime", "enum"} {
+			if strings.Contains(field, module+".") {
+				imports += fmt.Sprintf("import %s\n", module)
+			}
+		}
+		if imports != "" {
+			imports = "\n" + imports
+		}
+		return []byte(fmt.Sprintf("%s\n\nfrom __future__ import annotations\n%s%s", strings.Replace(copyright, "//", "#", -1), imports, field)), nil
+	})
+}
+
+func genPythonClassName(name string) (fieldName string) {
+	for _, str := range strings.Split(name, ":") {
+		fieldName += MakeFirstUpperCase(str)
+	}
+	var tmp string
+	for _, str := range strings.Split(fieldName, ".") {
+		tmp += MakeFirstUpperCase(str)
+	}
+	fieldName = tmp
+	fieldName = strings.Replace(strings.Replace(fieldName, "-", "", -1), "_", "", -1)
+	return
+}
+
+// genPythonFieldName generates the snake case field name of the data class by
+// given name, keywords will be suffixed with an underscore.
+func genPythonFieldName(name string) string {
+	fieldName := genSnakeCaseName(name)
+	if fieldName == "" || fieldName[0] >= '0' && fieldName[0] <= '9' {
+		fieldName = "field_" + fieldName
+	}
+	if pythonKeywords[fieldName] {
+		return fieldName + "_"
+	}
+	return fieldName
+}
+
+func genPythonFieldType(name string) string {
+	if _, ok := pythonBuildInType[name]; ok {
+		return name
+	}
+	if fieldType := genPythonClassName(name); fieldType != "" {
+		return fieldType
+	}
+	return "str"
+}
+
+// genPythonEnumName generates the upper snake case enum member name by given
+// enumeration value, the names which don't start with a letter are prefixed
+// with VALUE.
+func genPythonEnumName(value string) string {
+	enumName := strings.ToUpper(genSnakeCaseName(value))
+	if enumName == "" || enumName[0] >= '0' && enumName[0] <= '9' {
+		return strings.TrimSuffix("VALUE_"+enumName, "_")
+	}
+	return enumName
+}
+
+// genPythonType returns the type of the definition by given type name, the
+// enumerations are declared as the Python enums.
+func (gen *CodeGenerator) genPythonType(name string) string {
+	return gen.getEnumOrBaseType(name)
+}
+
+// genPythonDataClass generates the data class declaration by given name, base
+// class and fields. The repeating fields are declared as lists which default
+// to empty lists, and the optional ones default to None.
+func genPythonDataClass(name, base string, fields []pythonField) string {
+	declaration := "class " + name
+	if base != "" {
+		declaration += "(" + base + ")"
+	}
+	if len(fields) == 0 {
+		return fmt.Sprintf("\n\n@dataclasses.dataclass(kw_only=True)\n%s:\n\tpass\n", declaration)
+	}
+	var content string
+	for _, field := range fields {
+		fieldType := genPythonFieldType(field.Type)
+		switch {
+		case field.Plural:
+			content += fmt.Sprintf("\t%s: list[%s] = dataclasses.field(default_factory=list)\n", field.Name, fieldType)
+		case field.Optional:
+			content += fmt.Sprintf("\t%s: %s | None = None\n", field.Name, fieldType)
+		default:
+			content += fmt.Sprintf("\t%s: %s\n", field.Name, fieldType)
+		}
+	}
+	return fmt.Sprintf("\n\n@dataclasses.dataclass(kw_only=True)\n%s:\n%s", declaration, content)
+}
+
+// PythonSimpleType generates code for simple type XML schema in Python
+// language syntax. The union is declared as the data class with a field for
+// each of its member types.
+func (gen *CodeGenerator) PythonSimpleType(v *SimpleType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	if v.List {
+		gen.StructAST[v.Name] = fmt.Sprintf(" = list[%s]\n", genPythonFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base))))
+		gen.Field += fmt.Sprintf("\n\n%s%s", genPythonClassName(v.Name), gen.StructAST[v.Name])
+		return
+	}
+	if v.Union && len(v.MemberTypes) > 0 {
+		var memberNames []string
+		for memberName := range v.MemberTypes {
+			memberNames = append(memberNames, memberName)
+		}
+		sort.Strings(memberNames)
+		var fields []pythonField
+		for _, memberName := range memberNames {
+			memberType := v.MemberTypes[memberName]
+			if memberType == "" { // fix order issue
+				memberType = gen.getBasefromSimpleType(memberName)
+			}
+			fields = append(fields, pythonField{Name: genPythonFieldName(memberName), Type: memberType, Optional: true})
+		}
+		gen.StructAST[v.Name] = genPythonDataClass(genPythonClassName(v.Name), "", fields)
+		gen.Field += gen.StructAST[v.Name]
+		return
+	}
+	if len(v.Restriction.Enum) > 0 {
+		var content string
+		names := map[string]int{}
+		for _, enum := range v.Restriction.Enum {
+			enumName := genPythonEnumName(enum)
+			if names[enumName]++; names[enumName] > 1 {
+				enumName = fmt.Sprintf("%s_%d", enumName, names[enumName])
+			}
+			content += fmt.Sprintf("\t%s = %q\n", enumName, enum)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf("\n\nclass %s(str, enum.Enum):\n%s", genPythonClassName(v.Name), content)
+		gen.Field += gen.StructAST[v.Name]
+		return
+	}
+	gen.StructAST[v.Name] = fmt.Sprintf(" = %s\n", genPythonFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base))))
+	gen.Field += fmt.Sprintf("\n\n%s%s", genPythonClassName(v.Name), gen.StructAST[v.Name])
+	return
+}
+
+// PythonComplexType generates code for complex type XML schema in Python
+// language syntax. The complex type derived by extension from a complex type
+// is declared as the subclass of it, so the base class is declared first.
+func (gen *CodeGenerator) PythonComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var base string
+	if baseName := trimNSPrefix(v.Base); baseName != "" && v.Extension && gen.isComplexType(baseName) {
+		for _, ele := range gen.ProtoTree {
+			if baseType, ok := ele.(*ComplexType); ok && baseType.Name == baseName && baseType != v {
+				gen.PythonComplexType(baseType)
+				break
+			}
+		}
+		base = genPythonClassName(baseName)
+	}
+	var fields []pythonField
+	for _, attrGroup := range v.AttributeGroup {
+		fields = append(fields, pythonField{Name: genPythonFieldName(attrGroup.Name), Type: gen.genPythonType(attrGroup.Ref)})
+	}
+
+	for _, attribute := range v.Attributes {
+		fields = append(fields, pythonField{Name: genPythonFieldName(attribute.Name + "Attr"), Type: gen.genPythonType(attribute.Type), Plural: attribute.Plural, Optional: attribute.Optional})
+	}
+
+	for _, group := range v.Groups {
+		fields = append(fields, pythonField{Name: genPythonFieldName(group.Name), Type: gen.genPythonType(group.Ref), Plural: group.Plural})
+	}
+
+	for _, element := range v.Elements {
+		fields = append(fields, pythonField{Name: genPythonFieldName(element.Name), Type: gen.genPythonType(element.Type), Plural: element.Plural, Optional: element.Optional})
+	}
+	if v.Mixed {
+		fields = append(fields, pythonField{Name: "value", Type: "str", Optional: true})
+	} else if baseName := trimNSPrefix(v.Base); baseName != "" && !gen.isComplexType(baseName) {
+		fields = append(fields, pythonField{Name: "value", Type: gen.genPythonType(baseName)})
+	}
+	gen.StructAST[v.Name] = genPythonDataClass(genPythonClassName(v.Name), base, fields)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
+
+// PythonGroup generates code for group XML schema in Python language syntax.
+func (gen *CodeGenerator) PythonGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var fields []pythonField
+	for _, element := range v.Elements {
+		fields = append(fields, pythonField{Name: genPythonFieldName(element.Name), Type: gen.genPythonType(element.Type), Plural: element.Plural, Optional: element.Optional})
+	}
+
+	for _, group := range v.Groups {
+		fields = append(fields, pythonField{Name: genPythonFieldName(group.Name), Type: gen.genPythonType(group.Ref), Plural: group.Plural})
+	}
+	gen.StructAST[v.Name] = genPythonDataClass(genPythonClassName(v.Name), "", fields)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
+
+// PythonAttributeGroup generates code for attribute group XML schema in
+// Python language syntax.
+func (gen *CodeGenerator) PythonAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var fields []pythonField
+	for _, attribute := range v.Attributes {
+		fields = append(fields, pythonField{Name: genPythonFieldName(attribute.Name + "Attr"), Type: gen.genPythonType(attribute.Type), Plural: attribute.Plural, Optional: attribute.Optional})
+	}
+	gen.StructAST[v.Name] = genPythonDataClass(genPythonClassName(v.Name), "", fields)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
@@ -0,0 +1,252 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var rubyBuildInType = map[string]bool{
+	"Array<String>": true,
+	"Boolean":       true,
+	"Float":         true,
+	"Integer":       true,
+	"Object":        true,
+	"String":        true,
+	"Time":          true,
+}
+
+var rubyKeywords = map[string]bool{
+	"BEGIN": true, "END": true, "alias": true, "and": true, "begin": true,
+	"break": true, "case": true, "class": true, "def": true, "defined?": true,
+	"do": true, "else": true, "elsif": true, "end": true, "ensure": true,
+	"false": true, "for": true, "if": true, "in": true, "module": true,
+	"next": true, "nil": true, "not": true, "or": true, "redo": true,
+	"rescue": true, "retry": true, "return": true, "self": true,
+	"super": true, "then": true, "true": true, "undef": true, "unless": true,
+	"until": true, "when": true, "while": true, "yield": true,
+}
+
+// rubyAttribute defines an attribute of the generated class, the type of it
+// is only used in the documentation comment since Ruby is dynamically typed.
+type rubyAttribute struct {
+	Name     string
+	Type     string
+	Plural   bool
+	Optional bool
+}
+
+// GenRuby generate Ruby programming language source code for XML schema
+// definition files. Complex types are declared as the classes with attribute
+// accessors and the initializer accepting keyword arguments, and simple types
+// with enumerations are declared as the frozen constant arrays of the allowed
+// values. All declarations are placed in the module named after the package.
+func (gen *CodeGenerator) GenRuby() error {
+	gen.genProtoTree("Ruby")
+	moduleName := gen.Package
+	if moduleName == "" {
+		moduleName = "schema"
+	}
+	return gen.writeSource(".rb", genRubyClassName, func(path, field string) ([]byte, error) {
+		lines := strings.Split(strings.TrimSuffix(field, "\n"), "\n")
+		for i, line := range lines {
+			if line != "" {
+				lines[i] = "\t" + line
+			}
+		}
+		return []byte(fmt.Sprintf("%s\n\nmodule %s%s\nend\n", strings.Replace(copyright, "//", "#", -1), genRubyClassName(moduleName), strings.Join(lines, "\n"))), nil
+	})
+}
+
+func genRubyClassName(name string) (fieldName string) {
+	for _, str := range strings.Split(name, ":") {
+		fieldName += MakeFirstUpperCase(str)
+	}
+	var tmp string
+	for _, str := range strings.Split(fieldName, ".") {
+		tmp += MakeFirstUpperCase(str)
+	}
+	fieldName = tmp
+	fieldName = strings.Replace(strings.Replace(fieldName, "-", "", -1), "_", "", -1)
+	return
+}
+
+// genRubyAttributeName generates the snake case attribute name of the class
+// by given name, keywords will be suffixed with an underscore, since they
+// can't be used as the names of the keyword arguments.
+func genRubyAttributeName(name string) string {
+	attrName := genSnakeCaseName(name)
+	if rubyKeywords[attrName] {
+		return attrName + "_"
+	}
+	return attrName
+}
+
+func genRubyFieldType(name string) string {
+	if _, ok := rubyBuildInType[name]; ok {
+		return name
+	}
+	if fieldType := genRubyClassName(name); fieldType != "" {
+		return fieldType
+	}
+	return "String"
+}
+
+// genRubyString returns the single-quoted string literal of the value.
+func genRubyString(value string) string {
+	return "'" + strings.Replace(strings.Replace(value, `\`, `\\`, -1), "'", `\'`, -1) + "'"
+}
+
+// genRubyClass generates the class declaration by given name, superclass and
+// attributes. The types of the attributes are documented in the comments,
+// the repeating ones are documented as arrays and default to empty arrays,
+// and the optional ones default to nil in the initializer. The initializer
+// of the derived class passes the rest of keyword arguments to the
+// superclass.
+func genRubyClass(name, superclass string, attributes []rubyAttribute) string {
+	declaration := "class " + name
+	if superclass != "" {
+		declaration += " < " + superclass
+	}
+	if len(attributes) == 0 {
+		return fmt.Sprintf("\n%s\nend\n", declaration)
+	}
+	var accessors, params, assignments []string
+	for _, attribute := range attributes {
+		fieldType, param := genRubyFieldType(attribute.Type), attribute.Name+":"
+		if attribute.Plural {
+			fieldType, param = fmt.Sprintf("Array<%s>", fieldType), param+" []"
+		} else if attribute.Optional {
+			fieldType, param = fieldType+", nil", param+" nil"
+		}
+		accessors = append(accessors, fmt.Sprintf("\t# @return [%s]\n\tattr_accessor :%s\n", fieldType, attribute.Name))
+		params = append(params, param)
+		assignments = append(assignments, fmt.Sprintf("\t\t@%s = %s\n", attribute.Name, attribute.Name))
+	}
+	if superclass != "" {
+		params = append(params, "**kwargs")
+		assignments = append([]string{"\t\tsuper(**kwargs)\n"}, assignments...)
+	}
+	return fmt.Sprintf("\n%s\n%s\n\tdef initialize(%s)\n%s\tend\nend\n", declaration, strings.Join(accessors, ""), strings.Join(params, ", "), strings.Join(assignments, ""))
+}
+
+// RubySimpleType generates code for simple type XML schema in Ruby language
+// syntax. The union is declared as the class with an attribute for each of
+// its member types, and other simple types are replaced by their base types.
+func (gen *CodeGenerator) RubySimpleType(v *SimpleType) {
+	if _, ok := gen.StructAST[v.Name]; ok || v.List {
+		return
+	}
+	if v.Union && len(v.MemberTypes) > 0 {
+		var memberNames []string
+		for memberName := range v.MemberTypes {
+			memberNames = append(memberNames, memberName)
+		}
+		sort.Strings(memberNames)
+		var attributes []rubyAttribute
+		for _, memberName := range memberNames {
+			memberType := v.MemberTypes[memberName]
+			if memberType == "" { // fix order issue
+				memberType = gen.getBasefromSimpleType(memberName)
+			}
+			attributes = append(attributes, rubyAttribute{Name: genRubyAttributeName(memberName), Type: memberType, Optional: true})
+		}
+		gen.StructAST[v.Name] = genRubyClass(genRubyClassName(v.Name), "", attributes)
+		gen.Field += gen.StructAST[v.Name]
+		return
+	}
+	if len(v.Restriction.Enum) > 0 {
+		var values []string
+		for _, enum := range v.Restriction.Enum {
+			values = append(values, genRubyString(enum))
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf("\n# The allowed values of %s.\n%s = [%s].freeze\n", v.Name, strings.ToUpper(genSnakeCaseName(v.Name)), strings.Join(values, ", "))
+		gen.Field += gen.StructAST[v.Name]
+	}
+	return
+}
+
+// RubyComplexType generates code for complex type XML schema in Ruby
+// language syntax. The complex type derived by extension from a complex type
+// is declared as the subclass of it, so the base class is declared first.
+func (gen *CodeGenerator) RubyComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var superclass string
+	base := trimNSPrefix(v.Base)
+	if base != "" && v.Extension && gen.isComplexType(base) {
+		for _, ele := range gen.ProtoTree {
+			if baseType, ok := ele.(*ComplexType); ok && baseType.Name == base && baseType != v {
+				gen.RubyComplexType(baseType)
+				break
+			}
+		}
+		superclass = genRubyClassName(base)
+	}
+	var attributes []rubyAttribute
+	for _, attrGroup := range v.AttributeGroup {
+		attributes = append(attributes, rubyAttribute{Name: genRubyAttributeName(attrGroup.Name), Type: gen.getBasefromSimpleType(trimNSPrefix(attrGroup.Ref))})
+	}
+
+	for _, attribute := range v.Attributes {
+		attributes = append(attributes, rubyAttribute{Name: genRubyAttributeName(attribute.Name + "Attr"), Type: gen.getBasefromSimpleType(trimNSPrefix(attribute.Type)), Plural: attribute.Plural, Optional: attribute.Optional})
+	}
+
+	for _, group := range v.Groups {
+		attributes = append(attributes, rubyAttribute{Name: genRubyAttributeName(group.Name), Type: gen.getBasefromSimpleType(trimNSPrefix(group.Ref)), Plural: group.Plural})
+	}
+
+	for _, element := range v.Elements {
+		attributes = append(attributes, rubyAttribute{Name: genRubyAttributeName(element.Name), Type: gen.getBasefromSimpleType(trimNSPrefix(element.Type)), Plural: element.Plural, Optional: element.Optional})
+	}
+	if v.Mixed {
+		attributes = append(attributes, rubyAttribute{Name: "value", Type: "String", Optional: true})
+	} else if base != "" && !gen.isComplexType(base) {
+		attributes = append(attributes, rubyAttribute{Name: "value", Type: gen.getBasefromSimpleType(base)})
+	}
+	gen.StructAST[v.Name] = genRubyClass(genRubyClassName(v.Name), superclass, attributes)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
+
+// RubyGroup generates code for group XML schema in Ruby language syntax.
+func (gen *CodeGenerator) RubyGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var attributes []rubyAttribute
+	for _, element := range v.Elements {
+		attributes = append(attributes, rubyAttribute{Name: genRubyAttributeName(element.Name), Type: gen.getBasefromSimpleType(trimNSPrefix(element.Type)), Plural: element.Plural, Optional: element.Optional})
+	}
+
+	for _, group := range v.Groups {
+		attributes = append(attributes, rubyAttribute{Name: genRubyAttributeName(group.Name), Type: gen.getBasefromSimpleType(trimNSPrefix(group.Ref)), Plural: group.Plural})
+	}
+	gen.StructAST[v.Name] = genRubyClass(genRubyClassName(v.Name), "", attributes)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
+
+// RubyAttributeGroup generates code for attribute group XML schema in Ruby
+// language syntax.
+func (gen *CodeGenerator) RubyAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; ok {
+		return
+	}
+	var attributes []rubyAttribute
+	for _, attribute := range v.Attributes {
+		attributes = append(attributes, rubyAttribute{Name: genRubyAttributeName(attribute.Name + "Attr"), Type: gen.getBasefromSimpleType(trimNSPrefix(attribute.Type)), Plural: attribute.Plural, Optional: attribute.Optional})
+	}
+	gen.StructAST[v.Name] = genRubyClass(genRubyClassName(v.Name), "", attributes)
+	gen.Field += gen.StructAST[v.Name]
+	return
+}
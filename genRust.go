@@ -10,8 +10,6 @@ package xgen
 
 import (
 	"fmt"
-	"os"
-	"reflect"
 	"strings"
 )
 
@@ -37,30 +35,15 @@ var rustBuildinType = map[string]bool{
 	"char":      true,
 }
 
-// GenRust generate Go programming language source code for XML schema
-// definition files.
+// GenRust generate Rust programming language source code for XML schema
+// definition files. Simple types with enumerations are declared as the enums
+// with the variants renamed to their values.
 func (gen *CodeGenerator) GenRust() error {
-	for _, ele := range gen.ProtoTree {
-		if ele == nil {
-			continue
-		}
-		funcName := fmt.Sprintf("Rust%s", reflect.TypeOf(ele).String()[6:])
-		callFuncByName(gen, funcName, []reflect.Value{reflect.ValueOf(ele)})
-	}
-	f, err := os.Create(gen.File + ".rs")
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-	var extern = `#[macro_use]
-extern crate serde_derive;
-extern crate serde;
-extern crate serde_xml_rs;
-
-use serde_xml_rs::from_reader;`
-	source := []byte(fmt.Sprintf("%s\n\n%s\n%s", copyright, extern, gen.Field))
-	f.Write(source)
-	return err
+	gen.genProtoTree("Rust")
+	var extern = `use serde::{Deserialize, Serialize};`
+	return gen.writeSource(".rs", genRustFieldName, func(path, field string) ([]byte, error) {
+		return []byte(fmt.Sprintf("%s\n\n%s\n%s", copyright, extern, field)), nil
+	})
 }
 
 func genRustFieldName(name string) (fieldName string) {
@@ -91,37 +74,164 @@ func genRustFieldType(name string) string {
 	return "char"
 }
 
+// genRustEnumName generates the enum variant name by given enumeration value,
+// characters which are not allowed in the identifier will be removed, and
+// the names which don't start with a letter or are reserved are prefixed
+// with Value.
+func genRustEnumName(value string) string {
+	var enumName string
+	for _, str := range strings.FieldsFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		enumName += MakeFirstUpperCase(str)
+	}
+	if enumName == "" || enumName[0] >= '0' && enumName[0] <= '9' || enumName == "Self" {
+		return "Value" + enumName
+	}
+	return enumName
+}
+
+// genRustType returns the type of the field by given type name, the
+// enumerations are declared as the Rust enums.
+func (gen *CodeGenerator) genRustType(name string) string {
+	return gen.getEnumOrBaseType(name)
+}
+
+// genRustFieldAttr generates the serde attribute of the field by given Rust
+// type of the field, which renames the field to the local name in the XML
+// schema. The default value will be used for the optional field if it's
+// absent, and the absent value isn't serialized, so that no empty element or
+// attribute is written for it.
+func genRustFieldAttr(name, fieldType string, optional bool) string {
+	if optional {
+		skip := "Option::is_none"
+		if strings.HasPrefix(fieldType, "Vec<") {
+			skip = "Vec::is_empty"
+		}
+		return fmt.Sprintf("\t#[serde(rename = \"%s\", default, skip_serializing_if = \"%s\")]\n", name, skip)
+	}
+	return fmt.Sprintf("\t#[serde(rename = \"%s\")]\n", name)
+}
+
+// genRustFieldCardinality wraps the field type by the occurrence of the
+// element or attribute, repeating elements are declared as Vec<T> and
+// optional elements and attributes are declared as Option<T>, an empty
+// Vec<T> means an absent optional repeating element.
+func genRustFieldCardinality(fieldType string, plural, optional bool) string {
+	if plural {
+		return fmt.Sprintf("Vec<%s>", fieldType)
+	}
+	if optional {
+		return fmt.Sprintf("Option<%s>", fieldType)
+	}
+	return fieldType
+}
+
+// genRustBox boxes the type of the field by given type name of the field if
+// the value of the field holds a value of the complex type or group being
+// defined with the name, directly or through the fields of other types which
+// are not repeating, otherwise the size of the struct would be infinite.
+func (gen *CodeGenerator) genRustBox(fieldType, typeName, name string) string {
+	visited := map[string]bool{}
+	var holds func(typeName string) bool
+	holds = func(typeName string) bool {
+		if typeName == name {
+			return true
+		}
+		if visited[typeName] {
+			return false
+		}
+		visited[typeName] = true
+		for _, ele := range gen.ProtoTree {
+			var elements []Element
+			var groups []Group
+			switch v := ele.(type) {
+			case *ComplexType:
+				if v.Name != typeName {
+					continue
+				}
+				elements, groups = v.Elements, v.Groups
+			case *Group:
+				if v.Name != typeName || v.Plural {
+					continue
+				}
+				elements, groups = v.Elements, v.Groups
+			default:
+				continue
+			}
+			for _, element := range elements {
+				if !element.Plural && holds(trimNSPrefix(element.Type)) {
+					return true
+				}
+			}
+			for _, group := range groups {
+				if !group.Plural && holds(trimNSPrefix(group.Ref)) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if holds(trimNSPrefix(typeName)) {
+		return fmt.Sprintf("Box<%s>", fieldType)
+	}
+	return fieldType
+}
+
 // RustSimpleType generates code for simple type XML schema in Rust language
 // syntax.
 func (gen *CodeGenerator) RustSimpleType(v *SimpleType) {
 	if v.List {
 		if _, ok := gen.StructAST[v.Name]; !ok {
-			fieldType := genRustFieldType(getBasefromSimpleType(trimNSPrefix(v.Base), gen.ProtoTree))
-			content := fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: Vec<%s>,\n", v.Name, genRustFieldName(v.Name), fieldType)
+			fieldType := genRustFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))
+			content := fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: Vec<%s>,\n", gen.xmlName(v.Name), genRustFieldName(v.Name), fieldType)
 			gen.StructAST[v.Name] = content
-			gen.Field += fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name])
+			gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), genDocComment(v.Doc, "/// "))
 			return
 		}
 	}
 	if v.Union && len(v.MemberTypes) > 0 {
 		if _, ok := gen.StructAST[v.Name]; !ok {
+			// the untagged enum is deserialized as the first variant which
+			// accepts the value, the member types are tried in the declared
+			// order.
 			var content string
-			for memberName, memberType := range v.MemberTypes {
+			for _, memberName := range v.Members {
+				memberType := v.MemberTypes[memberName]
 				if memberType == "" { // fix order issue
-					memberType = getBasefromSimpleType(memberName, gen.ProtoTree)
+					memberType = gen.getBasefromSimpleType(memberName)
+				}
+				if isEnumSimpleType(memberName, gen.ProtoTree) {
+					memberType = memberName
+				}
+				content += fmt.Sprintf("\t%s(%s),\n", genRustFieldName(memberName), genRustFieldType(memberType))
+			}
+			gen.StructAST[v.Name] = content
+			gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\n#[serde(untagged)]\nenum %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), genDocComment(v.Doc, "/// "))
+		}
+		return
+	}
+	if len(v.Restriction.Enum) > 0 {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			var content string
+			names := map[string]int{}
+			for _, enum := range v.Restriction.Enum {
+				enumName := genRustEnumName(enum)
+				if names[enumName]++; names[enumName] > 1 {
+					enumName = fmt.Sprintf("%s%d", enumName, names[enumName])
 				}
-				content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: %s,\n", v.Name, genRustFieldName(memberName), genRustFieldType(memberType))
+				content += fmt.Sprintf("\t#[serde(rename = %q)]\n\t%s,\n", enum, enumName)
 			}
 			gen.StructAST[v.Name] = content
-			gen.Field += fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name])
+			gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\n#[derive(Debug, Clone, Copy, PartialEq, Eq, Serialize, Deserialize)]\nenum %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), genDocComment(v.Doc, "/// "))
 		}
 		return
 	}
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		fieldType := genRustFieldType(getBasefromSimpleType(trimNSPrefix(v.Base), gen.ProtoTree))
-		content := fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: %s,\n", v.Name, genRustFieldName(v.Name), fieldType)
+		fieldType := genRustFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))
+		content := fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: %s,\n", gen.xmlName(v.Name), genRustFieldName(v.Name), fieldType)
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name])
+		gen.Field += withDocComment(withAssertionComment(withDerivationComment(fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), v.Restriction.Assertions), genDocComment(v.Doc, "/// "))
 	}
 	return
 }
@@ -132,35 +242,47 @@ func (gen *CodeGenerator) RustComplexType(v *ComplexType) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		var content string
 		for _, attrGroup := range v.AttributeGroup {
-			fieldType := getBasefromSimpleType(trimNSPrefix(attrGroup.Ref), gen.ProtoTree)
+			fieldType := gen.getBasefromSimpleType(trimNSPrefix(attrGroup.Ref))
 			content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: Vec<%s>,\n", attrGroup.Name, genRustFieldName(attrGroup.Name), genRustFieldType(fieldType))
 		}
 
 		for _, attribute := range v.Attributes {
-			// TODO: check attribute.Optional
-			fieldType := genRustFieldType(getBasefromSimpleType(trimNSPrefix(attribute.Type), gen.ProtoTree))
-			content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: Vec<%s>,\n", attribute.Name, genRustFieldName(attribute.Name), fieldType)
+			content += genDocComment(attribute.Doc, "\t/// ")
+			fieldType := genRustFieldCardinality(genRustFieldType(gen.genRustType(attribute.Type)), attribute.Plural, attribute.Optional)
+			content += fmt.Sprintf("%s\tpub %s: %s,\n", genRustFieldAttr(attribute.Name, fieldType, attribute.Optional), genRustFieldName(attribute.Name), fieldType)
 		}
 		for _, group := range v.Groups {
-			fieldType := genRustFieldType(getBasefromSimpleType(trimNSPrefix(group.Ref), gen.ProtoTree))
+			fieldType := genRustFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref)))
 			fieldName := genRustFieldName(group.Name)
 			if group.Plural {
 				content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: Vec<%s>,\n", group.Name, fieldName, fieldType)
 			} else {
-				content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: %s,\n", group.Name, fieldName, fieldType)
+				content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: %s,\n", group.Name, fieldName, gen.genRustBox(fieldType, group.Ref, v.Name))
 			}
 		}
 		for _, element := range v.Elements {
-			fieldType := genRustFieldType(getBasefromSimpleType(trimNSPrefix(element.Type), gen.ProtoTree))
-			fieldName := genRustFieldName(element.Name)
-			if element.Plural {
-				content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: Vec<%s>,\n", element.Name, fieldName, fieldType)
-			} else {
-				content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: %s,\n", element.Name, fieldName, fieldType)
+			content += genDocComment(element.Doc, "\t/// ")
+			if head, _ := gen.substitutionGroup(element); head != nil {
+				// the members of the substitution group are selected by the
+				// name of the element, which is the variant of the enum.
+				fieldType := genRustFieldName(head.Name) + "Substitution"
+				content += fmt.Sprintf("\t#[serde(rename = \"$value\")]\n\tpub %s: %s,\n", genRustFieldName(element.Name), genRustFieldCardinality(fieldType, element.Plural, element.Optional))
+				continue
+			}
+			// the nil elements are deserialized as None.
+			fieldType, optional := genRustFieldType(gen.genRustType(element.Type)), element.Optional || element.Nillable
+			if !element.Plural {
+				fieldType = gen.genRustBox(fieldType, element.Type, v.Name)
 			}
+			fieldType = genRustFieldCardinality(fieldType, element.Plural, optional)
+			content += fmt.Sprintf("%s\tpub %s: %s,\n", genRustFieldAttr(element.Name, fieldType, optional), genRustFieldName(element.Name), fieldType)
+		}
+		if v.Mixed {
+			// the character data of the mixed content.
+			content += "\t#[serde(rename = \"$text\", default)]\n\tpub Value: Option<String>,\n"
 		}
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name])
+		gen.Field += withDocComment(withAssertionComment(withDerivationComment(fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name]), v.Block, v.Final), v.Assertions), genDocComment(v.Doc, "/// "))
 	}
 	return
 }
@@ -170,25 +292,25 @@ func (gen *CodeGenerator) RustGroup(v *Group) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		var content string
 		for _, element := range v.Elements {
-			fieldType := genRustFieldType(getBasefromSimpleType(trimNSPrefix(element.Type), gen.ProtoTree))
-			fieldName := genRustFieldName(element.Name)
-			if v.Plural {
-				content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: Vec<%s>,\n", element.Name, fieldName, fieldType)
-			} else {
-				content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: %s,\n", element.Name, fieldName, fieldType)
+			content += genDocComment(element.Doc, "\t/// ")
+			fieldType, optional := genRustFieldType(gen.genRustType(element.Type)), element.Optional || element.Nillable
+			if !v.Plural && !element.Plural {
+				fieldType = gen.genRustBox(fieldType, element.Type, v.Name)
 			}
+			fieldType = genRustFieldCardinality(fieldType, v.Plural || element.Plural, optional)
+			content += fmt.Sprintf("%s\tpub %s: %s,\n", genRustFieldAttr(element.Name, fieldType, optional), genRustFieldName(element.Name), fieldType)
 		}
 		for _, group := range v.Groups {
-			fieldType := genRustFieldType(getBasefromSimpleType(trimNSPrefix(group.Ref), gen.ProtoTree))
+			fieldType := genRustFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref)))
 			fieldName := genRustFieldName(group.Name)
 			if v.Plural {
 				content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: Vec<%s>,\n", group.Name, fieldName, fieldType)
 			} else {
-				content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: %s,\n", group.Name, fieldName, fieldType)
+				content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: %s,\n", group.Name, fieldName, gen.genRustBox(fieldType, group.Ref, v.Name))
 			}
 		}
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name])
+		gen.Field += withDocComment(fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name]), genDocComment(v.Doc, "/// "))
 	}
 	return
 }
@@ -199,11 +321,12 @@ func (gen *CodeGenerator) RustAttributeGroup(v *AttributeGroup) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		var content string
 		for _, attribute := range v.Attributes {
-			// TODO: check attribute.Optional
-			content += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: Vec<%s>,\n", attribute.Name, genRustFieldName(attribute.Name), genRustFieldType(getBasefromSimpleType(trimNSPrefix(attribute.Type), gen.ProtoTree)))
+			content += genDocComment(attribute.Doc, "\t/// ")
+			fieldType := genRustFieldCardinality(genRustFieldType(gen.genRustType(attribute.Type)), attribute.Plural, attribute.Optional)
+			content += fmt.Sprintf("%s\tpub %s: %s,\n", genRustFieldAttr(attribute.Name, fieldType, attribute.Optional), genRustFieldName(attribute.Name), fieldType)
 		}
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name])
+		gen.Field += withDocComment(fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", genRustFieldName(v.Name), gen.StructAST[v.Name]), genDocComment(v.Doc, "/// "))
 	}
 	return
 }
@@ -211,14 +334,18 @@ func (gen *CodeGenerator) RustAttributeGroup(v *AttributeGroup) {
 // RustElement generates code for element XML schema in Rust language syntax.
 func (gen *CodeGenerator) RustElement(v *Element) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		fieldType := genRustFieldType(getBasefromSimpleType(trimNSPrefix(v.Type), gen.ProtoTree))
+		fieldType := genRustFieldType(gen.genRustType(v.Type))
 		fieldName := genRustFieldName(v.Name)
-		if v.Plural {
-			gen.StructAST[v.Name] = fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: Vec<%s>,\n", v.Name, fieldName, fieldType)
-		} else {
-			gen.StructAST[v.Name] = fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: %s,\n", v.Name, fieldName, fieldType)
+		fieldType = genRustFieldCardinality(fieldType, v.Plural, v.Optional)
+		gen.StructAST[v.Name] = fmt.Sprintf("%s\tpub %s: %s,\n", genRustFieldAttr(gen.xmlName(v.Name), fieldType, v.Optional), fieldName, fieldType)
+		gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", fieldName, gen.StructAST[v.Name]), v.Block, v.Final), genDocComment(v.Doc, "/// "))
+		if head, members := gen.substitutionGroup(*v); head != nil {
+			var variants string
+			for _, member := range members {
+				variants += fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\t%s(%s),\n", member.Name, genRustEnumName(member.Name), genRustFieldType(gen.genRustType(member.Type)))
+			}
+			gen.Field += fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nenum %sSubstitution {\n%s}\n", fieldName, variants)
 		}
-		gen.Field += fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", fieldName, gen.StructAST[v.Name])
 	}
 	return
 }
@@ -226,14 +353,14 @@ func (gen *CodeGenerator) RustElement(v *Element) {
 // RustAttribute generates code for attribute XML schema in Rust language syntax.
 func (gen *CodeGenerator) RustAttribute(v *Attribute) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		fieldType := genRustFieldType(getBasefromSimpleType(trimNSPrefix(v.Type), gen.ProtoTree))
+		fieldType := genRustFieldType(gen.genRustType(v.Type))
 		fieldName := genRustFieldName(v.Name)
 		if v.Plural {
-			gen.StructAST[v.Name] = fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: Vec<%s>,\n", v.Name, fieldName, fieldType)
+			gen.StructAST[v.Name] = fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: Vec<%s>,\n", gen.xmlName(v.Name), fieldName, fieldType)
 		} else {
-			gen.StructAST[v.Name] = fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: %s,\n", v.Name, fieldName, fieldType)
+			gen.StructAST[v.Name] = fmt.Sprintf("\t#[serde(rename = \"%s\")]\n\tpub %s: %s,\n", gen.xmlName(v.Name), fieldName, fieldType)
 		}
-		gen.Field += fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", fieldName, gen.StructAST[v.Name])
+		gen.Field += withDocComment(fmt.Sprintf("\n#[derive(Debug, Serialize, Deserialize)]\nstruct %s {\n%s}\n", fieldName, gen.StructAST[v.Name]), genDocComment(v.Doc, "/// "))
 	}
 	return
 }
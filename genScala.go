@@ -0,0 +1,295 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"strings"
+)
+
+var scalaBuildInType = map[string]bool{
+	"Any":                         true,
+	"Array[Byte]":                 true,
+	"Boolean":                     true,
+	"Byte":                        true,
+	"Double":                      true,
+	"Float":                       true,
+	"Int":                         true,
+	"Long":                        true,
+	"Short":                       true,
+	"String":                      true,
+	"Seq[String]":                 true,
+	"java.time.LocalDateTime":     true,
+	"javax.xml.datatype.Duration": true,
+}
+
+var scalaKeywords = map[string]bool{
+	"abstract": true, "case": true, "catch": true, "class": true, "def": true,
+	"do": true, "else": true, "extends": true, "false": true, "final": true,
+	"finally": true, "for": true, "forSome": true, "if": true, "implicit": true,
+	"import": true, "lazy": true, "match": true, "new": true, "null": true,
+	"object": true, "override": true, "package": true, "private": true,
+	"protected": true, "return": true, "sealed": true, "super": true,
+	"this": true, "throw": true, "trait": true, "try": true, "true": true,
+	"type": true, "val": true, "var": true, "while": true, "with": true,
+	"yield": true,
+}
+
+// GenScala generate Scala programming language source code for XML schema
+// definition files. Simple types are declared as top-level type aliases,
+// which requires Scala 3.
+func (gen *CodeGenerator) GenScala() error {
+	gen.genProtoTree("Scala")
+	packageName := gen.Package
+	if packageName == "" {
+		packageName = "schema"
+	}
+	return gen.writeSource(".scala", genScalaFieldName, func(path, field string) ([]byte, error) {
+		return []byte(fmt.Sprintf("%s\n\npackage %s\n%s", copyright, packageName, field)), nil
+	})
+}
+
+func genScalaFieldName(name string) (fieldName string) {
+	for _, str := range strings.Split(name, ":") {
+		fieldName += MakeFirstUpperCase(str)
+	}
+	var tmp string
+	for _, str := range strings.Split(fieldName, ".") {
+		tmp += MakeFirstUpperCase(str)
+	}
+	fieldName = tmp
+	fieldName = strings.Replace(strings.Replace(fieldName, "-", "", -1), "_", "", -1)
+	return
+}
+
+// genScalaPropertyName generates the lower camel case parameter name of the
+// case class by given name, keywords will be quoted with backticks.
+func genScalaPropertyName(name string) string {
+	fieldName := genScalaFieldName(name)
+	if fieldName == "" {
+		return fieldName
+	}
+	fieldName = strings.ToLower(fieldName[:1]) + fieldName[1:]
+	if scalaKeywords[fieldName] {
+		return "`" + fieldName + "`"
+	}
+	return fieldName
+}
+
+func genScalaFieldType(name string) string {
+	if _, ok := scalaBuildInType[name]; ok {
+		return name
+	}
+	var fieldType string
+	for _, str := range strings.Split(name, ".") {
+		fieldType += MakeFirstUpperCase(str)
+	}
+	fieldType = strings.Replace(MakeFirstUpperCase(strings.Replace(fieldType, "-", "", -1)), "_", "", -1)
+	if fieldType != "" {
+		return fieldType
+	}
+	return "Any"
+}
+
+// genScalaEnumName generates the case object name by given enumeration
+// value, characters which are not allowed in the identifier will be removed.
+func genScalaEnumName(value string) string {
+	var enumName string
+	for _, str := range strings.FieldsFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		enumName += MakeFirstUpperCase(str)
+	}
+	if enumName == "" || enumName[0] >= '0' && enumName[0] <= '9' {
+		return "Value" + enumName
+	}
+	return enumName
+}
+
+// genScalaType returns the type of the parameter by given type name, the
+// enumerations are declared as the sealed traits.
+func (gen *CodeGenerator) genScalaType(name string) string {
+	return gen.getEnumOrBaseType(name)
+}
+
+// genScalaCaseClass generates the case class declaration by given name and
+// parameters.
+func genScalaCaseClass(name string, params []string) string {
+	if len(params) == 0 {
+		return fmt.Sprintf("\ncase class %s()\n", name)
+	}
+	return fmt.Sprintf("\ncase class %s(\n\t%s\n)\n", name, strings.Join(params, ",\n\t"))
+}
+
+// ScalaSimpleType generates code for simple type XML schema in Scala language
+// syntax.
+func (gen *CodeGenerator) ScalaSimpleType(v *SimpleType) {
+	if v.List {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			fieldType := genScalaFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))
+			content := fmt.Sprintf(" = Seq[%s]\n", fieldType)
+			gen.StructAST[v.Name] = content
+			gen.Field += withDerivationComment(fmt.Sprintf("\ntype %s%s", genScalaFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final)
+			return
+		}
+	}
+	if v.Union && len(v.MemberTypes) > 0 {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			var params []string
+			for _, memberName := range v.Members {
+				memberType := v.MemberTypes[memberName]
+				if memberType == "" { // fix order issue
+					memberType = gen.getBasefromSimpleType(memberName)
+				}
+				params = append(params, fmt.Sprintf("%s: Option[%s] = None", genScalaPropertyName(memberName), genScalaFieldType(memberType)))
+			}
+			gen.StructAST[v.Name] = genScalaCaseClass(genScalaFieldName(v.Name), params)
+			gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+		}
+		return
+	}
+	if len(v.Restriction.Enum) > 0 {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			fieldName := genScalaFieldName(v.Name)
+			var content string
+			for _, enum := range v.Restriction.Enum {
+				content += fmt.Sprintf("\tcase object %s extends %s { val value = %q }\n", genScalaEnumName(enum), fieldName, enum)
+			}
+			gen.StructAST[v.Name] = fmt.Sprintf("\nsealed trait %s { def value: String }\n\nobject %s {\n%s}\n", fieldName, fieldName, content)
+			gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+		}
+		return
+	}
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		content := fmt.Sprintf(" = %s\n", genScalaFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base))))
+		gen.StructAST[v.Name] = content
+		gen.Field += withDerivationComment(fmt.Sprintf("\ntype %s%s", genScalaFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final)
+	}
+	return
+}
+
+// ScalaComplexType generates code for complex type XML schema in Scala
+// language syntax.
+func (gen *CodeGenerator) ScalaComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var params []string
+		for _, attrGroup := range v.AttributeGroup {
+			fieldType := gen.genScalaType(attrGroup.Ref)
+			params = append(params, fmt.Sprintf("%s: %s", genScalaPropertyName(attrGroup.Name), genScalaFieldType(fieldType)))
+		}
+
+		// Case classes can't be derived from each other, the parameters of
+		// the base types are included in the derived type instead.
+		elements, attributes := gen.complexTypeContent(v)
+		for _, attribute := range attributes {
+			fieldType := genScalaFieldType(gen.genScalaType(attribute.Type))
+			if attribute.Optional {
+				fieldType = fmt.Sprintf("Option[%s] = None", fieldType)
+			}
+			params = append(params, fmt.Sprintf("%s: %s", genScalaPropertyName(attribute.Name+"Attr"), fieldType))
+		}
+		for _, group := range v.Groups {
+			fieldType := genScalaFieldType(gen.genScalaType(group.Ref))
+			if group.Plural {
+				fieldType = fmt.Sprintf("Seq[%s]", fieldType)
+			}
+			params = append(params, fmt.Sprintf("%s: %s", genScalaPropertyName(group.Name), fieldType))
+		}
+
+		for _, element := range elements {
+			fieldType := genScalaFieldType(gen.genScalaType(element.Type))
+			if element.Plural {
+				fieldType = fmt.Sprintf("Seq[%s] = Seq.empty", fieldType)
+			} else if element.Optional {
+				fieldType = fmt.Sprintf("Option[%s] = None", fieldType)
+			}
+			params = append(params, fmt.Sprintf("%s: %s", genScalaPropertyName(element.Name), fieldType))
+		}
+		if v.Mixed {
+			// the text segments of the mixed content.
+			params = append(params, "text: Seq[String] = Seq.empty")
+		}
+		gen.StructAST[v.Name] = genScalaCaseClass(genScalaFieldName(v.Name), params)
+		gen.Field += withDerivationComment(gen.StructAST[v.Name], v.Block, v.Final)
+	}
+	return
+}
+
+// ScalaGroup generates code for group XML schema in Scala language syntax.
+func (gen *CodeGenerator) ScalaGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var params []string
+		for _, element := range v.Elements {
+			fieldType := genScalaFieldType(gen.genScalaType(element.Type))
+			if element.Plural {
+				fieldType = fmt.Sprintf("Seq[%s] = Seq.empty", fieldType)
+			} else if element.Optional {
+				fieldType = fmt.Sprintf("Option[%s] = None", fieldType)
+			}
+			params = append(params, fmt.Sprintf("%s: %s", genScalaPropertyName(element.Name), fieldType))
+		}
+
+		for _, group := range v.Groups {
+			fieldType := genScalaFieldType(gen.genScalaType(group.Ref))
+			if group.Plural {
+				fieldType = fmt.Sprintf("Seq[%s]", fieldType)
+			}
+			params = append(params, fmt.Sprintf("%s: %s", genScalaPropertyName(group.Name), fieldType))
+		}
+		gen.StructAST[v.Name] = genScalaCaseClass(genScalaFieldName(v.Name), params)
+		gen.Field += gen.StructAST[v.Name]
+	}
+	return
+}
+
+// ScalaAttributeGroup generates code for attribute group XML schema in Scala
+// language syntax.
+func (gen *CodeGenerator) ScalaAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var params []string
+		for _, attribute := range v.Attributes {
+			fieldType := genScalaFieldType(gen.genScalaType(attribute.Type))
+			if attribute.Optional {
+				fieldType = fmt.Sprintf("Option[%s] = None", fieldType)
+			}
+			params = append(params, fmt.Sprintf("%s: %s", genScalaPropertyName(attribute.Name+"Attr"), fieldType))
+		}
+		gen.StructAST[v.Name] = genScalaCaseClass(genScalaFieldName(v.Name), params)
+		gen.Field += gen.StructAST[v.Name]
+	}
+	return
+}
+
+// ScalaElement generates code for element XML schema in Scala language
+// syntax.
+func (gen *CodeGenerator) ScalaElement(v *Element) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		fieldType := genScalaFieldType(gen.genScalaType(v.Type))
+		if v.Plural {
+			fieldType = fmt.Sprintf("Seq[%s]", fieldType)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf(" = %s\n", fieldType)
+		gen.Field += withDerivationComment(fmt.Sprintf("\ntype %s%s", genScalaFieldName(v.Name), gen.StructAST[v.Name]), v.Block, v.Final)
+	}
+	return
+}
+
+// ScalaAttribute generates code for attribute XML schema in Scala language
+// syntax.
+func (gen *CodeGenerator) ScalaAttribute(v *Attribute) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		fieldType := genScalaFieldType(gen.genScalaType(v.Type))
+		if v.Plural {
+			fieldType = fmt.Sprintf("Seq[%s]", fieldType)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf(" = %s\n", fieldType)
+		gen.Field += fmt.Sprintf("\ntype %s%s", genScalaFieldName(v.Name), gen.StructAST[v.Name])
+	}
+	return
+}
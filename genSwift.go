@@ -0,0 +1,313 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"strings"
+)
+
+var swiftBuildInType = map[string]bool{
+	"Bool":     true,
+	"Data":     true,
+	"Date":     true,
+	"Double":   true,
+	"Float":    true,
+	"Int":      true,
+	"Int16":    true,
+	"Int64":    true,
+	"Int8":     true,
+	"String":   true,
+	"[String]": true,
+}
+
+var swiftKeywords = map[string]bool{
+	"as": true, "associatedtype": true, "break": true, "case": true,
+	"catch": true, "class": true, "continue": true, "default": true,
+	"defer": true, "deinit": true, "do": true, "else": true, "enum": true,
+	"extension": true, "fallthrough": true, "false": true,
+	"fileprivate": true, "for": true, "func": true, "guard": true, "if": true,
+	"import": true, "in": true, "init": true, "inout": true, "internal": true,
+	"is": true, "let": true, "nil": true, "open": true, "operator": true,
+	"private": true, "protocol": true, "public": true, "repeat": true,
+	"rethrows": true, "return": true, "self": true, "static": true,
+	"struct": true, "subscript": true, "super": true, "switch": true,
+	"throw": true, "throws": true, "true": true, "try": true,
+	"typealias": true, "var": true, "where": true, "while": true,
+}
+
+// swiftProperty defines a property of the generated struct and the name of
+// the element or attribute in the XML document which it's coded by.
+type swiftProperty struct {
+	Name string
+	Type string
+	Key  string
+}
+
+// GenSwift generate Swift programming language source code for XML schema
+// definition files. Complex types are declared as the structs conforming to
+// Codable, simple types with enumerations are declared as the String-backed
+// enums, and other simple types are declared as type aliases.
+func (gen *CodeGenerator) GenSwift() error {
+	gen.genProtoTree("Swift")
+	return gen.writeSource(".swift", genSwiftFieldName, func(path, field string) ([]byte, error) {
+		return []byte(fmt.Sprintf("%s\n\nimport Foundation\n%s", copyright, field)), nil
+	})
+}
+
+func genSwiftFieldName(name string) (fieldName string) {
+	for _, str := range strings.Split(name, ":") {
+		fieldName += MakeFirstUpperCase(str)
+	}
+	var tmp string
+	for _, str := range strings.Split(fieldName, ".") {
+		tmp += MakeFirstUpperCase(str)
+	}
+	fieldName = tmp
+	fieldName = strings.Replace(strings.Replace(fieldName, "-", "", -1), "_", "", -1)
+	return
+}
+
+// genSwiftPropertyName generates the lower camel case property name of the
+// struct by given name, keywords will be quoted with backticks.
+func genSwiftPropertyName(name string) string {
+	fieldName := genSwiftFieldName(name)
+	if fieldName == "" {
+		return fieldName
+	}
+	fieldName = strings.ToLower(fieldName[:1]) + fieldName[1:]
+	if swiftKeywords[fieldName] {
+		return "`" + fieldName + "`"
+	}
+	return fieldName
+}
+
+func genSwiftFieldType(name string) string {
+	if _, ok := swiftBuildInType[name]; ok {
+		return name
+	}
+	var fieldType string
+	for _, str := range strings.Split(name, ".") {
+		fieldType += MakeFirstUpperCase(str)
+	}
+	fieldType = strings.Replace(MakeFirstUpperCase(strings.Replace(fieldType, "-", "", -1)), "_", "", -1)
+	if fieldType != "" {
+		return fieldType
+	}
+	return "String"
+}
+
+// genSwiftEnumName generates the lower camel case enum case by given
+// enumeration value, characters which are not allowed in the identifier will
+// be removed.
+func genSwiftEnumName(value string) string {
+	var enumName string
+	for _, str := range strings.FieldsFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		enumName += MakeFirstUpperCase(str)
+	}
+	if enumName == "" || enumName[0] >= '0' && enumName[0] <= '9' {
+		return "value" + enumName
+	}
+	enumName = strings.ToLower(enumName[:1]) + enumName[1:]
+	if swiftKeywords[enumName] {
+		return "`" + enumName + "`"
+	}
+	return enumName
+}
+
+// genSwiftProperty returns the property of the struct by given name, type
+// and occurrence of the element or attribute in the XML document. The
+// repeating properties are declared as arrays, and the optional ones are
+// declared as optional types.
+func genSwiftProperty(name, fieldType string, plural, optional bool) swiftProperty {
+	fieldType = genSwiftFieldType(fieldType)
+	if plural {
+		fieldType = fmt.Sprintf("[%s]", fieldType)
+	}
+	if optional {
+		fieldType += "?"
+	}
+	return swiftProperty{Name: genSwiftPropertyName(name), Type: fieldType, Key: name}
+}
+
+// genSwiftType returns the type of the definition by given type name, the
+// enumerations are declared as the Swift enums.
+func (gen *CodeGenerator) genSwiftType(name string) string {
+	return gen.getEnumOrBaseType(name)
+}
+
+// genSwiftStruct generates the struct declaration by given name and
+// properties, the CodingKeys enumeration maps the properties to the names of
+// the elements and attributes in the XML document.
+func genSwiftStruct(name string, properties []swiftProperty) string {
+	if len(properties) == 0 {
+		return fmt.Sprintf("\nstruct %s: Codable {}\n", name)
+	}
+	var content, codingKeys string
+	for _, property := range properties {
+		content += fmt.Sprintf("\tlet %s: %s\n", property.Name, property.Type)
+		if property.Name == property.Key {
+			codingKeys += fmt.Sprintf("\t\tcase %s\n", property.Name)
+			continue
+		}
+		codingKeys += fmt.Sprintf("\t\tcase %s = %q\n", property.Name, property.Key)
+	}
+	return fmt.Sprintf("\nstruct %s: Codable {\n%s\n\tenum CodingKeys: String, CodingKey {\n%s\t}\n}\n", name, content, codingKeys)
+}
+
+// SwiftSimpleType generates code for simple type XML schema in Swift language
+// syntax.
+func (gen *CodeGenerator) SwiftSimpleType(v *SimpleType) {
+	if v.List {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			fieldType := genSwiftFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))
+			content := fmt.Sprintf(" = [%s]\n", fieldType)
+			gen.StructAST[v.Name] = content
+			gen.Field += withDerivationComment(fmt.Sprintf("\ntypealias %s%s", genSwiftFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final)
+			return
+		}
+	}
+	if v.Union && len(v.MemberTypes) > 0 {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			var properties []swiftProperty
+			for _, memberName := range v.Members {
+				memberType := v.MemberTypes[memberName]
+				if memberType == "" { // fix order issue
+					memberType = gen.getBasefromSimpleType(memberName)
+				}
+				properties = append(properties, genSwiftProperty(memberName, memberType, false, true))
+			}
+			gen.StructAST[v.Name] = genSwiftStruct(genSwiftFieldName(v.Name), properties)
+			gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+		}
+		return
+	}
+	if len(v.Restriction.Enum) > 0 {
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			var content string
+			for _, enum := range v.Restriction.Enum {
+				if enumName := genSwiftEnumName(enum); enumName != enum {
+					content += fmt.Sprintf("\tcase %s = %q\n", enumName, enum)
+					continue
+				}
+				content += fmt.Sprintf("\tcase %s\n", enum)
+			}
+			gen.StructAST[v.Name] = fmt.Sprintf("\nenum %s: String, Codable {\n%s}\n", genSwiftFieldName(v.Name), content)
+			gen.Field += withDerivationComment(gen.StructAST[v.Name], "", v.Final)
+		}
+		return
+	}
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		content := fmt.Sprintf(" = %s\n", genSwiftFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base))))
+		gen.StructAST[v.Name] = content
+		gen.Field += withDerivationComment(fmt.Sprintf("\ntypealias %s%s", genSwiftFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final)
+	}
+	return
+}
+
+// SwiftComplexType generates code for complex type XML schema in Swift
+// language syntax.
+func (gen *CodeGenerator) SwiftComplexType(v *ComplexType) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var properties []swiftProperty
+		for _, attrGroup := range v.AttributeGroup {
+			properties = append(properties, genSwiftProperty(attrGroup.Name, gen.genSwiftType(attrGroup.Ref), false, false))
+		}
+
+		// Structs can't be derived from each other, the properties of the
+		// base types are included in the derived type instead.
+		elements, attributes := gen.complexTypeContent(v)
+		for _, attribute := range attributes {
+			property := genSwiftProperty(attribute.Name+"Attr", gen.genSwiftType(attribute.Type), false, attribute.Optional)
+			property.Key = attribute.Name
+			properties = append(properties, property)
+		}
+
+		for _, group := range v.Groups {
+			properties = append(properties, genSwiftProperty(group.Name, gen.genSwiftType(group.Ref), group.Plural, false))
+		}
+
+		for _, element := range elements {
+			properties = append(properties, genSwiftProperty(element.Name, gen.genSwiftType(element.Type), element.Plural, element.Optional))
+		}
+		if v.Mixed {
+			// the character data of the mixed content is decoded by the
+			// empty key, as the convention of XMLCoder.
+			property := genSwiftProperty("value", "String", false, true)
+			property.Key = ""
+			properties = append(properties, property)
+		}
+		gen.StructAST[v.Name] = genSwiftStruct(genSwiftFieldName(v.Name), properties)
+		gen.Field += withDerivationComment(gen.StructAST[v.Name], v.Block, v.Final)
+	}
+	return
+}
+
+// SwiftGroup generates code for group XML schema in Swift language syntax.
+func (gen *CodeGenerator) SwiftGroup(v *Group) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var properties []swiftProperty
+		for _, element := range v.Elements {
+			properties = append(properties, genSwiftProperty(element.Name, gen.genSwiftType(element.Type), element.Plural, element.Optional))
+		}
+
+		for _, group := range v.Groups {
+			properties = append(properties, genSwiftProperty(group.Name, gen.genSwiftType(group.Ref), group.Plural, false))
+		}
+		gen.StructAST[v.Name] = genSwiftStruct(genSwiftFieldName(v.Name), properties)
+		gen.Field += gen.StructAST[v.Name]
+	}
+	return
+}
+
+// SwiftAttributeGroup generates code for attribute group XML schema in Swift
+// language syntax.
+func (gen *CodeGenerator) SwiftAttributeGroup(v *AttributeGroup) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		var properties []swiftProperty
+		for _, attribute := range v.Attributes {
+			property := genSwiftProperty(attribute.Name+"Attr", gen.genSwiftType(attribute.Type), false, attribute.Optional)
+			property.Key = attribute.Name
+			properties = append(properties, property)
+		}
+		gen.StructAST[v.Name] = genSwiftStruct(genSwiftFieldName(v.Name), properties)
+		gen.Field += gen.StructAST[v.Name]
+	}
+	return
+}
+
+// SwiftElement generates code for element XML schema in Swift language
+// syntax.
+func (gen *CodeGenerator) SwiftElement(v *Element) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		fieldType := genSwiftFieldType(gen.genSwiftType(v.Type))
+		if v.Plural {
+			fieldType = fmt.Sprintf("[%s]", fieldType)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf(" = %s\n", fieldType)
+		gen.Field += withDerivationComment(fmt.Sprintf("\ntypealias %s%s", genSwiftFieldName(v.Name), gen.StructAST[v.Name]), v.Block, v.Final)
+	}
+	return
+}
+
+// SwiftAttribute generates code for attribute XML schema in Swift language
+// syntax.
+func (gen *CodeGenerator) SwiftAttribute(v *Attribute) {
+	if _, ok := gen.StructAST[v.Name]; !ok {
+		fieldType := genSwiftFieldType(gen.genSwiftType(v.Type))
+		if v.Plural {
+			fieldType = fmt.Sprintf("[%s]", fieldType)
+		}
+		gen.StructAST[v.Name] = fmt.Sprintf(" = %s\n", fieldType)
+		gen.Field += fmt.Sprintf("\ntypealias %s%s", genSwiftFieldName(v.Name), gen.StructAST[v.Name])
+	}
+	return
+}
@@ -10,12 +10,11 @@ package xgen
 
 import (
 	"fmt"
-	"os"
-	"reflect"
 	"strings"
 )
 
 var typeScriptBuildInType = map[string]bool{
+	"any":       true,
 	"boolean":   true,
 	"number":    true,
 	"string":    true,
@@ -27,22 +26,10 @@ var typeScriptBuildInType = map[string]bool{
 // GenTypeScript generate TypeScript programming language source code for XML
 // schema definition files.
 func (gen *CodeGenerator) GenTypeScript() error {
-	for _, ele := range gen.ProtoTree {
-		if ele == nil {
-			continue
-		}
-		funcName := fmt.Sprintf("TypeScript%s", reflect.TypeOf(ele).String()[6:])
-		callFuncByName(gen, funcName, []reflect.Value{reflect.ValueOf(ele)})
-	}
-	f, err := os.Create(gen.File + ".ts")
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-	source := []byte(fmt.Sprintf("%s\n%s", copyright, gen.Field))
-	f.Write(source)
-	return err
-
+	gen.genProtoTree("TypeScript")
+	return gen.writeSource(".ts", genTypeScriptFieldName, func(path, field string) ([]byte, error) {
+		return []byte(fmt.Sprintf("%s\n%s", copyright, field)), nil
+	})
 }
 
 func genTypeScriptFieldName(name string) (fieldName string) {
@@ -73,94 +60,156 @@ func genTypeScriptFieldType(name string) string {
 	return "any"
 }
 
+// genTypeScriptNullable returns the null union of the type for the nillable
+// element, which is null if the element is nil.
+func genTypeScriptNullable(nillable bool) string {
+	if nillable {
+		return " | null"
+	}
+	return ""
+}
+
+// genTypeScriptEnumName generates the enum member name by given enumeration
+// value, characters which are not allowed in the identifier will be removed.
+func genTypeScriptEnumName(value string) string {
+	var enumName string
+	for _, str := range strings.FieldsFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '$')
+	}) {
+		enumName += MakeFirstUpperCase(str)
+	}
+	if enumName == "" || enumName[0] >= '0' && enumName[0] <= '9' {
+		return "Enum" + enumName
+	}
+	return enumName
+}
+
+// genTypeScriptString generates the single quoted TypeScript string literal
+// by given value.
+func genTypeScriptString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`).Replace(value) + "'"
+}
+
+// genTypeScriptType returns the type of the field by given type name, the
+// enumerations are declared as the literal types or enums.
+func (gen *CodeGenerator) genTypeScriptType(name string) string {
+	return gen.getEnumOrBaseType(name)
+}
+
 // TypeScriptSimpleType generates code for simple type XML schema in TypeScript language
 // syntax.
 func (gen *CodeGenerator) TypeScriptSimpleType(v *SimpleType) {
 	if v.List {
 		if _, ok := gen.StructAST[v.Name]; !ok {
-			fieldType := genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(v.Base), gen.ProtoTree))
+			fieldType := genTypeScriptFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))
 			content := fmt.Sprintf(" = Array<%s>;\n", genTypeScriptFieldType(fieldType))
 			gen.StructAST[v.Name] = content
-			gen.Field += fmt.Sprintf("\nexport type %s%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name])
+			gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\nexport type %s%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), genBlockDocComment(v.Doc, ""))
 			return
 		}
 	}
 	if v.Union && len(v.MemberTypes) > 0 {
 		if _, ok := gen.StructAST[v.Name]; !ok {
-			content := " {\n"
-			for memberName, memberType := range v.MemberTypes {
+			// the value is any of the member types, which are tried in the
+			// declared order.
+			var memberTypes []string
+			for _, memberName := range v.Members {
+				memberType := v.MemberTypes[memberName]
 				if memberType == "" { // fix order issue
-					memberType = getBasefromSimpleType(memberName, gen.ProtoTree)
+					memberType = gen.getBasefromSimpleType(memberName)
+				}
+				if isEnumSimpleType(memberName, gen.ProtoTree) {
+					memberType = memberName
 				}
-				content += fmt.Sprintf("\t%s: %s;\n", genTypeScriptFieldName(memberName), genTypeScriptFieldType(memberType))
+				memberTypes = append(memberTypes, genTypeScriptFieldType(memberType))
 			}
-			content += "}\n"
-			gen.StructAST[v.Name] = content
-			gen.Field += fmt.Sprintf("\nexport class %s%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name])
+			gen.StructAST[v.Name] = fmt.Sprintf(" = %s;\n", strings.Join(memberTypes, " | "))
+			gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\nexport type %s%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), genBlockDocComment(v.Doc, ""))
 		}
 		return
 	}
 	if len(v.Restriction.Enum) > 0 {
-		var content string
-		baseType := genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(v.Base), gen.ProtoTree))
-		for _, enum := range v.Restriction.Enum {
-			switch baseType {
-			case "string":
-				content += fmt.Sprintf("\t%s = '%s',\n", enum, enum)
-			case "number":
-				content += fmt.Sprintf("\tEnum%s = %s,\n", enum, enum)
-			default:
-				content += fmt.Sprintf("\tEnum%s = '%s',\n", enum, enum)
+		if _, ok := gen.StructAST[v.Name]; !ok {
+			baseType := genTypeScriptFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base)))
+			var values []string
+			for _, enum := range v.Restriction.Enum {
+				value := genTypeScriptString(enum)
+				if baseType == "number" {
+					value = enum
+				}
+				if gen.TypeScriptEnum {
+					value = fmt.Sprintf("\t%s = %s,\n", genTypeScriptEnumName(enum), value)
+				}
+				values = append(values, value)
+			}
+			if gen.TypeScriptEnum {
+				gen.StructAST[v.Name] = fmt.Sprintf(" {\n%s}\n", strings.Join(values, ""))
+				gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\nexport enum %s%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), genBlockDocComment(v.Doc, ""))
+				return
 			}
+			gen.StructAST[v.Name] = fmt.Sprintf(" %s;\n", strings.Join(values, " | "))
+			gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\nexport type %s =%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), genBlockDocComment(v.Doc, ""))
 		}
-		gen.Field += fmt.Sprintf("\nexport enum %s {\n%s}\n", genTypeScriptFieldName(v.Name), content)
 		return
 	}
 	if _, ok := gen.StructAST[v.Name]; !ok {
-		content := fmt.Sprintf(" %s;\n", genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(v.Base), gen.ProtoTree)))
+		content := fmt.Sprintf(" %s;\n", genTypeScriptFieldType(gen.getBasefromSimpleType(trimNSPrefix(v.Base))))
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\nexport type %s =%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name])
+		gen.Field += withDocComment(withAssertionComment(withDerivationComment(fmt.Sprintf("\nexport type %s =%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name]), "", v.Final), v.Restriction.Assertions), genBlockDocComment(v.Doc, ""))
 	}
 	return
 }
 
 // TypeScriptComplexType generates code for complex type XML schema in TypeScript language
-// syntax.
+// syntax. The abstract complex type is declared as the abstract class, which
+// is extended by the classes of the types derived from it by extension.
 func (gen *CodeGenerator) TypeScriptComplexType(v *ComplexType) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		content := " {\n"
 		for _, attrGroup := range v.AttributeGroup {
-			fieldType := getBasefromSimpleType(trimNSPrefix(attrGroup.Ref), gen.ProtoTree)
+			fieldType := gen.getBasefromSimpleType(trimNSPrefix(attrGroup.Ref))
 			content += fmt.Sprintf("\t%s: %s;\n", genTypeScriptFieldName(attrGroup.Name), genTypeScriptFieldType(fieldType))
 		}
 
 		for _, attribute := range v.Attributes {
+			content += genBlockDocComment(attribute.Doc, "\t")
 			var optional string
 			if attribute.Optional {
 				optional = ` | null`
 			}
-			fieldType := genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(attribute.Type), gen.ProtoTree))
+			fieldType := genTypeScriptFieldType(gen.genTypeScriptType(attribute.Type))
 			content += fmt.Sprintf("\t%sAttr: %s%s;\n", genTypeScriptFieldName(attribute.Name), fieldType, optional)
 		}
 		for _, group := range v.Groups {
 			if group.Plural {
-				content += fmt.Sprintf("\t%s: Array<%s>;\n", genTypeScriptFieldName(group.Name), genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(group.Ref), gen.ProtoTree)))
+				content += fmt.Sprintf("\t%s: Array<%s>;\n", genTypeScriptFieldName(group.Name), genTypeScriptFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref))))
 				continue
 			}
-			content += fmt.Sprintf("\t%s: %s;\n", genTypeScriptFieldName(group.Name), genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(group.Ref), gen.ProtoTree)))
+			content += fmt.Sprintf("\t%s: %s;\n", genTypeScriptFieldName(group.Name), genTypeScriptFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref))))
 		}
 
 		for _, element := range v.Elements {
-			fieldType := genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(element.Type), gen.ProtoTree))
+			content += genBlockDocComment(element.Doc, "\t")
+			fieldType := genTypeScriptFieldType(gen.genTypeScriptType(element.Type))
 			if element.Plural {
 				content += fmt.Sprintf("\t%s: Array<%s>;\n", genTypeScriptFieldName(element.Name), fieldType)
 				continue
 			}
-			content += fmt.Sprintf("\t%s: Array<%s>;\n", genTypeScriptFieldName(element.Name), fieldType)
+			content += fmt.Sprintf("\t%s: Array<%s>%s;\n", genTypeScriptFieldName(element.Name), fieldType, genTypeScriptNullable(element.Nillable))
+		}
+		if v.Mixed {
+			content += "\tValue: string; // character data of mixed content\n"
 		}
 		content += "}\n"
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\nexport class %s%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name])
+		declaration := "class " + genTypeScriptFieldName(v.Name)
+		if v.Abstract {
+			declaration = "abstract " + declaration
+		}
+		if base := gen.getAbstractBase(v); base != "" {
+			declaration += " extends " + genTypeScriptFieldName(base)
+		}
+		gen.Field += withDocComment(withAssertionComment(withDerivationComment(fmt.Sprintf("\nexport %s%s", declaration, gen.StructAST[v.Name]), v.Block, v.Final), v.Assertions), genBlockDocComment(v.Doc, ""))
 	}
 	return
 }
@@ -170,24 +219,25 @@ func (gen *CodeGenerator) TypeScriptGroup(v *Group) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		content := " {\n"
 		for _, element := range v.Elements {
+			content += genBlockDocComment(element.Doc, "\t")
 			if element.Plural {
-				content += fmt.Sprintf("\t%s: Array<%s>;\n", genTypeScriptFieldName(element.Name), genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(element.Type), gen.ProtoTree)))
+				content += fmt.Sprintf("\t%s: Array<%s>;\n", genTypeScriptFieldName(element.Name), genTypeScriptFieldType(gen.genTypeScriptType(element.Type)))
 				continue
 			}
-			content += fmt.Sprintf("\t%s: %s;\n", genTypeScriptFieldName(element.Name), genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(element.Type), gen.ProtoTree)))
+			content += fmt.Sprintf("\t%s: %s%s;\n", genTypeScriptFieldName(element.Name), genTypeScriptFieldType(gen.genTypeScriptType(element.Type)), genTypeScriptNullable(element.Nillable))
 		}
 
 		for _, group := range v.Groups {
 			if group.Plural {
-				content += fmt.Sprintf("\t%s: Array<%s>;\n", genTypeScriptFieldName(group.Name), genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(group.Ref), gen.ProtoTree)))
+				content += fmt.Sprintf("\t%s: Array<%s>;\n", genTypeScriptFieldName(group.Name), genTypeScriptFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref))))
 				continue
 			}
-			content += fmt.Sprintf("\t%s: %s;\n", genTypeScriptFieldName(group.Name), genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(group.Ref), gen.ProtoTree)))
+			content += fmt.Sprintf("\t%s: %s;\n", genTypeScriptFieldName(group.Name), genTypeScriptFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref))))
 		}
 
 		content += "}\n"
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\nexport class %s%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name])
+		gen.Field += withDocComment(fmt.Sprintf("\nexport class %s%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name]), genBlockDocComment(v.Doc, ""))
 	}
 	return
 }
@@ -198,15 +248,16 @@ func (gen *CodeGenerator) TypeScriptAttributeGroup(v *AttributeGroup) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		content := " {\n"
 		for _, attribute := range v.Attributes {
+			content += genBlockDocComment(attribute.Doc, "\t")
 			var optional string
 			if attribute.Optional {
 				optional = ` | null`
 			}
-			content += fmt.Sprintf("\t%sAttr: %s%s;\n", genTypeScriptFieldName(attribute.Name), genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(attribute.Type), gen.ProtoTree)), optional)
+			content += fmt.Sprintf("\t%sAttr: %s%s;\n", genTypeScriptFieldName(attribute.Name), genTypeScriptFieldType(gen.genTypeScriptType(attribute.Type)), optional)
 		}
 		content += "}\n"
 		gen.StructAST[v.Name] = content
-		gen.Field += fmt.Sprintf("\nexport class %s%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name])
+		gen.Field += withDocComment(fmt.Sprintf("\nexport class %s%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name]), genBlockDocComment(v.Doc, ""))
 	}
 	return
 }
@@ -215,12 +266,12 @@ func (gen *CodeGenerator) TypeScriptAttributeGroup(v *AttributeGroup) {
 func (gen *CodeGenerator) TypeScriptElement(v *Element) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		if v.Plural {
-			gen.StructAST[v.Name] = fmt.Sprintf(" Array<%s>;\n", genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(v.Type), gen.ProtoTree)))
+			gen.StructAST[v.Name] = fmt.Sprintf(" Array<%s>;\n", genTypeScriptFieldType(gen.genTypeScriptType(v.Type)))
 		} else {
-			gen.StructAST[v.Name] = fmt.Sprintf(" %s;\n", genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(v.Type), gen.ProtoTree)))
+			gen.StructAST[v.Name] = fmt.Sprintf(" %s;\n", genTypeScriptFieldType(gen.genTypeScriptType(v.Type)))
 		}
 
-		gen.Field += fmt.Sprintf("\nexport type %s =%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name])
+		gen.Field += withDocComment(withDerivationComment(fmt.Sprintf("\nexport type %s =%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name]), v.Block, v.Final), genBlockDocComment(v.Doc, ""))
 	}
 	return
 }
@@ -229,11 +280,11 @@ func (gen *CodeGenerator) TypeScriptElement(v *Element) {
 func (gen *CodeGenerator) TypeScriptAttribute(v *Attribute) {
 	if _, ok := gen.StructAST[v.Name]; !ok {
 		if v.Plural {
-			gen.StructAST[v.Name] = fmt.Sprintf(" Array<%s>;\n", genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(v.Type), gen.ProtoTree)))
+			gen.StructAST[v.Name] = fmt.Sprintf(" Array<%s>;\n", genTypeScriptFieldType(gen.genTypeScriptType(v.Type)))
 		} else {
-			gen.StructAST[v.Name] = fmt.Sprintf(" %s;\n", genTypeScriptFieldType(getBasefromSimpleType(trimNSPrefix(v.Type), gen.ProtoTree)))
+			gen.StructAST[v.Name] = fmt.Sprintf(" %s;\n", genTypeScriptFieldType(gen.genTypeScriptType(v.Type)))
 		}
-		gen.Field += fmt.Sprintf("\nexport type %s =%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name])
+		gen.Field += withDocComment(fmt.Sprintf("\nexport type %s =%s", genTypeScriptFieldName(v.Name), gen.StructAST[v.Name]), genBlockDocComment(v.Doc, ""))
 	}
 	return
 }
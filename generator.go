@@ -0,0 +1,87 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import "sync"
+
+// Generator is implemented by the custom code generators which can be
+// registered by RegisterGenerator. Each method receives a top-level
+// definition of the schema in document order with the references to groups
+// and attribute groups resolved, and returns the source code of the
+// declaration. Render returns the complete file content by given source code
+// of the declarations which are written into the file with FileExtension.
+type Generator interface {
+	FileExtension() string
+	SimpleType(v *SimpleType) string
+	ComplexType(v *ComplexType) string
+	Attribute(v *Attribute) string
+	Element(v *Element) string
+	Group(v *Group) string
+	AttributeGroup(v *AttributeGroup) string
+	Render(field string) ([]byte, error)
+}
+
+var (
+	generatorsMu sync.RWMutex
+	generators   = map[string]Generator{}
+)
+
+// RegisterGenerator makes the code generator available by the language name,
+// which is consulted before the built-in languages, so a built-in language
+// can be replaced as well. The data types in XSD are converted to the Go
+// types for the languages which are not built-in. If RegisterGenerator is
+// called twice with the same language name, the last one is used, and a nil
+// generator unregisters the language.
+func RegisterGenerator(lang string, gen Generator) {
+	generatorsMu.Lock()
+	defer generatorsMu.Unlock()
+	if gen == nil {
+		delete(generators, lang)
+		return
+	}
+	generators[lang] = gen
+}
+
+// registeredGenerator returns the code generator registered with the language
+// name.
+func registeredGenerator(lang string) (gen Generator, ok bool) {
+	generatorsMu.RLock()
+	defer generatorsMu.RUnlock()
+	gen, ok = generators[lang]
+	return
+}
+
+// genRegistered generates the source code for XML schema definition files by
+// the registered code generator.
+func (gen *CodeGenerator) genRegistered(g Generator) error {
+	gen.genProtoTree(gen.Lang)
+	return gen.writeSource(g.FileExtension(), genGoFieldName, func(path, field string) ([]byte, error) {
+		return g.Render(field)
+	})
+}
+
+// genRegisteredDecl returns the source code of the declaration generated by
+// the registered code generator for the element of the proto tree.
+func genRegisteredDecl(g Generator, ele interface{}) string {
+	switch v := ele.(type) {
+	case *SimpleType:
+		return g.SimpleType(v)
+	case *ComplexType:
+		return g.ComplexType(v)
+	case *Attribute:
+		return g.Attribute(v)
+	case *Element:
+		return g.Element(v)
+	case *Group:
+		return g.Group(v)
+	case *AttributeGroup:
+		return g.AttributeGroup(v)
+	}
+	return ""
+}
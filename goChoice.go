@@ -0,0 +1,124 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"strings"
+)
+
+var goChoiceTemplate = `
+// %[2]s returns the name of the element of the choice in the %[1]s which is
+// present, or an empty string if none of %[3]s is present.
+func (v *%[1]s) %[2]s() string {
+	if v == nil {
+		return ""
+	}
+	switch {
+%[4]s	}
+	return ""
+}
+`
+
+// goChoiceElement returns the choice of the complex type containing the
+// element, or nil if the element isn't a branch of a choice.
+func goChoiceElement(v *ComplexType, element Element) *Choice {
+	for i, choice := range v.Choices {
+		for _, name := range choice.Elements {
+			if name == element.Name {
+				return &v.Choices[i]
+			}
+		}
+	}
+	return nil
+}
+
+// goChoicePresence returns the condition which reports whether the element
+// of the choice is present in the value of the complex type.
+func (gen *CodeGenerator) goChoicePresence(v *ComplexType, element Element, derivedTypes map[string][]string) string {
+	fieldName := genGoFieldName(element.Name)
+	if gen.isGoPolymorphicElement(element, derivedTypes) && !element.Plural && !element.Wildcard {
+		return fmt.Sprintf("v.%s.Value != nil", fieldName)
+	}
+	if fieldType, _ := gen.genGoOptionalElementType(v, element, derivedTypes); strings.HasPrefix(fieldType, "[]") {
+		return fmt.Sprintf("len(v.%s) > 0", fieldName)
+	}
+	return fmt.Sprintf("v.%s != nil", fieldName)
+}
+
+// goChoiceMethodName returns the name of the method reporting the element
+// of the choice with the index in the complex type which is present.
+func goChoiceMethodName(index int) string {
+	if index == 0 {
+		return "Choice"
+	}
+	return fmt.Sprintf("Choice%d", index+1)
+}
+
+// genGoChoiceMethods generates the method reporting the present element of
+// each choice in the complex types, which discriminates the branches of the
+// choice since only one of them can be present.
+func (gen *CodeGenerator) genGoChoiceMethods() {
+	derivedTypes := getDerivedTypes(gen.ProtoTree)
+	names := map[string]bool{}
+	for _, ele := range gen.ProtoTree {
+		v, ok := ele.(*ComplexType)
+		if !ok || len(v.Choices) == 0 || names[v.Name] {
+			continue
+		}
+		names[v.Name] = true
+		fieldName := genGoFieldName(v.Name)
+		for i, choice := range v.Choices {
+			var cases string
+			for _, element := range v.Elements {
+				if goChoiceElement(v, element) == &v.Choices[i] {
+					cases += fmt.Sprintf("\tcase %s:\n\t\treturn %q\n", gen.goChoicePresence(v, element, derivedTypes), element.Name)
+				}
+			}
+			methodName := goChoiceMethodName(i)
+			start := len(gen.Field)
+			gen.Field += fmt.Sprintf(goChoiceTemplate, fieldName, methodName, strings.Join(choice.Elements, ", "), cases)
+			gen.Decls = append(gen.Decls, Decl{Name: fieldName + methodName, Source: gen.Field[start:]})
+		}
+	}
+}
+
+// genGoChoiceCheck returns the statements of the Validate method checking
+// the choices of the complex type, an error is returned if more than one of
+// the elements of a choice is present, or none of them is present in a
+// required choice.
+func (gen *CodeGenerator) genGoChoiceCheck(v *ComplexType) (content string) {
+	for i, choice := range v.Choices {
+		check := "\tif choice != 1 {\n\t\treturn fmt.Errorf(%q)\n\t}\n"
+		message := fmt.Sprintf("%s: exactly one of the elements %s must be present", v.Name, strings.Join(choice.Elements, ", "))
+		if choice.Optional {
+			check = "\tif choice > 1 {\n\t\treturn fmt.Errorf(%q)\n\t}\n"
+			message = fmt.Sprintf("%s: at most one of the elements %s can be present", v.Name, strings.Join(choice.Elements, ", "))
+		}
+		if content += "\tchoice = 0\n"; i == 0 {
+			content = "\tchoice := 0\n"
+		}
+		content += fmt.Sprintf("\tfor _, present := range []bool{%s} {\n\t\tif present {\n\t\t\tchoice++\n\t\t}\n\t}\n", gen.goChoiceConditions(v, &v.Choices[i]))
+		content += fmt.Sprintf(check, message)
+	}
+	return
+}
+
+// goChoiceConditions returns the conditions reporting whether each element
+// of the choice is present.
+func (gen *CodeGenerator) goChoiceConditions(v *ComplexType, choice *Choice) string {
+	derivedTypes := getDerivedTypes(gen.ProtoTree)
+	var conditions []string
+	for _, element := range v.Elements {
+		if goChoiceElement(v, element) == choice {
+			conditions = append(conditions, gen.goChoicePresence(v, element, derivedTypes))
+		}
+	}
+	return strings.Join(conditions, ", ")
+}
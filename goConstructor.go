@@ -0,0 +1,236 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true,
+	"default": true, "defer": true, "else": true, "fallthrough": true,
+	"for": true, "func": true, "go": true, "goto": true, "if": true,
+	"import": true, "interface": true, "map": true, "package": true,
+	"range": true, "return": true, "select": true, "struct": true,
+	"switch": true, "type": true, "var": true,
+}
+
+// goConstructorField defines a field of the complex type which is
+// initialized by the constructor. The pointer field tracking the presence of
+// the attribute is set by the address of the value.
+type goConstructorField struct {
+	Name     string
+	Type     string
+	Default  string
+	Required bool
+	Pointer  bool
+}
+
+var goConstructorTemplate = `
+// New%[1]s creates the %[1]s with the required fields and the default values
+// of the optional fields, then applies the options to it.
+func New%[1]s(%[2]s) *%[1]s {
+	v := &%[1]s{}
+%[3]s	for _, option := range options {
+		option(v)
+	}
+	return v
+}
+
+// %[1]sOption sets an optional field of the %[1]s created by New%[1]s.
+type %[1]sOption func(*%[1]s)
+`
+
+var goConstructorOptionTemplate = `
+// With%[1]s%[2]s sets the %[2]s of the %[1]s.
+func With%[1]s%[2]s(value %[3]s) %[1]sOption {
+	return func(v *%[1]s) {
+		v.%[2]s = %[4]s
+	}
+}
+`
+
+// genGoParamName returns the name of the constructor parameter for the
+// field, keywords and the names used by the constructor will be suffixed
+// with an underscore.
+func genGoParamName(fieldName string) string {
+	paramName := strings.ToLower(fieldName[:1]) + fieldName[1:]
+	if goKeywords[paramName] || paramName == "v" || paramName == "options" {
+		return paramName + "_"
+	}
+	return paramName
+}
+
+// genGoLiteral returns the literal of the default value for the field type,
+// the named simple types are converted from the literals of their base
+// types. The values which can't be represented by the type are ignored.
+func (gen *CodeGenerator) genGoLiteral(fieldType, typeName, value string) (string, bool) {
+	base := fieldType
+	if !goBuildinType[fieldType] {
+		base = gen.getBasefromSimpleType(trimNSPrefix(typeName))
+	}
+	var literal string
+	switch base {
+	case "string":
+		literal = strconv.Quote(value)
+	case "bool":
+		switch strings.TrimSpace(value) {
+		case "true", "1":
+			literal = "true"
+		case "false", "0":
+			literal = "false"
+		default:
+			return "", false
+		}
+	case "float32", "float64":
+		f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return "", false
+		}
+		literal = strconv.FormatFloat(f, 'g', -1, 64)
+	case "int", "int8", "int16", "int32", "int64":
+		i, err := strconv.ParseInt(strings.TrimPrefix(strings.TrimSpace(value), "+"), 10, 64)
+		if err != nil {
+			return "", false
+		}
+		literal = strconv.FormatInt(i, 10)
+	case "byte", "uint", "uint8", "uint16", "uint32", "uint64":
+		u, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(value), "+"), 10, 64)
+		if err != nil {
+			return "", false
+		}
+		literal = strconv.FormatUint(u, 10)
+	default:
+		return "", false
+	}
+	if base != fieldType {
+		literal = fmt.Sprintf("%s(%s)", fieldType, literal)
+	}
+	return literal, true
+}
+
+// goConstructorFields returns the fields of the complex type initialized by
+// the constructor, the fields of the embedded base types are promoted, so
+// they are returned first unless they are shadowed by the fields of the
+// derived type. The required-ness of the attributes comes from the use, and
+// the one of the elements comes from the minOccurs.
+func (gen *CodeGenerator) goConstructorFields(v *ComplexType, visited map[string]bool) (fields []goConstructorField) {
+	visited[v.Name] = true
+	derivedTypes := getDerivedTypes(gen.ProtoTree)
+	for _, attrGroup := range v.AttributeGroup {
+		fields = append(fields, goConstructorField{Name: genGoFieldName(attrGroup.Name), Type: genGoFieldType(gen.getBasefromSimpleType(trimNSPrefix(attrGroup.Ref)))})
+	}
+	for _, attribute := range v.Attributes {
+		if attribute.Prohibited {
+			continue
+		}
+		fieldName, _ := genGoAttributeName(attribute.Name)
+		field := goConstructorField{Name: fieldName, Type: gen.genGoAttributeType(attribute), Required: !attribute.Optional}
+		if fieldType := gen.genGoType(attribute.Type); field.Type != fieldType {
+			field.Type, field.Pointer = fieldType, true
+		}
+		if attribute.Default != "" {
+			field.Default, _ = gen.genGoLiteral(field.Type, attribute.Type, attribute.Default)
+		}
+		fields = append(fields, field)
+	}
+	for _, group := range v.Groups {
+		var plural string
+		if group.Plural {
+			plural = "[]"
+		}
+		fields = append(fields, goConstructorField{Name: genGoFieldName(group.Name), Type: plural + genGoFieldType(gen.getBasefromSimpleType(trimNSPrefix(group.Ref)))})
+	}
+	for _, element := range v.Elements {
+		if element.Wildcard {
+			continue
+		}
+		field := goConstructorField{Name: genGoFieldName(element.Name), Type: gen.genGoElementType(element, derivedTypes), Required: !element.Optional}
+		_, field.Pointer = gen.genGoOptionalElementType(v, element, derivedTypes)
+		if element.Default != "" && !element.Plural && !element.Nillable {
+			field.Default, _ = gen.genGoLiteral(field.Type, element.Type, element.Default)
+		}
+		fields = append(fields, field)
+	}
+	if v.Mixed {
+		fields = append(fields, goConstructorField{Name: "Value", Type: "string"})
+	} else if valueType := gen.genGoCharDataType(v); valueType != "" {
+		fields = append(fields, goConstructorField{Name: "Value", Type: valueType})
+	}
+	if _, qualified := gen.typePackages[trimNSPrefix(v.Base)]; !v.Extension || qualified {
+		return
+	}
+	for _, ele := range gen.ProtoTree {
+		base, ok := ele.(*ComplexType)
+		if !ok || base.Name != trimNSPrefix(v.Base) || visited[base.Name] {
+			continue
+		}
+		shadowed := map[string]bool{}
+		for _, field := range fields {
+			shadowed[field.Name] = true
+		}
+		var promoted []goConstructorField
+		for _, field := range gen.goConstructorFields(base, visited) {
+			if !shadowed[field.Name] {
+				promoted = append(promoted, field)
+			}
+		}
+		return append(promoted, fields...)
+	}
+	return
+}
+
+// genGoConstructors generates the constructor for the complex types if the
+// GoConstructors of the code generator is set. The constructor takes the
+// required fields as the parameters and applies the default values of the
+// optional fields, which can be set by the functional options. The abstract
+// types can't be instantiated, so they have no constructors.
+func (gen *CodeGenerator) genGoConstructors() {
+	if !gen.GoConstructors {
+		return
+	}
+	names := map[string]bool{}
+	for _, ele := range gen.ProtoTree {
+		v, ok := ele.(*ComplexType)
+		if !ok || names[v.Name] || v.Abstract {
+			continue
+		}
+		if _, ok := gen.goListItem(v.Name); ok {
+			continue
+		}
+		names[v.Name] = true
+		typeName := genGoFieldName(v.Name)
+		var params []string
+		var assignments, options string
+		for _, field := range gen.goConstructorFields(v, map[string]bool{}) {
+			value, address := genGoParamName(field.Name), ""
+			if field.Pointer {
+				address = "&"
+			}
+			if field.Required {
+				params = append(params, fmt.Sprintf("%s %s", value, field.Type))
+				assignments += fmt.Sprintf("\tv.%s = %s%s\n", field.Name, address, value)
+				continue
+			}
+			if field.Default != "" && field.Pointer {
+				assignments += fmt.Sprintf("\tv.%[1]s = new(%[2]s)\n\t*v.%[1]s = %[3]s\n", field.Name, field.Type, field.Default)
+			} else if field.Default != "" {
+				assignments += fmt.Sprintf("\tv.%s = %s\n", field.Name, field.Default)
+			}
+			options += fmt.Sprintf(goConstructorOptionTemplate, typeName, field.Name, field.Type, address+"value")
+		}
+		params = append(params, fmt.Sprintf("options ...%sOption", typeName))
+		start := len(gen.Field)
+		gen.Field += fmt.Sprintf(goConstructorTemplate, typeName, strings.Join(params, ", "), assignments) + options
+		gen.Decls = append(gen.Decls, Decl{Name: typeName + "Constructor", Source: gen.Field[start:]})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"strings"
+)
+
+var goDocumentTemplate = `
+// %[1]s is the root element %[2]s of the XML document.
+type %[1]s struct {
+	XMLName	xml.Name	` + "`xml:\"%[3]s\"`" + `
+%[4]s}
+
+// Unmarshal parses the XML document and returns the root element of it.
+func Unmarshal(data []byte) (*%[1]s, error) {
+	v := &%[1]s{}
+	if err := xml.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Marshal returns the XML document of the root element, which starts with
+// the XML declaration.
+func (v *%[1]s) Marshal() ([]byte, error) {
+	v.XMLName = xml.Name{Space: %[5]q, Local: %[6]q}
+	data, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(%[7]q), data...), nil
+}
+`
+
+// genGoDocument generates the root type of the XML document and the helpers
+// to unmarshal and marshal it if the GoDocument of the code generator is set
+// and the schema declares a single global element. The root type is named
+// Document unless the name is taken by the definitions in the schema, the
+// declaration written by the helper is encoded in UTF-8 by default.
+func (gen *CodeGenerator) genGoDocument() {
+	if !gen.GoDocument {
+		return
+	}
+	var root *Element
+	names := map[string]bool{}
+	for _, ele := range gen.ProtoTree {
+		if kind, name := definitionKind(ele); kind != "" {
+			names[genGoFieldName(name)] = true
+		}
+		if v, ok := ele.(*Element); ok {
+			if root != nil && root.Name != v.Name {
+				return
+			}
+			root = v
+		}
+	}
+	if root == nil {
+		return
+	}
+	typeName := "Document"
+	if names[typeName] {
+		typeName = genGoFieldName(root.Name) + typeName
+	}
+	fieldType := gen.genGoType(root.Type)
+	content := fmt.Sprintf("\t%s\n", strings.TrimPrefix(fieldType, "*"))
+	if _, ok := gen.goListItem(root.Type); !strings.HasPrefix(fieldType, "*") || ok {
+		if fieldType == "time.Time" {
+			gen.ImportTime = true
+		}
+		content = fmt.Sprintf("\tValue\t%s\t`xml:\",chardata\"`\n", fieldType)
+	}
+	xmlName := strings.TrimSpace(gen.Namespace + " " + root.Name)
+	encoding := gen.GoDocumentEncoding
+	if encoding == "" {
+		encoding = "UTF-8"
+	}
+	header := fmt.Sprintf("<?xml version=\"1.0\" encoding=\"%s\"?>\n", encoding)
+	gen.ImportEncodingXML = true
+	start := len(gen.Field)
+	gen.Field += fmt.Sprintf(goDocumentTemplate, typeName, root.Name, xmlName, content, gen.Namespace, root.Name, header)
+	gen.Decls = append(gen.Decls, Decl{Name: typeName, Source: gen.Field[start:]})
+}
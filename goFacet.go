@@ -0,0 +1,174 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var goFacetTemplate = `
+// Validate checks the value of the %[1]s against the facets of the simple
+// type, an error is returned if the value is out of range, its length is
+// out of bounds, or it doesn't match the pattern.
+func (v %[1]s) Validate() error {
+%[2]s	return nil
+}
+`
+
+var goNumericType = map[string]bool{
+	"float32": true, "float64": true,
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+}
+
+// goFacetType returns the simple type with the name which has the Validate
+// method checking its facets if the GoValidate of the code generator is set,
+// that are the types restricted by the range, length or pattern facets, or
+// derived from such types, which aren't the enumerations, lists or unions.
+func (gen *CodeGenerator) goFacetType(name string) *SimpleType {
+	if !gen.GoValidate {
+		return nil
+	}
+	name = trimNSPrefix(name)
+	for visited := map[string]bool{}; !visited[name]; {
+		visited[name] = true
+		var simpleType *SimpleType
+		for _, ele := range gen.ProtoTree {
+			if v, ok := ele.(*SimpleType); ok && v.Name == name {
+				simpleType = v
+				break
+			}
+		}
+		if simpleType == nil || simpleType.List || simpleType.Union || len(simpleType.Restriction.Enum) > 0 {
+			return nil
+		}
+		if gen.isGoFacetRestriction(simpleType) {
+			return simpleType
+		}
+		name = trimNSPrefix(simpleType.Base)
+	}
+	return nil
+}
+
+// isGoFacetRestriction reports whether the restriction of the simple type has
+// the facets which can be checked on the Go type of its values, or the
+// assertions checked by the hook.
+func (gen *CodeGenerator) isGoFacetRestriction(v *SimpleType) bool {
+	restriction, fieldType := v.Restriction, gen.getBasefromSimpleType(trimNSPrefix(v.Base))
+	if len(restriction.Assertions) > 0 {
+		return true
+	}
+	if restriction.Pattern != nil {
+		return fieldType == "string" || goNumericType[fieldType]
+	}
+	if restriction.MinLength > 0 || restriction.HasMaxLength {
+		return fieldType == "string" || fieldType == "[]byte"
+	}
+	return (restriction.HasMin || restriction.HasMax) && goNumericType[fieldType]
+}
+
+// genGoFacetMethods generates the Validate method for the simple type with
+// the facets, and the regular expression of its pattern. The Validate method
+// of the base type is called first, so the facets inherited by restriction
+// are also checked.
+func (gen *CodeGenerator) genGoFacetMethods(v *SimpleType) string {
+	if gen.goFacetType(v.Name) != v {
+		return ""
+	}
+	var content, declarations string
+	fieldName, fieldType := genGoFieldName(v.Name), gen.getBasefromSimpleType(trimNSPrefix(v.Base))
+	if base := gen.goFacetType(v.Base); base != nil {
+		content += fmt.Sprintf("\tif err := %s(v).Validate(); err != nil {\n\t\treturn err\n\t}\n", genGoFieldName(base.Name))
+	}
+	restriction := v.Restriction
+	if goNumericType[fieldType] {
+		for _, facet := range []struct {
+			has       bool
+			value     float64
+			exclusive bool
+			operators [2]string
+			messages  [2]string
+		}{
+			{restriction.HasMin, restriction.Min, restriction.MinExclusive, [2]string{"<", "<="}, [2]string{"at least", "greater than"}},
+			{restriction.HasMax, restriction.Max, restriction.MaxExclusive, [2]string{">", ">="}, [2]string{"at most", "less than"}},
+		} {
+			if !facet.has {
+				continue
+			}
+			index, value := 0, strconv.FormatFloat(facet.value, 'g', -1, 64)
+			if facet.exclusive {
+				index = 1
+			}
+			content += fmt.Sprintf("\tif float64(v) %s %s {\n\t\treturn fmt.Errorf(\"%s: value %%v must be %s %s\", v)\n\t}\n", facet.operators[index], value, v.Name, facet.messages[index], value)
+		}
+	}
+	if (restriction.MinLength > 0 || restriction.HasMaxLength) && (fieldType == "string" || fieldType == "[]byte") {
+		length := "len(v)"
+		if fieldType == "string" {
+			length = "utf8.RuneCountInString(string(v))"
+			gen.ImportUTF8 = true
+		}
+		switch {
+		case restriction.HasMaxLength && restriction.MinLength == restriction.MaxLength:
+			content += fmt.Sprintf("\tif length := %s; length != %d {\n\t\treturn fmt.Errorf(\"%s: length %%d must be %d\", length)\n\t}\n", length, restriction.MaxLength, v.Name, restriction.MaxLength)
+		default:
+			if restriction.MinLength > 0 {
+				content += fmt.Sprintf("\tif length := %s; length < %d {\n\t\treturn fmt.Errorf(\"%s: length %%d must be at least %d\", length)\n\t}\n", length, restriction.MinLength, v.Name, restriction.MinLength)
+			}
+			if restriction.HasMaxLength {
+				content += fmt.Sprintf("\tif length := %s; length > %d {\n\t\treturn fmt.Errorf(\"%s: length %%d must be at most %d\", length)\n\t}\n", length, restriction.MaxLength, v.Name, restriction.MaxLength)
+			}
+		}
+	}
+	if restriction.Pattern != nil && (fieldType == "string" || goNumericType[fieldType]) {
+		patternName, value := "pattern"+fieldName, "string(v)"
+		if fieldType != "string" {
+			value = "fmt.Sprint(v)"
+		}
+		expr := restriction.Pattern.String()
+		declarations += fmt.Sprintf("\n// %s is the regular expression of the pattern of the %s.\nvar %s = regexp.MustCompile(%s)\n", patternName, fieldName, patternName, genGoRawString(expr))
+		content += fmt.Sprintf("\tif !%s.MatchString(%s) {\n\t\treturn fmt.Errorf(%q, v)\n\t}\n", patternName, value, fmt.Sprintf("%s: value %%q doesn't match the pattern %s", v.Name, strings.TrimSuffix(strings.TrimPrefix(expr, "^(?:"), ")$")))
+		gen.ImportRegexp = true
+	}
+	if len(restriction.Assertions) > 0 {
+		content += fmt.Sprintf(goAssertCheckTemplate, fieldName)
+		declarations += fmt.Sprintf(goAssertHookTemplate, fieldName, fieldName)
+	}
+	gen.ImportFmt = true
+	return declarations + fmt.Sprintf(goFacetTemplate, fieldName, content)
+}
+
+// genGoRawString returns the raw string literal of the value, or the
+// interpreted string literal if the value contains backquotes.
+func genGoRawString(value string) string {
+	if strings.Contains(value, "`") {
+		return strconv.Quote(value)
+	}
+	return "`" + value + "`"
+}
+
+// genGoFacetCheck returns the statements of the Validate method of the
+// complex type checking the value of the field with the type of the simple
+// type restricted by facets.
+func (gen *CodeGenerator) genGoFacetCheck(fieldName, typeName string, plural, pointer bool) string {
+	simpleType := gen.goFacetType(typeName)
+	if simpleType == nil {
+		return ""
+	}
+	check := fmt.Sprintf("\tif err := %s(%%s).Validate(); err != nil {\n\t\treturn err\n\t}\n", genGoFieldName(simpleType.Name))
+	switch {
+	case plural:
+		return fmt.Sprintf("\tfor _, item := range v.%s {\n\t%s\t}\n", fieldName, strings.Replace(fmt.Sprintf(check, "item"), "\n\t", "\n\t\t", -1))
+	case pointer:
+		return fmt.Sprintf("\tif v.%s != nil {\n\t%s\t}\n", fieldName, strings.Replace(fmt.Sprintf(check, "*v."+fieldName), "\n\t", "\n\t\t", -1))
+	}
+	return fmt.Sprintf(check, "v."+fieldName)
+}
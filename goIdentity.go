@@ -0,0 +1,227 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	goIdentitySelector = regexp.MustCompile(`^\s*(?:\./)?((?:[A-Za-z_][\w.-]*:)?[A-Za-z_][\w.-]*)\s*$`)
+	goIdentityField    = regexp.MustCompile(`^\s*(?:\./)?(@?)((?:[A-Za-z_][\w.-]*:)?[A-Za-z_][\w.-]*)\s*$`)
+)
+
+var goIdentityTemplate = `
+// ValidateIdentity checks the identity constraints declared by the elements
+// of the %[1]s type, an error is returned if the values selected by a key or
+// unique constraint are duplicated, a field of a key is absent, or a value
+// selected by a keyref doesn't match the referenced key. Only the
+// constraints selecting the repeated child elements by name and comparing a
+// single attribute or child element of them are checked, the others are
+// listed with their XPath expressions.
+func (v *%[1]s) ValidateIdentity() error {
+	if v == nil {
+		return nil
+	}
+%[2]s	return nil
+}
+`
+
+// goIdentityConstraints returns the names of the types of the element
+// declarations with the identity constraints in the order of the
+// declarations, and the constraints of each type. The constraints of the
+// elements sharing the type are merged.
+func (gen *CodeGenerator) goIdentityConstraints() (names []string, constraints map[string][]IdentityConstraint) {
+	constraints = map[string][]IdentityConstraint{}
+	declared := map[string]bool{}
+	add := func(elements ...Element) {
+		for _, element := range elements {
+			typeName := trimNSPrefix(element.Type)
+			for _, constraint := range element.Constraints {
+				if key := typeName + " " + constraint.Kind + " " + constraint.Name; !declared[key] {
+					declared[key] = true
+					if _, ok := constraints[typeName]; !ok {
+						names = append(names, typeName)
+					}
+					constraints[typeName] = append(constraints[typeName], constraint)
+				}
+			}
+		}
+	}
+	for _, ele := range gen.ProtoTree {
+		switch v := ele.(type) {
+		case *Element:
+			add(*v)
+		case *ComplexType:
+			add(v.Elements...)
+		case *Group:
+			add(v.Elements...)
+		}
+	}
+	return
+}
+
+// goIdentityField returns the field of the items selected by the XPath
+// expression of the field of the identity constraint, which is an attribute
+// or a single child element of the complex type of the items, and whether
+// the field is a pointer tracking the presence of the attribute. The fields
+// with the types can't be compared are ignored.
+func (gen *CodeGenerator) goIdentityField(item *ComplexType, xpath string, derivedTypes map[string][]string) (fieldName, fieldType string, pointer, ok bool) {
+	matches := goIdentityField.FindStringSubmatch(xpath)
+	if matches == nil {
+		return
+	}
+	if matches[1] == "@" {
+		for _, attribute := range item.Attributes {
+			if trimNSPrefix(attribute.Name) == trimNSPrefix(matches[2]) && !attribute.Prohibited {
+				fieldName, _ = genGoAttributeName(attribute.Name)
+				fieldType = gen.genGoAttributeType(attribute)
+				pointer = strings.HasPrefix(fieldType, "*")
+				fieldType = strings.TrimPrefix(fieldType, "*")
+				ok = true
+				break
+			}
+		}
+	} else {
+		for _, element := range item.Elements {
+			if trimNSPrefix(element.Name) == trimNSPrefix(matches[2]) && !element.Wildcard && !element.Plural {
+				fieldName, fieldType = genGoFieldName(element.Name), gen.genGoElementType(element, derivedTypes)
+				polymorphic := gen.isGoPolymorphicElement(element, derivedTypes)
+				_, list := gen.goListItem(element.Type)
+				ok = !polymorphic && !list && !strings.HasPrefix(fieldType, "*")
+				_, pointer = gen.genGoOptionalElementType(item, element, derivedTypes)
+				break
+			}
+		}
+	}
+	ok = ok && !strings.HasPrefix(fieldType, "[]") && fieldType != "interface{}"
+	return
+}
+
+// goIdentitySelection returns the repeated child element of the complex
+// type selected by the selector of the identity constraint, and the field of
+// the items compared by the single field of the constraint. The selection is
+// not ok if the constraint can't be checked.
+func (gen *CodeGenerator) goIdentitySelection(v *ComplexType, constraint IdentityConstraint, derivedTypes map[string][]string) (selected *Element, fieldName, fieldType string, pointer, ok bool) {
+	matches := goIdentitySelector.FindStringSubmatch(constraint.Selector)
+	if matches == nil || len(constraint.Fields) != 1 {
+		return
+	}
+	for i, element := range v.Elements {
+		if trimNSPrefix(element.Name) == trimNSPrefix(matches[1]) && !element.Wildcard {
+			selected = &v.Elements[i]
+			break
+		}
+	}
+	if selected == nil || !selected.Plural || gen.isGoPolymorphicElement(*selected, derivedTypes) {
+		return
+	}
+	for _, ele := range gen.ProtoTree {
+		if item, isComplexType := ele.(*ComplexType); isComplexType && item.Name == trimNSPrefix(selected.Type) {
+			fieldName, fieldType, pointer, ok = gen.goIdentityField(item, constraint.Fields[0], derivedTypes)
+			return
+		}
+	}
+	return
+}
+
+// genGoIdentityCheck returns the statements of the ValidateIdentity method
+// checking the identity constraint of the complex type, the constraint is
+// listed in the comment only if it can't be checked. The values of a keyref
+// are checked against the values of the referenced key or unique constraint
+// of the same type.
+func (gen *CodeGenerator) genGoIdentityCheck(v *ComplexType, constraint IdentityConstraint, constraints []IdentityConstraint, derivedTypes map[string][]string) string {
+	var fields []string
+	for _, field := range constraint.Fields {
+		fields = append(fields, fmt.Sprintf("%q", field))
+	}
+	comment := fmt.Sprintf("\t// %s %s: selector %q, field %s", constraint.Kind, constraint.Name, constraint.Selector, strings.Join(fields, ", "))
+	selected, fieldName, fieldType, pointer, ok := gen.goIdentitySelection(v, constraint, derivedTypes)
+	if !ok {
+		return comment + " (not checked)\n"
+	}
+	path := constraint.Selector + "/" + constraint.Fields[0]
+	value := "item." + fieldName
+	if constraint.Kind == "keyref" {
+		var refer *IdentityConstraint
+		for i := range constraints {
+			if constraints[i].Name == trimNSPrefix(constraint.Refer) && constraints[i].Kind != "keyref" {
+				refer = &constraints[i]
+				break
+			}
+		}
+		if refer == nil {
+			return comment + " (not checked)\n"
+		}
+		referSelected, referFieldName, referFieldType, referPointer, ok := gen.goIdentitySelection(v, *refer, derivedTypes)
+		if !ok || referFieldType != fieldType {
+			return comment + " (not checked)\n"
+		}
+		keys, referValue := "keys"+genGoFieldName(constraint.Name), "item."+referFieldName
+		content := comment + fmt.Sprintf("\n\t%s := map[%s]bool{}\n\tfor _, item := range v.%s {\n", keys, fieldType, genGoFieldName(referSelected.Name))
+		if referPointer {
+			content += fmt.Sprintf("\t\tif %s == nil {\n\t\t\tcontinue\n\t\t}\n", referValue)
+			referValue = "*" + referValue
+		}
+		content += fmt.Sprintf("\t\t%s[%s] = true\n\t}\n\tfor _, item := range v.%s {\n", keys, referValue, genGoFieldName(selected.Name))
+		if pointer {
+			// the keyref doesn't apply to the items without the field.
+			content += fmt.Sprintf("\t\tif %s == nil {\n\t\t\tcontinue\n\t\t}\n", value)
+			value = "*" + value
+		}
+		content += fmt.Sprintf("\t\tif !%[1]s[%[2]s] {\n\t\t\treturn fmt.Errorf(%[3]q, %[2]s)\n\t\t}\n\t}\n", keys, value,
+			fmt.Sprintf("%s: value %%v of %s in keyref %s doesn't match %s %s", v.Name, path, constraint.Name, refer.Kind, refer.Name))
+		gen.ImportFmt = true
+		return content
+	}
+	seen := "seen" + genGoFieldName(constraint.Name)
+	content := comment + fmt.Sprintf("\n\t%s := map[%s]bool{}\n\tfor _, item := range v.%s {\n", seen, fieldType, genGoFieldName(selected.Name))
+	if pointer {
+		check := "continue"
+		if constraint.Kind == "key" {
+			check = fmt.Sprintf("return fmt.Errorf(%q)", fmt.Sprintf("%s: field %s of key %s is absent", v.Name, path, constraint.Name))
+		}
+		content += fmt.Sprintf("\t\tif %s == nil {\n\t\t\t%s\n\t\t}\n", value, check)
+		value = "*" + value
+	}
+	content += fmt.Sprintf("\t\tif %[1]s[%[2]s] {\n\t\t\treturn fmt.Errorf(%[3]q, %[2]s)\n\t\t}\n\t\t%[1]s[%[2]s] = true\n\t}\n", seen, value,
+		fmt.Sprintf("%s: duplicate value %%v of %s in %s %s", v.Name, path, constraint.Kind, constraint.Name))
+	gen.ImportFmt = true
+	return content
+}
+
+// genGoIdentityMethods generates the ValidateIdentity method for the complex
+// types of the element declarations with the key, keyref or unique
+// constraints.
+func (gen *CodeGenerator) genGoIdentityMethods() {
+	names, constraints := gen.goIdentityConstraints()
+	derivedTypes := getDerivedTypes(gen.ProtoTree)
+	for _, name := range names {
+		for _, ele := range gen.ProtoTree {
+			v, ok := ele.(*ComplexType)
+			if !ok || v.Name != name {
+				continue
+			}
+			if _, ok := gen.goListItem(v.Name); ok {
+				break
+			}
+			var content string
+			for _, constraint := range constraints[name] {
+				content += gen.genGoIdentityCheck(v, constraint, constraints[name], derivedTypes)
+			}
+			fieldName := genGoFieldName(v.Name)
+			start := len(gen.Field)
+			gen.Field += fmt.Sprintf(goIdentityTemplate, fieldName, content)
+			gen.Decls = append(gen.Decls, Decl{Name: fieldName + "ValidateIdentity", Source: gen.Field[start:]})
+			break
+		}
+	}
+}
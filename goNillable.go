@@ -0,0 +1,94 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// xsiNilTag is the tag of the field holding the xsi:nil attribute, which
+// declares the element with the nillable declaration as nil.
+const xsiNilTag = "`xml:\"http://www.w3.org/2001/XMLSchema-instance nil,attr,omitempty\"`"
+
+var goNillableTemplate = `
+// %[1]s holds the value of the nillable element.
+// The Nil is set by the xsi:nil attribute of the element, so the nil element
+// is distinguished from the empty one, and the absent element is the nil
+// pointer.
+type %[1]s struct {
+	Nil   bool  ` + xsiNilTag + `
+	Value %[2]s ` + "`xml:\",chardata\"`" + `
+}
+`
+
+// goNillableType returns the name of the type which holds the value of the
+// nillable element with the simple type by given Go type of the value.
+func goNillableType(valueType string) string {
+	return "XSDNillable" + genGoFieldName(valueType)
+}
+
+// isGoNillableComplexType reports whether the complex type with the name is
+// the type of a nillable element, the struct of the type has the Nil field
+// holding the xsi:nil attribute of the element.
+func (gen *CodeGenerator) isGoNillableComplexType(name string) bool {
+	for _, ele := range gen.ProtoTree {
+		var elements []Element
+		switch v := ele.(type) {
+		case *ComplexType:
+			elements = v.Elements
+		case *Group:
+			elements = v.Elements
+		case *Element:
+			elements = []Element{*v}
+		}
+		for _, element := range elements {
+			if element.Nillable && trimNSPrefix(element.Type) == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// genGoNillableTypes generates the declarations of the types which hold the
+// values of the nillable child elements with the simple types, that are
+// referenced in the generated code.
+func (gen *CodeGenerator) genGoNillableTypes() {
+	valueTypes, derivedTypes := map[string]string{}, getDerivedTypes(gen.ProtoTree)
+	for _, ele := range gen.ProtoTree {
+		v, ok := ele.(*ComplexType)
+		if !ok {
+			continue
+		}
+		for _, element := range v.Elements {
+			if !element.Nillable || element.Plural {
+				continue
+			}
+			valueType := gen.genGoType(element.Type)
+			if name := goNillableType(valueType); gen.genGoElementType(element, derivedTypes) == "*"+name {
+				valueTypes[name] = valueType
+			}
+		}
+	}
+	var names []string
+	for name := range valueTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !regexp.MustCompile(`\b` + name + `\b`).MatchString(gen.Field) {
+			continue
+		}
+		start := len(gen.Field)
+		gen.Field += fmt.Sprintf(goNillableTemplate, name, valueTypes[name])
+		gen.Decls = append(gen.Decls, Decl{Name: name, Source: gen.Field[start:]})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// goPackage returns the import path and name of the Go package for the types
+// in the namespace when the PackagePerNamespace option is set. The Package
+// option is the import path of the output directory in this mode, and each
+// namespace is placed in the subpackage named after the last segment of it,
+// or all segments of it if the last segments of the namespaces in the
+// schemas being parsed are the same. The types without namespace are placed
+// in the output directory.
+func (opt *Options) goPackage(ns string) (importPath, name string) {
+	importPath = opt.Package
+	if importPath == "" {
+		importPath = "schema"
+	}
+	if ns == "" {
+		return importPath, path.Base(importPath)
+	}
+	segments := map[string]map[string]bool{}
+	for _, namespaces := range opt.typeNamespaces {
+		for namespace := range namespaces {
+			parts := strings.Split(strings.ToLower(nsToName(namespace)), ".")
+			if segments[parts[len(parts)-1]] == nil {
+				segments[parts[len(parts)-1]] = map[string]bool{}
+			}
+			segments[parts[len(parts)-1]][namespace] = true
+		}
+	}
+	parts := strings.Split(strings.ToLower(nsToName(ns)), ".")
+	if name = parts[len(parts)-1]; len(segments[name]) > 1 {
+		name = strings.Join(parts, "")
+	}
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = "ns" + name
+	}
+	return importPath + "/" + name, name
+}
+
+// layoutGoPackage places the generated code of the schema in the Go package
+// of its target namespace, and records the packages of the types in the
+// other namespaces, so the references to them are qualified by the package
+// names and the packages are imported in the generated code.
+func (opt *Options) layoutGoPackage(generator *CodeGenerator) error {
+	importPath, name := opt.goPackage(opt.TargetNamespace)
+	if opt.TargetNamespace != "" {
+		dir := filepath.Join(opt.OutputDir, name)
+		if opt.output == nil && !opt.DryRun {
+			if err := PrepareOutputDir(dir); err != nil {
+				return err
+			}
+		}
+		generator.File = filepath.Join(dir, filepath.Base(opt.FilePath))
+	}
+	generator.Package = name
+	generator.typePackages = map[string]string{}
+	for typeName, namespaces := range opt.typeNamespaces {
+		for ns := range namespaces {
+			if typePackage, _ := opt.goPackage(ns); ns != opt.TargetNamespace && typePackage != importPath {
+				generator.typePackages[opt.typeName(ns, typeName)] = typePackage
+			}
+		}
+	}
+	return nil
+}
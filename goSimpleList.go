@@ -0,0 +1,52 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import "fmt"
+
+var goSimpleListTemplate = `
+// MarshalText encodes the items of the %[1]s separated by spaces.
+func (l %[1]s) MarshalText() ([]byte, error) {
+	fields := make([]string, 0, len(l))
+	for _, item := range l {
+%[3]s		fields = append(fields, field)
+	}
+	return []byte(strings.Join(fields, " ")), nil
+}
+
+// UnmarshalText decodes the items of the %[1]s separated by white space.
+func (l *%[1]s) UnmarshalText(text []byte) error {
+	items := %[1]s{}
+	for _, field := range strings.Fields(string(text)) {
+		var item %[2]s
+%[4]s		items = append(items, item)
+	}
+	*l = items
+	return nil
+}
+`
+
+// genGoSimpleListMethods generates the methods which marshal and unmarshal
+// the values of the list simple type, the items of the list are separated by
+// white space in the lexical representation of the elements and the
+// attributes. The items of the date and time types are converted by their
+// own text methods, and other items are formatted and scanned by the fmt
+// package.
+func (gen *CodeGenerator) genGoSimpleListMethods(fieldName, itemType string, v *SimpleType) string {
+	gen.ImportStrings = true
+	if isGoTextType(itemType) {
+		return fmt.Sprintf(goSimpleListTemplate, fieldName, itemType,
+			"\t\ttext, err := item.MarshalText()\n\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\t\tfield := string(text)\n",
+			"\t\tif err := item.UnmarshalText([]byte(field)); err != nil {\n\t\t\treturn err\n\t\t}\n")
+	}
+	gen.ImportFmt = true
+	return fmt.Sprintf(goSimpleListTemplate, fieldName, itemType,
+		"\t\tfield := fmt.Sprint(item)\n",
+		fmt.Sprintf("\t\tr := strings.NewReader(field)\n\t\tif _, err := fmt.Fscan(r, &item); err != nil || r.Len() > 0 {\n\t\t\treturn fmt.Errorf(\"%s: invalid item %%q\", field)\n\t\t}\n", v.Name))
+}
@@ -0,0 +1,133 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"strings"
+)
+
+var goSubstitutionTemplate = `
+// %[1]sSubstitution holds the element of the %[2]s substitution group, the
+// concrete type of the value is selected by the name of the element.
+type %[1]sSubstitution struct {
+	XMLName xml.Name
+	Value   %[3]s
+}
+
+// UnmarshalXML decodes the element into the type of the member of the
+// substitution group named by the element, the content of other elements is
+// skipped and the value is left nil.
+func (e *%[1]sSubstitution) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value %[3]s
+	name := start.Name.Local
+	e.XMLName = start.Name
+	switch start.Name.Local {
+%[4]s	default:
+		return d.Skip()
+	}
+	start.Name.Local = name
+	if err := d.DecodeElement(value, &start); err != nil {
+		return err
+	}
+	e.Value = value
+	return nil
+}
+
+// MarshalXML encodes the value of the element named by the XMLName, or by
+// the first member of the substitution group with the type of the value if
+// the XMLName is not set.
+func (e %[1]sSubstitution) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	if e.Value == nil {
+		return nil
+	}
+	start.Name = e.XMLName
+	if start.Name.Local == "" {
+		switch e.Value.(type) {
+%[5]s		}
+	}
+	return enc.EncodeElement(e.Value, start)
+}
+`
+
+// isGoPolymorphicElement reports whether the child element is held by the
+// value of the element type which selects the concrete type of the value,
+// that is the element declared with a type which has derived types, or the
+// head element of a substitution group.
+func (gen *CodeGenerator) isGoPolymorphicElement(element Element, derivedTypes map[string][]string) bool {
+	if head, _ := gen.substitutionGroup(element); head != nil {
+		return true
+	}
+	_, polymorphic := derivedTypes[trimNSPrefix(element.Type)]
+	return polymorphic
+}
+
+// goSubstitutionValueType returns the type of the value held by the element
+// of the substitution group headed by the element, which is the interface of
+// the type of the head element if it has derived types, or the type of the
+// head element if all of the members are declared with it.
+func (gen *CodeGenerator) goSubstitutionValueType(head *Element, members []*Element, derivedTypes map[string][]string) string {
+	typeName := trimNSPrefix(head.Type)
+	if !gen.isComplexType(typeName) {
+		return "interface{}"
+	}
+	if _, ok := derivedTypes[typeName]; ok {
+		return genGoFieldName(typeName) + "Interface"
+	}
+	for _, member := range members {
+		if trimNSPrefix(member.Type) != typeName {
+			return "interface{}"
+		}
+	}
+	return "*" + genGoFieldName(typeName)
+}
+
+// genGoSubstitutionTypes generates the element type for each head element of
+// the substitution groups referenced in the complex types, which selects the
+// type of the value by the name of the element when unmarshaling.
+func (gen *CodeGenerator) genGoSubstitutionTypes() {
+	derivedTypes := getDerivedTypes(gen.ProtoTree)
+	names := map[string]bool{}
+	for _, ele := range gen.ProtoTree {
+		complexType, ok := ele.(*ComplexType)
+		if !ok {
+			continue
+		}
+		for _, element := range complexType.Elements {
+			head, members := gen.substitutionGroup(element)
+			if head == nil || names[head.Name] {
+				continue
+			}
+			names[head.Name] = true
+			var unmarshalCases, marshalCases string
+			marshaled := map[string]bool{}
+			for _, member := range members {
+				fieldType := gen.genGoType(member.Type)
+				if fieldType == "time.Time" {
+					gen.ImportTime = true
+				}
+				if strings.HasPrefix(fieldType, "*") {
+					unmarshalCases += fmt.Sprintf("\tcase %q:\n\t\tvalue, name = &%s{}, %q\n", member.Name, fieldType[1:], trimNSPrefix(member.Type))
+				} else {
+					unmarshalCases += fmt.Sprintf("\tcase %q:\n\t\tvalue = new(%s)\n", member.Name, fieldType)
+					fieldType = "*" + fieldType
+				}
+				if !marshaled[fieldType] {
+					marshaled[fieldType] = true
+					marshalCases += fmt.Sprintf("\t\tcase %s:\n\t\t\tstart.Name.Local = %q\n", fieldType, member.Name)
+				}
+			}
+			fieldName := genGoFieldName(head.Name)
+			start := len(gen.Field)
+			gen.Field += fmt.Sprintf(goSubstitutionTemplate, fieldName, head.Name, gen.goSubstitutionValueType(head, members, derivedTypes), unmarshalCases, marshalCases)
+			gen.Decls = append(gen.Decls, Decl{Name: fieldName + "Substitution", Source: gen.Field[start:]})
+			gen.ImportEncodingXML = true
+		}
+	}
+}
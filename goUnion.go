@@ -0,0 +1,104 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"strings"
+)
+
+var goUnionTemplate = `
+// MarshalText encodes the value of the member type which is set.
+func (u %[1]s) MarshalText() ([]byte, error) {
+	switch {
+%[2]s	}
+	return nil, nil
+}
+
+// UnmarshalText decodes the value as the first member type of the %[1]s
+// which accepts it, the member types are tried in the declared order.
+func (u *%[1]s) UnmarshalText(text []byte) error {
+	*u = %[1]s{}
+%[3]s}
+`
+
+// goUnionMember returns the Go type of the field holding the value of the
+// member type of the union, the enumerations are checked by their own types
+// and other simple types are replaced by their base types.
+func (gen *CodeGenerator) goUnionMember(v *SimpleType, memberName string) string {
+	if gen.isGoEnumType(memberName) {
+		return genGoFieldName(memberName)
+	}
+	memberType := v.MemberTypes[memberName]
+	if memberType == "" { // fix order issue
+		memberType = gen.getBasefromSimpleType(memberName)
+	}
+	return genGoFieldType(memberType)
+}
+
+// isGoTextType reports whether the values of the Go type by given name are
+// decoded and encoded by the text methods of the type.
+func isGoTextType(fieldType string) bool {
+	return fieldType == "time.Time" || strings.HasPrefix(fieldType, "XSD") || strings.HasPrefix(fieldType, "*")
+}
+
+// genGoUnion generates the struct of the union simple type, which has a
+// pointer field for each member type, and the methods which decode the value
+// as the first member type accepting it and encode the member which is set.
+func (gen *CodeGenerator) genGoUnion(fieldName string, v *SimpleType) (content, methods string) {
+	gen.ImportFmt, gen.ImportStrings = true, true
+	var fields, marshal, unmarshal string
+	var trimmed, scanned, exhaustive bool
+	for _, memberName := range v.Members {
+		memberField, memberType := genGoFieldName(memberName), gen.goUnionMember(v, memberName)
+		text := isGoTextType(memberType) || gen.isGoEnumType(memberName)
+		memberType = strings.TrimPrefix(memberType, "*")
+		if memberType == "time.Time" {
+			gen.ImportTime = true
+		}
+		fields += fmt.Sprintf("\t%s\t*%s\n", memberField, memberType)
+		marshal += fmt.Sprintf("\tcase u.%s != nil:\n", memberField)
+		literal := memberType == "string" || memberType == "[]byte"
+		switch {
+		case literal:
+			marshal += fmt.Sprintf("\t\treturn []byte(*u.%s), nil\n", memberField)
+		case text:
+			marshal += fmt.Sprintf("\t\treturn u.%s.MarshalText()\n", memberField)
+		default:
+			marshal += fmt.Sprintf("\t\treturn []byte(fmt.Sprint(*u.%s)), nil\n", memberField)
+		}
+		if exhaustive {
+			continue
+		}
+		variable := strings.ToLower(memberField[:1]) + memberField[1:] + "Value"
+		if literal {
+			// the string accepts any value, so the following member types
+			// are never tried.
+			unmarshal += fmt.Sprintf("\t%s := %s(text)\n\tu.%[3]s = &%[1]s\n\treturn nil\n", variable, memberType, memberField)
+			exhaustive = true
+			continue
+		}
+		if !trimmed {
+			unmarshal, trimmed = unmarshal+"\tvalue := strings.TrimSpace(string(text))\n", true
+		}
+		if text {
+			unmarshal += fmt.Sprintf("\tvar %s %s\n\tif err := %[1]s.UnmarshalText([]byte(value)); err == nil {\n\t\tu.%[3]s = &%[1]s\n\t\treturn nil\n\t}\n", variable, memberType, memberField)
+			continue
+		}
+		reader := "\tr.Reset(value)\n"
+		if !scanned {
+			reader, scanned = "\tr := strings.NewReader(value)\n", true
+		}
+		unmarshal += fmt.Sprintf("%s\tvar %s %s\n\tif _, err := fmt.Fscan(r, &%[2]s); err == nil && r.Len() == 0 {\n\t\tu.%[4]s = &%[2]s\n\t\treturn nil\n\t}\n", reader, variable, memberType, memberField)
+	}
+	if !exhaustive {
+		unmarshal += fmt.Sprintf("\treturn fmt.Errorf(\"%s: the value %%q matches none of the member types\", value)\n", v.Name)
+	}
+	return fmt.Sprintf(" struct {\n%s}\n", fields), fmt.Sprintf(goUnionTemplate, fieldName, marshal, unmarshal)
+}
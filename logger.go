@@ -0,0 +1,206 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+// logf writes the diagnostic message by the Logger of the options, nothing
+// will be written if the Logger is nil.
+func (opt *Options) logf(format string, v ...interface{}) {
+	if opt.Logger != nil {
+		opt.Logger.Printf(format, v...)
+	}
+}
+
+// warnf writes the warning message by the Logger of the options, or the
+// standard logger if the Logger is nil, so the warnings are reported unless
+// they are captured by the caller.
+func (opt *Options) warnf(format string, v ...interface{}) {
+	if opt.Logger != nil {
+		opt.Logger.Printf(format, v...)
+		return
+	}
+	log.Printf(format, v...)
+}
+
+// definitionKind returns the kind and name of the top-level definition in the
+// proto tree.
+func definitionKind(ele interface{}) (kind, name string) {
+	switch v := ele.(type) {
+	case *SimpleType:
+		return "simpleType", v.Name
+	case *ComplexType:
+		return "complexType", v.Name
+	case *Element:
+		return "element", v.Name
+	case *Attribute:
+		return "attribute", v.Name
+	case *Group:
+		return "group", v.Name
+	case *AttributeGroup:
+		return "attributeGroup", v.Name
+	}
+	return "", ""
+}
+
+// logTypes writes the definitions registered in the proto tree and the type
+// references which can't be resolved to the build-in types of the language
+// or the definitions in the schemas used by the document.
+func (opt *Options) logTypes() {
+	if opt.Logger == nil {
+		return
+	}
+	known := map[string]bool{"": true}
+	column := supportLang[opt.Lang]
+	for _, buildInTypes := range BuildInTypes {
+		if column < len(buildInTypes) {
+			known[buildInTypes[column]] = true
+		}
+	}
+	for name, namespaces := range opt.typeNamespaces {
+		for ns := range namespaces {
+			known[name], known[opt.typeName(ns, name)] = true, true
+		}
+	}
+	for _, ele := range opt.ProtoTree {
+		if kind, name := definitionKind(ele); kind != "" {
+			known[name] = true
+			opt.logf("registered %s %s", kind, name)
+		}
+	}
+	unresolved := func(name, ref string) {
+		if ref = trimNSPrefix(ref); !known[ref] {
+			known[ref] = true
+			opt.logf("unresolved reference %s in %s", ref, name)
+		}
+	}
+	for _, ele := range opt.ProtoTree {
+		switch v := ele.(type) {
+		case *SimpleType:
+			unresolved(v.Name, v.Base)
+		case *ComplexType:
+			unresolved(v.Name, v.Base)
+			for _, element := range v.Elements {
+				unresolved(v.Name, element.Type)
+			}
+			for _, attribute := range v.Attributes {
+				unresolved(v.Name, attribute.Type)
+			}
+		case *Element:
+			unresolved(v.Name, v.Type)
+		case *Attribute:
+			unresolved(v.Name, v.Type)
+		case *Group:
+			for _, element := range v.Elements {
+				unresolved(v.Name, element.Type)
+			}
+		case *AttributeGroup:
+			for _, attribute := range v.Attributes {
+				unresolved(v.Name, attribute.Type)
+			}
+		}
+	}
+}
+
+// genCardinality returns the number of occurrences of the element or
+// attribute in the range notation, for example "[0..1]" for the optional
+// element and "[1..*]" for the required repeating element.
+func genCardinality(plural, optional bool) string {
+	min, max := "1", "1"
+	if optional {
+		min = "0"
+	}
+	if plural {
+		max = "*"
+	}
+	return fmt.Sprintf("[%s..%s]", min, max)
+}
+
+// dumpAST writes the resolved proto tree by the Logger of the options, or the
+// standard logger if it is nil, each definition with its base type, and the
+// types and cardinalities of the elements and attributes in it.
+func (opt *Options) dumpAST() {
+	var dump strings.Builder
+	fmt.Fprintf(&dump, "AST of %s", opt.FilePath)
+	if opt.TargetNamespace != "" {
+		fmt.Fprintf(&dump, " in namespace %s", opt.TargetNamespace)
+	}
+	dump.WriteString("\n")
+	dumpElements := func(elements []Element) {
+		for _, element := range elements {
+			fmt.Fprintf(&dump, "\t\telement %s %s %s\n", element.Name, element.Type, genCardinality(element.Plural, element.Optional))
+		}
+	}
+	dumpAttributes := func(attributes []Attribute) {
+		for _, attribute := range attributes {
+			fmt.Fprintf(&dump, "\t\tattribute %s %s %s\n", attribute.Name, attribute.Type, genCardinality(attribute.Plural, attribute.Optional))
+		}
+	}
+	for _, ele := range opt.ProtoTree {
+		kind, name := definitionKind(ele)
+		if kind == "" {
+			continue
+		}
+		fmt.Fprintf(&dump, "\t%s %s", kind, name)
+		switch v := ele.(type) {
+		case *SimpleType:
+			switch {
+			case v.List:
+				fmt.Fprintf(&dump, " list of %s", v.Base)
+			case v.Union:
+				var memberTypes []string
+				for memberType := range v.MemberTypes {
+					memberTypes = append(memberTypes, memberType)
+				}
+				sort.Strings(memberTypes)
+				fmt.Fprintf(&dump, " union of %s", strings.Join(memberTypes, " "))
+			default:
+				fmt.Fprintf(&dump, " base %s", v.Base)
+			}
+			if len(v.Restriction.Enum) > 0 {
+				fmt.Fprintf(&dump, " enum %s", strings.Join(v.Restriction.Enum, "|"))
+			}
+			dump.WriteString("\n")
+		case *ComplexType:
+			if v.Base != "" {
+				derivation := "restriction"
+				if v.Extension {
+					derivation = "extension"
+				}
+				fmt.Fprintf(&dump, " %s of %s", derivation, v.Base)
+			}
+			if v.Mixed {
+				dump.WriteString(" mixed")
+			}
+			dump.WriteString("\n")
+			dumpAttributes(v.Attributes)
+			dumpElements(v.Elements)
+		case *Element:
+			fmt.Fprintf(&dump, " %s %s\n", v.Type, genCardinality(v.Plural, v.Optional))
+		case *Attribute:
+			fmt.Fprintf(&dump, " %s %s\n", v.Type, genCardinality(v.Plural, v.Optional))
+		case *Group:
+			dump.WriteString("\n")
+			dumpElements(v.Elements)
+		case *AttributeGroup:
+			dump.WriteString("\n")
+			dumpAttributes(v.Attributes)
+		}
+	}
+	if opt.Logger != nil {
+		opt.Logger.Print(dump.String())
+		return
+	}
+	log.Print(dump.String())
+}
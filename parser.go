@@ -9,11 +9,17 @@
 package xgen
 
 import (
+	"bytes"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 
 	"golang.org/x/net/html/charset"
 )
@@ -27,9 +33,38 @@ type Options struct {
 	Extract             bool
 	Lang                string
 	Package             string
+	FileLayout          string
+	Indent              string
+	Formatters          map[string]func([]byte) ([]byte, error)
+	TimeLayout          map[string]string
+	TypeScriptEnum      bool
+	JavaAccessors       bool
+	GenRoundTripTests   bool
+	GoGenerics          bool
+	GoValidate          bool
+	GoConstructors      bool
+	GoDocument          bool
+	GoDocumentEncoding  string
+	GoOptionalPointers  bool
+	PackagePerNamespace bool
+	TypeNamePrefix      string
+	TypeNameSuffix      string
+	AnonymousTypeNaming string
+	AnonymousTypeName   func(parent, name string) string
+	IncludeTypes        []string
+	ExcludeTypes        []string
+	UnresolvedAsAny     bool
+	DryRun              bool
+	Manifest            map[string][]string
+	Logger              *log.Logger
+	DumpAST             bool
+	Proxy               string
+	InsecureSkipVerify  bool
+	TargetNamespace     string
 	IncludeMap          map[string]bool
 	LocalNameNSMap      map[string]string
 	NSSchemaLocationMap map[string]string
+	SchemaLocationMap   map[string]string
 	ParseFileList       map[string]bool
 	ParseFileMap        map[string][]interface{}
 	ProtoTree           []interface{}
@@ -40,6 +75,7 @@ type Options struct {
 	InGroup          int
 	InUnion          bool
 	InAttributeGroup bool
+	RedefineStart    int
 
 	SimpleType     *Stack
 	ComplexType    *Stack
@@ -47,6 +83,91 @@ type Options struct {
 	Attribute      *Stack
 	Group          *Stack
 	AttributeGroup *Stack
+
+	// output is the writer of the generated code given to Generate, the
+	// generated code of the imported schemas will not be written if it is
+	// set.
+	output io.Writer
+
+	// skipDepth is the depth of the elements being ignored in the subtree of
+	// an unsupported element, such as the XML schema 1.1 assertions.
+	skipDepth int
+
+	// redefined indicates that the document is loaded by the redefine or
+	// override element, the group and attribute group references are
+	// resolved after the redefinitions have been applied.
+	redefined bool
+
+	// elementFormDefault and attributeFormDefault are the default forms of
+	// the local element and attribute declarations in the schema, which are
+	// unqualified if they are absent.
+	elementFormDefault   string
+	attributeFormDefault string
+
+	// localDecl is the kind of the local element or attribute declaration in
+	// the complex type which is being parsed, the anonymous type defined in
+	// it is named by the path of the declarations.
+	localDecl string
+
+	// anonymous is the anonymous simple type in the local declaration which
+	// is being parsed, and anonymousNames are the names given to the
+	// anonymous types of the document.
+	anonymous      *anonymousType
+	anonymousNames map[string]bool
+
+	// identityConstraint is the key, keyref or unique constraint of the
+	// element declaration which is being parsed.
+	identityConstraint *IdentityConstraint
+
+	// choices are the choice model groups which are being parsed, from the
+	// outermost to the innermost one.
+	choices []*choiceGroup
+
+	// sequences are the sequence model groups which are being parsed, from
+	// the outermost to the innermost one.
+	sequences []*sequenceGroup
+
+	// ancestors are the local names of the elements which are being parsed,
+	// from the root element to the current one, the annotations are added to
+	// the schema components by the names of their parents.
+	ancestors []string
+
+	// docTarget and hintTarget are the documentation and the customization
+	// hints of the schema component which is being annotated, inAppinfo
+	// indicates that the appinfo element of them is being parsed, hint is
+	// the hint in it, and text holds the text of the documentation element
+	// or the hint.
+	docTarget  *string
+	hintTarget *[]Hint
+	inAppinfo  bool
+	hint       *Hint
+	text       *strings.Builder
+
+	// typeNamespaces maps the names of the top-level definitions to the
+	// namespaces defining them, which is collected from the schemas used by
+	// the document before parsing to disambiguate the colliding names.
+	typeNamespaces map[string]map[string]bool
+
+	// declared maps the names of the declarations written into each output
+	// directory to the schema documents generating them, which is shared by
+	// the documents given to ParseFiles, so the declarations in the same
+	// package are only written once.
+	declared map[string]string
+
+	// namespaceFiles maps the paths of the files written by the
+	// FileLayoutPerNamespace layout to the declarations of the schemas in
+	// the namespaces, which is shared by the documents given to ParseFiles
+	// and the schemas used by them, so the schemas in the same namespace
+	// are written into the same file.
+	namespaceFiles map[string]*namespaceFile
+
+	// dependencies are the locations of the schemas imported or included by
+	// the document in the order of the references, and generated records
+	// the documents whose code is generated in the run, which is shared by
+	// the documents given to ParseFiles and the schemas used by them, so
+	// each document is only generated once.
+	dependencies []string
+	generated    map[string]bool
 }
 
 // NewParser creates a new parser options for the Parse. Useful for XML schema
@@ -57,8 +178,30 @@ func NewParser(options *Options) *Options {
 
 // Parse reads XML documents and return proto tree for every element in the
 // documents by given options. If value of the properity extract is false,
-// parse will fetch schema used in <import> or <include> statements.
+// parse will fetch schema used in <import> or <include> statements, and the
+// code of the schemas imported or included by the document is generated as
+// well, which follows the chains of the imports transitively and generates
+// each schema only once. Files with the .wsdl extension are parsed as WSDL
+// documents, all schemas in the types section will be parsed as the same
+// XML schema document. The file path can also be an HTTP URL, the relative
+// schema locations in the remote documents are resolved against the URL of
+// the document. The remote documents are fetched through the proxy given by
+// the Proxy option, or the proxy specified by the HTTP_PROXY, HTTPS_PROXY
+// and NO_PROXY environment variables if it is empty. If the DryRun option
+// is set, the code is generated without writing any files, and the files
+// which would be written are recorded in the Manifest with the names of the
+// types in them.
 func (opt *Options) Parse() (err error) {
+	if opt.DryRun && opt.Manifest == nil {
+		opt.Manifest = map[string][]string{}
+	}
+	if isValidURL(opt.FilePath) {
+		var body []byte
+		if body, err = opt.readSchema(opt.FilePath); err != nil {
+			return
+		}
+		return opt.parse(bytes.NewReader(body))
+	}
 	opt.FileDir = filepath.Dir(opt.FilePath)
 	var fi os.FileInfo
 	fi, err = os.Stat(opt.FilePath)
@@ -73,17 +216,244 @@ func (opt *Options) Parse() (err error) {
 	if err != nil {
 		return
 	}
+	defer xmlFile.Close()
+	return opt.parse(xmlFile)
+}
+
+// Generate parses the XML schema document from the reader and writes the
+// generated code into the writer by given options. The FilePath of the
+// options is used to resolve the locations of the imported and included
+// schemas and name the generated code, which defaults to "schema.xsd". No
+// files will be written, the code of the imported schemas isn't generated
+// and the FileLayout option is ignored.
+func Generate(r io.Reader, w io.Writer, opts Options) error {
+	if opts.FilePath == "" {
+		opts.FilePath = "schema.xsd"
+	}
+	opt := NewParser(&Options{
+		FilePath:            opts.FilePath,
+		FileDir:             filepath.Dir(opts.FilePath),
+		Lang:                opts.Lang,
+		Package:             opts.Package,
+		Indent:              opts.Indent,
+		Formatters:          opts.Formatters,
+		TimeLayout:          opts.TimeLayout,
+		TypeScriptEnum:      opts.TypeScriptEnum,
+		JavaAccessors:       opts.JavaAccessors,
+		GoGenerics:          opts.GoGenerics,
+		GoValidate:          opts.GoValidate,
+		GoConstructors:      opts.GoConstructors,
+		GoDocument:          opts.GoDocument,
+		GoDocumentEncoding:  opts.GoDocumentEncoding,
+		GoOptionalPointers:  opts.GoOptionalPointers,
+		PackagePerNamespace: opts.PackagePerNamespace,
+		TypeNamePrefix:      opts.TypeNamePrefix,
+		TypeNameSuffix:      opts.TypeNameSuffix,
+		AnonymousTypeNaming: opts.AnonymousTypeNaming,
+		AnonymousTypeName:   opts.AnonymousTypeName,
+		IncludeTypes:        opts.IncludeTypes,
+		ExcludeTypes:        opts.ExcludeTypes,
+		UnresolvedAsAny:     opts.UnresolvedAsAny,
+		Logger:              opts.Logger,
+		DumpAST:             opts.DumpAST,
+		Proxy:               opts.Proxy,
+		InsecureSkipVerify:  opts.InsecureSkipVerify,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		SchemaLocationMap:   opts.SchemaLocationMap,
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+		RemoteSchema:        make(map[string][]byte),
+		output:              w,
+	})
+	return opt.parse(r)
+}
+
+// ParseFiles parses and generates code for each of the XML schema documents
+// by given file paths with the options. A new set of runtime data will be
+// created for each file, and processing will continue with the remaining
+// files if a document failed to be processed, so the code of the valid
+// documents is still generated. The errors are returned as ParseErrors,
+// the caller can decide whether to use the partial output. The files which
+// would be written are collected in the Manifest of the options in the
+// dry-run mode. The documents are generated as one set of schemas: the
+// references to the definitions in the other documents of the same target
+// namespace are resolved as if they were included, the colliding names in
+// different namespaces are disambiguated across all documents, and each
+// declaration is written only once into the same package, such as the
+// helper types shared by the documents.
+func ParseFiles(files []string, options *Options) error {
+	var errs ParseErrors
+	if options.DryRun && options.Manifest == nil {
+		options.Manifest = map[string][]string{}
+	}
+	typeNamespaces, collected := map[string]map[string]bool{}, map[string]bool{}
+	targetNamespaces, remoteSchema := map[string]string{}, map[string][]byte{}
+	for _, file := range files {
+		collector := &Options{
+			Proxy:              options.Proxy,
+			InsecureSkipVerify: options.InsecureSkipVerify,
+			SchemaLocationMap:  options.SchemaLocationMap,
+			RemoteSchema:       remoteSchema,
+			typeNamespaces:     map[string]map[string]bool{},
+		}
+		body, err := collector.readSchema(file)
+		if err != nil {
+			continue
+		}
+		// the documents with circular imports are left to be reported when
+		// they are parsed.
+		if collector.collectTypeNamespaces(file, body, "", map[string]bool{}, nil) != nil {
+			continue
+		}
+		for name, namespaces := range collector.typeNamespaces {
+			if typeNamespaces[name] == nil {
+				typeNamespaces[name] = map[string]bool{}
+			}
+			for ns := range namespaces {
+				typeNamespaces[name][ns] = true
+			}
+		}
+		collected[file] = true
+		if ns, ok := schemaTargetNamespace(body); ok {
+			targetNamespaces[file] = ns
+		}
+	}
+	declared, generated, namespaceFiles := map[string]string{}, map[string]bool{}, map[string]*namespaceFile{}
+	for _, file := range files {
+		if !isValidURL(file) {
+			file = filepath.Clean(file)
+		}
+		generated[file] = true
+	}
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           options.OutputDir,
+			Extract:             options.Extract,
+			Lang:                options.Lang,
+			Package:             options.Package,
+			FileLayout:          options.FileLayout,
+			Indent:              options.Indent,
+			Formatters:          options.Formatters,
+			TimeLayout:          options.TimeLayout,
+			TypeScriptEnum:      options.TypeScriptEnum,
+			JavaAccessors:       options.JavaAccessors,
+			GenRoundTripTests:   options.GenRoundTripTests,
+			GoGenerics:          options.GoGenerics,
+			GoValidate:          options.GoValidate,
+			GoConstructors:      options.GoConstructors,
+			GoDocument:          options.GoDocument,
+			GoDocumentEncoding:  options.GoDocumentEncoding,
+			GoOptionalPointers:  options.GoOptionalPointers,
+			PackagePerNamespace: options.PackagePerNamespace,
+			TypeNamePrefix:      options.TypeNamePrefix,
+			TypeNameSuffix:      options.TypeNameSuffix,
+			AnonymousTypeNaming: options.AnonymousTypeNaming,
+			AnonymousTypeName:   options.AnonymousTypeName,
+			IncludeTypes:        options.IncludeTypes,
+			ExcludeTypes:        options.ExcludeTypes,
+			UnresolvedAsAny:     options.UnresolvedAsAny,
+			DryRun:              options.DryRun,
+			Manifest:            options.Manifest,
+			Logger:              options.Logger,
+			DumpAST:             options.DumpAST,
+			Proxy:               options.Proxy,
+			InsecureSkipVerify:  options.InsecureSkipVerify,
+			IncludeMap:          siblingSchemas(file, targetNamespaces),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			SchemaLocationMap:   options.SchemaLocationMap,
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+			RemoteSchema:        remoteSchema,
+			declared:            declared,
+			generated:           generated,
+			namespaceFiles:      namespaceFiles,
+		})
+		if collected[file] {
+			parser.typeNamespaces = typeNamespaces
+		}
+		if err := parser.Parse(); err != nil {
+			errs = append(errs, newParseError(file, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// siblingSchemas returns the locations of the other documents in the same
+// target namespace as the document by given file path, which are both local
+// files or remote documents.
+func siblingSchemas(file string, targetNamespaces map[string]string) map[string]bool {
+	siblings := map[string]bool{}
+	ns, ok := targetNamespaces[file]
+	if !ok {
+		return siblings
+	}
+	for sibling, siblingNS := range targetNamespaces {
+		if sibling == file || siblingNS != ns || isValidURL(sibling) != isValidURL(file) {
+			continue
+		}
+		siblings[sibling] = true
+	}
+	return siblings
+}
+
+// parse reads the XML schema document from the reader and generates code
+// for it unless the Extract option is set.
+func (opt *Options) parse(r io.Reader) (err error) {
+	switch opt.AnonymousTypeNaming {
+	case "", AnonymousTypeNamingPath, AnonymousTypeNamingHash:
+	default:
+		return newParseError(opt.FilePath, fmt.Errorf("unsupported anonymous type naming %s", opt.AnonymousTypeNaming))
+	}
 	if !opt.Extract {
 		opt.ParseFileList[opt.FilePath] = true
 		opt.ParseFileMap[opt.FilePath] = opt.ProtoTree
 	}
 	opt.ProtoTree = make([]interface{}, 0)
+	if opt.namespaceFiles == nil {
+		opt.namespaceFiles = map[string]*namespaceFile{}
+	}
+	opt.logf("parsing %s", opt.FilePath)
+	if opt.typeNamespaces == nil {
+		var body []byte
+		if body, err = ioutil.ReadAll(r); err != nil {
+			return newParseError(opt.FilePath, err)
+		}
+		opt.typeNamespaces = map[string]map[string]bool{}
+		if err = opt.collectTypeNamespaces(opt.FilePath, body, "", map[string]bool{}, nil); err != nil {
+			return newParseError(opt.FilePath, err)
+		}
+		r = bytes.NewReader(body)
+	}
 
 	opt.InElement = ""
 	opt.CurrentEle = ""
 	opt.InGroup = 0
 	opt.InUnion = false
 	opt.InAttributeGroup = false
+	opt.RedefineStart = 0
+	opt.skipDepth = 0
+	opt.localDecl = ""
+	opt.anonymous = nil
+	opt.anonymousNames = nil
+	opt.identityConstraint = nil
+	opt.choices = nil
+	opt.sequences = nil
+	opt.ancestors = nil
+	opt.dependencies = nil
+	opt.docTarget = nil
+	opt.hintTarget = nil
+	opt.inAppinfo = false
+	opt.hint = nil
+	opt.text = nil
 
 	opt.SimpleType = NewStack()
 	opt.ComplexType = NewStack()
@@ -92,57 +462,299 @@ func (opt *Options) Parse() (err error) {
 	opt.Group = NewStack()
 	opt.AttributeGroup = NewStack()
 
-	decoder := xml.NewDecoder(xmlFile)
+	// Only the schemas embedded in the types section of the WSDL documents
+	// are parsed, other WSDL content such as bindings will be ignored.
+	wsdl := filepath.Ext(opt.FilePath) == ".wsdl"
+	decoder := xml.NewDecoder(r)
 	decoder.CharsetReader = charset.NewReaderLabel
+	// A malformed document may leave the parser in an unexpected state, for
+	// example a restriction outside of any type definition, so the panic
+	// will be reported as an error at the position where it occurred.
+	defer func() {
+		if r := recover(); r != nil {
+			err = &ParseError{FilePath: opt.FilePath, Offset: decoder.InputOffset(), Err: fmt.Errorf("malformed XML schema: %v", r)}
+		}
+	}()
+	var root bool
 	for {
-		token, _ := decoder.Token()
-		if token == nil {
-			break
+		var token xml.Token
+		if token, err = decoder.Token(); err != nil {
+			if err == io.EOF {
+				err = nil
+				break
+			}
+			return newParseError(opt.FilePath, err)
 		}
 
 		switch element := token.(type) {
 		case xml.StartElement:
+			if !root {
+				if !isSchemaRoot(element, wsdl) {
+					return &ParseError{FilePath: opt.FilePath, Offset: decoder.InputOffset(), Err: fmt.Errorf("unexpected root element %s, expected an XML schema document", element.Name.Local)}
+				}
+				root = true
+			}
+			if opt.skipDepth > 0 {
+				opt.skipDepth++
+				opt.startHint(element)
+				continue
+			}
+			if wsdl && element.Name.Space != xsdNamespace {
+				continue
+			}
+			if isExcludedByVersion(element) {
+				opt.skipDepth++
+				continue
+			}
 
 			opt.InElement = element.Name.Local
+			opt.ancestors = append(opt.ancestors, element.Name.Local)
 			funcName := fmt.Sprintf("On%s", MakeFirstUpperCase(opt.InElement))
 			if err = callFuncByName(opt, funcName, []reflect.Value{reflect.ValueOf(element), reflect.ValueOf(opt.ProtoTree)}); err != nil {
 				return
 			}
 
 		case xml.EndElement:
+			if opt.skipDepth > 0 {
+				switch opt.skipDepth--; opt.skipDepth {
+				case 0:
+					opt.endDocumentation()
+					opt.inAppinfo = false
+				case 1:
+					opt.endHint()
+				}
+				continue
+			}
+			if wsdl && element.Name.Space != xsdNamespace {
+				continue
+			}
+			opt.endAncestor(element.Name.Local)
 			funcName := fmt.Sprintf("End%s", MakeFirstUpperCase(element.Name.Local))
 			if err = callFuncByName(opt, funcName, []reflect.Value{reflect.ValueOf(element), reflect.ValueOf(opt.ProtoTree)}); err != nil {
 				return
 			}
+		case xml.CharData:
+			if opt.text != nil {
+				opt.text.Write(element)
+			}
 		default:
 		}
 
 	}
-	defer xmlFile.Close()
+	if !root {
+		return newParseError(opt.FilePath, errors.New("no root element found, expected an XML schema document"))
+	}
+	if !opt.redefined {
+		opt.resolveGroups()
+		opt.resolveAttributeGroups()
+		opt.resolveRestrictions()
+	}
+	opt.logTypes()
 
 	if !opt.Extract {
 		opt.ParseFileList[opt.FilePath] = true
 		opt.ParseFileMap[opt.FilePath] = opt.ProtoTree
+		if opt.DumpAST {
+			opt.dumpAST()
+		}
+		opt.logf("generating %s code for %s", opt.Lang, opt.FilePath)
 		generator := &CodeGenerator{
-			Lang:      opt.Lang,
-			Package:   opt.Package,
-			File:      filepath.Join(opt.OutputDir, filepath.Base(opt.FilePath)),
-			ProtoTree: opt.ProtoTree,
-			StructAST: map[string]string{},
+			Lang:               opt.Lang,
+			Package:            opt.Package,
+			File:               filepath.Join(opt.OutputDir, filepath.Base(opt.FilePath)),
+			FileLayout:         opt.FileLayout,
+			Indent:             opt.Indent,
+			Formatters:         opt.Formatters,
+			TimeLayout:         opt.TimeLayout,
+			TypeScriptEnum:     opt.TypeScriptEnum,
+			JavaAccessors:      opt.JavaAccessors,
+			RoundTripTests:     opt.GenRoundTripTests,
+			GoGenerics:         opt.GoGenerics,
+			GoValidate:         opt.GoValidate,
+			GoConstructors:     opt.GoConstructors,
+			GoDocument:         opt.GoDocument,
+			GoDocumentEncoding: opt.GoDocumentEncoding,
+			GoOptionalPointers: opt.GoOptionalPointers,
+			DryRun:             opt.DryRun,
+			Manifest:           opt.Manifest,
+			TypeNamePrefix:     opt.TypeNamePrefix,
+			TypeNameSuffix:     opt.TypeNameSuffix,
+			Output:             opt.output,
+			Namespace:          opt.TargetNamespace,
+			ProtoTree:          opt.ProtoTree,
+			StructAST:          map[string]string{},
+			declared:           opt.declared,
+			namespaceFiles:     opt.namespaceFiles,
+		}
+		if opt.UnresolvedAsAny {
+			generator.ProtoTree = opt.anyUnresolvedTypes(generator.ProtoTree)
+		}
+		generator.filterTypes(opt.IncludeTypes, opt.ExcludeTypes)
+		if opt.PackagePerNamespace && opt.Lang == "Go" {
+			if err = opt.layoutGoPackage(generator); err != nil {
+				return
+			}
 		}
-		funcName := fmt.Sprintf("Gen%s", MakeFirstUpperCase(opt.Lang))
+		generator.renameTypes(func(name string) string {
+			return opt.typeName(opt.TargetNamespace, name)
+		})
+		if registered, ok := registeredGenerator(opt.Lang); ok {
+			if err = generator.genRegistered(registered); err != nil {
+				return
+			}
+			opt.parseDependencies()
+			return
+		}
+		// the plus signs in the language name, such as C++, are spelled as
+		// P and the number signs, such as C#, are spelled as Sharp in the
+		// name of the code generator function.
+		funcName := fmt.Sprintf("Gen%s", MakeFirstUpperCase(strings.NewReplacer("+", "P", "#", "Sharp").Replace(opt.Lang)))
 		if err = callFuncByName(generator, funcName, []reflect.Value{}); err != nil {
 			return
 		}
+		opt.parseDependencies()
 	}
 	return
 }
 
+// parseDependencies generates the code of the schemas imported or included
+// by the document which haven't been generated in the run, which generate
+// the schemas used by them in turn, so the types of all namespaces used by
+// the document are generated. Nothing is generated for the code written by
+// Generate, and the schemas which can't be loaded are skipped.
+func (opt *Options) parseDependencies() {
+	if opt.output != nil {
+		return
+	}
+	if opt.generated == nil {
+		opt.generated = map[string]bool{}
+	}
+	opt.generated[opt.FilePath] = true
+	for _, location := range opt.dependencies {
+		if opt.generated[location] || opt.ParseFileList[location] {
+			continue
+		}
+		opt.generated[location] = true
+		if err := opt.dependencyParser(location).Parse(); err != nil {
+			opt.logf("skip %s: %v", location, err)
+		}
+	}
+}
+
+// dependencyParser creates the parser options for generating the code of the
+// schema used by the document at the location, which is generated with the
+// same options as the document. The namespace declarations, imports and
+// includes are collected from the schema itself, and the parsed and
+// generated documents are shared with the document.
+func (opt *Options) dependencyParser(location string) *Options {
+	return NewParser(&Options{
+		FilePath:            location,
+		OutputDir:           opt.OutputDir,
+		Lang:                opt.Lang,
+		Package:             opt.Package,
+		FileLayout:          opt.FileLayout,
+		Indent:              opt.Indent,
+		Formatters:          opt.Formatters,
+		TimeLayout:          opt.TimeLayout,
+		TypeScriptEnum:      opt.TypeScriptEnum,
+		JavaAccessors:       opt.JavaAccessors,
+		GenRoundTripTests:   opt.GenRoundTripTests,
+		GoGenerics:          opt.GoGenerics,
+		GoValidate:          opt.GoValidate,
+		GoConstructors:      opt.GoConstructors,
+		GoDocument:          opt.GoDocument,
+		GoDocumentEncoding:  opt.GoDocumentEncoding,
+		GoOptionalPointers:  opt.GoOptionalPointers,
+		PackagePerNamespace: opt.PackagePerNamespace,
+		TypeNamePrefix:      opt.TypeNamePrefix,
+		TypeNameSuffix:      opt.TypeNameSuffix,
+		AnonymousTypeNaming: opt.AnonymousTypeNaming,
+		AnonymousTypeName:   opt.AnonymousTypeName,
+		IncludeTypes:        opt.IncludeTypes,
+		ExcludeTypes:        opt.ExcludeTypes,
+		UnresolvedAsAny:     opt.UnresolvedAsAny,
+		DryRun:              opt.DryRun,
+		Manifest:            opt.Manifest,
+		Logger:              opt.Logger,
+		DumpAST:             opt.DumpAST,
+		Proxy:               opt.Proxy,
+		InsecureSkipVerify:  opt.InsecureSkipVerify,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		SchemaLocationMap:   opt.SchemaLocationMap,
+		ParseFileList:       opt.ParseFileList,
+		ParseFileMap:        opt.ParseFileMap,
+		ProtoTree:           make([]interface{}, 0),
+		RemoteSchema:        opt.RemoteSchema,
+		typeNamespaces:      opt.typeNamespaces,
+		declared:            opt.declared,
+		generated:           opt.generated,
+		namespaceFiles:      opt.namespaceFiles,
+	})
+}
+
 // GetValueType convert XSD schema value type to the build-in type for the
-// given value and proto tree.
+// given value and proto tree. The namespace of the value is resolved by the
+// namespace declarations of the schema, an unprefixed value is in the
+// default namespace. Values in the XML schema namespace, or without a
+// declared namespace, are converted to the build-in types. The names of the
+// definitions which exist in more than one namespace are disambiguated by
+// the namespaces.
 func (opt *Options) GetValueType(value string, XSDSchema []interface{}) (valueType string, err error) {
-	if buildType, ok := getBuildInTypeByLang(trimNSPrefix(value), opt.Lang); ok {
-		valueType = buildType
+	if valueType, err = opt.getValueType(value, XSDSchema); valueType == trimNSPrefix(value) {
+		valueType = opt.typeName(opt.parseNS(value), valueType)
+	}
+	return
+}
+
+// keepsSimpleTypeRef reports whether the references to the simple type with
+// the name are kept instead of being replaced by its base type in the
+// language of the options.
+func (opt *Options) keepsSimpleTypeRef(name string, XSDSchema []interface{}) bool {
+	// Go, TypeScript, Java, Rust, GraphQL, OpenAPI, Swift, Protocol Buffers,
+	// C++, Python, C#, Kotlin, Scala and PHP declare the enumerations as enum
+	// types, so the references to them are kept instead of being replaced by
+	// their base types.
+	if (opt.Lang == "Go" || opt.Lang == "TypeScript" || opt.Lang == "Java" || opt.Lang == "Rust" || opt.Lang == "GraphQL" || opt.Lang == "OpenAPI" || opt.Lang == "Swift" || opt.Lang == "Protobuf" || opt.Lang == "C++" || opt.Lang == "Python" || opt.Lang == "C#" || opt.Lang == "Kotlin" || opt.Lang == "Scala" || opt.Lang == "PHP") && isEnumSimpleType(name, XSDSchema) {
+		return true
+	}
+	// JSON Schema declares all of the simple types as the definitions with
+	// their facets.
+	if opt.Lang == "JSONSchema" && isSimpleType(name, XSDSchema) {
+		return true
+	}
+	// Go validates the values of the simple types restricted by facets by
+	// their Validate methods.
+	if opt.Lang == "Go" && opt.GoValidate && isFacetSimpleType(name, XSDSchema) {
+		return true
+	}
+	// Go normalizes the white space of the values of the simple types by
+	// their named types on decoding.
+	return opt.Lang == "Go" && isNormalizedSimpleType(name, XSDSchema)
+}
+
+func (opt *Options) getValueType(value string, XSDSchema []interface{}) (valueType string, err error) {
+	// the C++ types in the standard library are converted already, which
+	// can't be parsed as the QName since the scope resolution operator is
+	// used in their names.
+	if opt.Lang == "C++" && strings.Contains(value, "::") {
+		valueType = value
+		return
+	}
+	if ns := opt.parseNS(value); ns == xsdNamespace || ns == "" {
+		if buildType, ok := getBuildInTypeByLang(trimNSPrefix(value), opt.Lang); ok {
+			valueType = buildType
+			return
+		}
+	} else if ns == xmlNamespace {
+		if buildType, ok := getBuildInTypeByLang("xml:"+trimNSPrefix(value), opt.Lang); ok {
+			valueType = buildType
+			return
+		}
+	}
+	if opt.keepsSimpleTypeRef(trimNSPrefix(value), XSDSchema) {
+		valueType = trimNSPrefix(value)
 		return
 	}
 	valueType = getBasefromSimpleType(trimNSPrefix(value), XSDSchema)
@@ -152,31 +764,40 @@ func (opt *Options) GetValueType(value string, XSDSchema []interface{}) (valueTy
 	if opt.Extract {
 		return
 	}
-	schemaLocation := opt.NSSchemaLocationMap[opt.parseNS(value)]
-	if isValidURL(schemaLocation) {
-		return
-	}
-	xsdFile := filepath.Join(opt.FileDir, schemaLocation)
-	var fi os.FileInfo
-	fi, err = os.Stat(xsdFile)
-	if err != nil {
-		return
+	xsdFile := opt.NSSchemaLocationMap[opt.parseNS(value)]
+	included := xsdFile == ""
+	if !included && !isValidURL(xsdFile) {
+		var fi os.FileInfo
+		fi, err = os.Stat(xsdFile)
+		if err != nil {
+			return
+		}
+		included = fi.IsDir()
 	}
-	if fi.IsDir() {
+	if included {
 		// extract type of value from include schema.
 		valueType = ""
 		for include := range opt.IncludeMap {
 			parser := NewParser(&Options{
-				FilePath:            filepath.Join(opt.FileDir, include),
+				FilePath:            include,
 				OutputDir:           opt.OutputDir,
 				Extract:             true,
 				Lang:                opt.Lang,
+				Logger:              opt.Logger,
+				DumpAST:             opt.DumpAST,
+				Proxy:               opt.Proxy,
+				InsecureSkipVerify:  opt.InsecureSkipVerify,
+				Package:             opt.Package,
+				PackagePerNamespace: opt.PackagePerNamespace,
 				IncludeMap:          opt.IncludeMap,
 				LocalNameNSMap:      opt.LocalNameNSMap,
 				NSSchemaLocationMap: opt.NSSchemaLocationMap,
+				SchemaLocationMap:   opt.SchemaLocationMap,
 				ParseFileList:       opt.ParseFileList,
 				ParseFileMap:        opt.ParseFileMap,
 				ProtoTree:           make([]interface{}, 0),
+				RemoteSchema:        opt.RemoteSchema,
+				typeNamespaces:      opt.typeNamespaces,
 			})
 			if parser.Parse() != nil {
 				return
@@ -193,18 +814,8 @@ func (opt *Options) GetValueType(value string, XSDSchema []interface{}) (valueTy
 
 	depXSDSchema, ok := opt.ParseFileMap[xsdFile]
 	if !ok {
-		parser := NewParser(&Options{
-			FilePath:            xsdFile,
-			OutputDir:           opt.OutputDir,
-			Extract:             false,
-			Lang:                opt.Lang,
-			IncludeMap:          opt.IncludeMap,
-			LocalNameNSMap:      opt.LocalNameNSMap,
-			NSSchemaLocationMap: opt.NSSchemaLocationMap,
-			ParseFileList:       opt.ParseFileList,
-			ParseFileMap:        opt.ParseFileMap,
-			ProtoTree:           make([]interface{}, 0),
-		})
+		parser := opt.dependencyParser(xsdFile)
+		parser.Extract = opt.output != nil
 		if parser.Parse() != nil {
 			return
 		}
@@ -219,12 +830,21 @@ func (opt *Options) GetValueType(value string, XSDSchema []interface{}) (valueTy
 		OutputDir:           opt.OutputDir,
 		Extract:             true,
 		Lang:                opt.Lang,
+		Logger:              opt.Logger,
+		DumpAST:             opt.DumpAST,
+		Proxy:               opt.Proxy,
+		InsecureSkipVerify:  opt.InsecureSkipVerify,
+		Package:             opt.Package,
+		PackagePerNamespace: opt.PackagePerNamespace,
 		IncludeMap:          opt.IncludeMap,
 		LocalNameNSMap:      opt.LocalNameNSMap,
 		NSSchemaLocationMap: opt.NSSchemaLocationMap,
+		SchemaLocationMap:   opt.SchemaLocationMap,
 		ParseFileList:       opt.ParseFileList,
 		ParseFileMap:        opt.ParseFileMap,
 		ProtoTree:           make([]interface{}, 0),
+		RemoteSchema:        opt.RemoteSchema,
+		typeNamespaces:      opt.typeNamespaces,
 	})
 	if parser.Parse() != nil {
 		return
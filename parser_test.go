@@ -9,36 +9,84 @@
 package xgen
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
 var (
-	testDir     = "data"
-	cSrcDir     = filepath.Join(testDir, "c")
-	cCodeDir    = filepath.Join(cSrcDir, "output")
-	goSrcDir    = filepath.Join(testDir, "go")
-	goCodeDir   = filepath.Join(goSrcDir, "output")
-	tsSrcDir    = filepath.Join(testDir, "ts")
-	tsCodeDir   = filepath.Join(tsSrcDir, "output")
-	javaSrcDir  = filepath.Join(testDir, "java")
-	javaCodeDir = filepath.Join(javaSrcDir, "output")
-	rsSrcDir    = filepath.Join(testDir, "rs")
-	rsCodeDir   = filepath.Join(rsSrcDir, "output")
-	xsdSrcDir   = filepath.Join(testDir, "xsd")
+	testDir        = "data"
+	cSrcDir        = filepath.Join(testDir, "c")
+	cCodeDir       = filepath.Join(cSrcDir, "output")
+	goSrcDir       = filepath.Join(testDir, "go")
+	goCodeDir      = filepath.Join(goSrcDir, "output")
+	tsSrcDir       = filepath.Join(testDir, "ts")
+	tsCodeDir      = filepath.Join(tsSrcDir, "output")
+	javaSrcDir     = filepath.Join(testDir, "java")
+	javaCodeDir    = filepath.Join(javaSrcDir, "output")
+	rsSrcDir       = filepath.Join(testDir, "rs")
+	rsCodeDir      = filepath.Join(rsSrcDir, "output")
+	dartSrcDir     = filepath.Join(testDir, "dart")
+	dartCodeDir    = filepath.Join(dartSrcDir, "output")
+	scalaSrcDir    = filepath.Join(testDir, "scala")
+	scalaCodeDir   = filepath.Join(scalaSrcDir, "output")
+	graphqlSrcDir  = filepath.Join(testDir, "graphql")
+	graphqlCodeDir = filepath.Join(graphqlSrcDir, "output")
+	openapiSrcDir  = filepath.Join(testDir, "openapi")
+	openapiCodeDir = filepath.Join(openapiSrcDir, "output")
+	kotlinSrcDir   = filepath.Join(testDir, "kotlin")
+	kotlinCodeDir  = filepath.Join(kotlinSrcDir, "output")
+	swiftSrcDir    = filepath.Join(testDir, "swift")
+	swiftCodeDir   = filepath.Join(swiftSrcDir, "output")
+	protoSrcDir    = filepath.Join(testDir, "proto")
+	protoCodeDir   = filepath.Join(protoSrcDir, "output")
+	rubySrcDir     = filepath.Join(testDir, "ruby")
+	rubyCodeDir    = filepath.Join(rubySrcDir, "output")
+	cppSrcDir      = filepath.Join(testDir, "cpp")
+	cppCodeDir     = filepath.Join(cppSrcDir, "output")
+	jsonSrcDir     = filepath.Join(testDir, "jsonschema")
+	jsonCodeDir    = filepath.Join(jsonSrcDir, "output")
+	pySrcDir       = filepath.Join(testDir, "python")
+	pyCodeDir      = filepath.Join(pySrcDir, "output")
+	csSrcDir       = filepath.Join(testDir, "cs")
+	csCodeDir      = filepath.Join(csSrcDir, "output")
+	phpSrcDir      = filepath.Join(testDir, "php")
+	phpCodeDir     = filepath.Join(phpSrcDir, "output")
+	xsdSrcDir      = filepath.Join(testDir, "xsd")
 )
 
+// goFixtureOptions sets the Go code generator options used for the fixtures
+// whose generated code is exercised by the tests in test/go.
+var goFixtureOptions = map[string]func(opt *Options){
+	"assert.xsd":   func(opt *Options) { opt.GoValidate = true },
+	"bank.xsd":     func(opt *Options) { opt.GoValidate = true },
+	"choice.xsd":   func(opt *Options) { opt.GoValidate, opt.GoConstructors = true, true },
+	"facets.xsd":   func(opt *Options) { opt.GoValidate = true },
+	"nillable.xsd": func(opt *Options) { opt.GoValidate = true },
+	"order.xsd":    func(opt *Options) { opt.GoDocument, opt.GoDocumentEncoding = true, "ISO-8859-1" },
+	"setting.xsd":  func(opt *Options) { opt.GoConstructors = true },
+}
+
 func TestParseGo(t *testing.T) {
 	err := PrepareOutputDir(goCodeDir)
 	assert.NoError(t, err)
-	files, err := GetFileList(xsdSrcDir)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
 	assert.NoError(t, err)
 	for _, file := range files {
-		parser := NewParser(&Options{
+		opt := &Options{
 			FilePath:            file,
 			OutputDir:           goCodeDir,
 			Lang:                "Go",
@@ -48,10 +96,14 @@ func TestParseGo(t *testing.T) {
 			ParseFileList:       make(map[string]bool),
 			ParseFileMap:        make(map[string][]interface{}),
 			ProtoTree:           make([]interface{}, 0),
-		})
+		}
+		if setOptions, ok := goFixtureOptions[filepath.Base(file)]; ok {
+			setOptions(opt)
+		}
+		parser := NewParser(opt)
 		err = parser.Parse()
 		assert.NoError(t, err, file)
-		if filepath.Ext(file) == ".xsd" {
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
 			srcCode := filepath.Join(goSrcDir, filepath.Base(file)+".go")
 			genCode := filepath.Join(goCodeDir, filepath.Base(file)+".go")
 
@@ -69,7 +121,7 @@ func TestParseGo(t *testing.T) {
 func TestParseTypeScript(t *testing.T) {
 	err := PrepareOutputDir(tsCodeDir)
 	assert.NoError(t, err)
-	files, err := GetFileList(xsdSrcDir)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
 	assert.NoError(t, err)
 	for _, file := range files {
 		parser := NewParser(&Options{
@@ -85,7 +137,7 @@ func TestParseTypeScript(t *testing.T) {
 		})
 		err = parser.Parse()
 		assert.NoError(t, err)
-		if filepath.Ext(file) == ".xsd" {
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
 			srcCode := filepath.Join(tsSrcDir, filepath.Base(file)+".ts")
 			genCode := filepath.Join(tsCodeDir, filepath.Base(file)+".ts")
 
@@ -100,10 +152,58 @@ func TestParseTypeScript(t *testing.T) {
 	}
 }
 
+func TestParseTypeScriptEnum(t *testing.T) {
+	srcDir, codeDir := filepath.Join(tsSrcDir, "enum"), filepath.Join(tsCodeDir, "enum")
+	err := PrepareOutputDir(codeDir)
+	assert.NoError(t, err)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "shipOrder.xsd"),
+		OutputDir:           codeDir,
+		Lang:                "TypeScript",
+		TypeScriptEnum:      true,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	srcCode, err := ioutil.ReadFile(filepath.Join(srcDir, "shipOrder.xsd.ts"))
+	assert.NoError(t, err)
+	genCode, err := ioutil.ReadFile(filepath.Join(codeDir, "shipOrder.xsd.ts"))
+	assert.NoError(t, err)
+	assert.Equal(t, string(srcCode), string(genCode))
+}
+
+func TestParseJavaAccessors(t *testing.T) {
+	srcDir, codeDir := filepath.Join(javaSrcDir, "accessors"), filepath.Join(javaCodeDir, "accessors")
+	err := PrepareOutputDir(codeDir)
+	assert.NoError(t, err)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "subscription.xsd"),
+		OutputDir:           codeDir,
+		Lang:                "Java",
+		JavaAccessors:       true,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	srcCode, err := ioutil.ReadFile(filepath.Join(srcDir, "subscription.xsd.java"))
+	assert.NoError(t, err)
+	genCode, err := ioutil.ReadFile(filepath.Join(codeDir, "subscription.xsd.java"))
+	assert.NoError(t, err)
+	assert.Equal(t, string(srcCode), string(genCode))
+}
+
 func TestParseC(t *testing.T) {
 	err := PrepareOutputDir(cCodeDir)
 	assert.NoError(t, err)
-	files, err := GetFileList(xsdSrcDir)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
 	assert.NoError(t, err)
 	for _, file := range files {
 		parser := NewParser(&Options{
@@ -119,7 +219,7 @@ func TestParseC(t *testing.T) {
 		})
 		err = parser.Parse()
 		assert.NoError(t, err)
-		if filepath.Ext(file) == ".xsd" {
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
 			srcCode := filepath.Join(cSrcDir, filepath.Base(file)+".h")
 			genCode := filepath.Join(cCodeDir, filepath.Base(file)+".h")
 
@@ -137,7 +237,7 @@ func TestParseC(t *testing.T) {
 func TestParseJava(t *testing.T) {
 	err := PrepareOutputDir(javaCodeDir)
 	assert.NoError(t, err)
-	files, err := GetFileList(xsdSrcDir)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
 	assert.NoError(t, err)
 	for _, file := range files {
 		parser := NewParser(&Options{
@@ -153,13 +253,25 @@ func TestParseJava(t *testing.T) {
 		})
 		err = parser.Parse()
 		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(javaSrcDir, filepath.Base(file)+".java")
+			genCode := filepath.Join(javaCodeDir, filepath.Base(file)+".java")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
 	}
 }
 
 func TestParseRust(t *testing.T) {
 	err := PrepareOutputDir(rsCodeDir)
 	assert.NoError(t, err)
-	files, err := GetFileList(xsdSrcDir)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
 	assert.NoError(t, err)
 	for _, file := range files {
 		parser := NewParser(&Options{
@@ -175,5 +287,3032 @@ func TestParseRust(t *testing.T) {
 		})
 		err = parser.Parse()
 		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(rsSrcDir, filepath.Base(file)+".rs")
+			genCode := filepath.Join(rsCodeDir, filepath.Base(file)+".rs")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
+	}
+}
+
+func TestParseDart(t *testing.T) {
+	err := PrepareOutputDir(dartCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           dartCodeDir,
+			Lang:                "Dart",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(dartSrcDir, filepath.Base(file)+".dart")
+			genCode := filepath.Join(dartCodeDir, filepath.Base(file)+".dart")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
+	}
+}
+
+func TestParseScala(t *testing.T) {
+	err := PrepareOutputDir(scalaCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           scalaCodeDir,
+			Lang:                "Scala",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(scalaSrcDir, filepath.Base(file)+".scala")
+			genCode := filepath.Join(scalaCodeDir, filepath.Base(file)+".scala")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
+	}
+}
+
+func TestParseGraphQL(t *testing.T) {
+	err := PrepareOutputDir(graphqlCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           graphqlCodeDir,
+			Lang:                "GraphQL",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(graphqlSrcDir, filepath.Base(file)+".graphql")
+			genCode := filepath.Join(graphqlCodeDir, filepath.Base(file)+".graphql")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
+	}
+}
+
+func TestParseOpenAPI(t *testing.T) {
+	err := PrepareOutputDir(openapiCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           openapiCodeDir,
+			Lang:                "OpenAPI",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(openapiSrcDir, filepath.Base(file)+".yaml")
+			genCode := filepath.Join(openapiCodeDir, filepath.Base(file)+".yaml")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
+	}
+}
+
+func TestParseKotlin(t *testing.T) {
+	err := PrepareOutputDir(kotlinCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           kotlinCodeDir,
+			Lang:                "Kotlin",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(kotlinSrcDir, filepath.Base(file)+".kt")
+			genCode := filepath.Join(kotlinCodeDir, filepath.Base(file)+".kt")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
+	}
+}
+
+func TestParseSwift(t *testing.T) {
+	err := PrepareOutputDir(swiftCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           swiftCodeDir,
+			Lang:                "Swift",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(swiftSrcDir, filepath.Base(file)+".swift")
+			genCode := filepath.Join(swiftCodeDir, filepath.Base(file)+".swift")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
+	}
+}
+
+func TestParseProtobuf(t *testing.T) {
+	err := PrepareOutputDir(protoCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           protoCodeDir,
+			Lang:                "Protobuf",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(protoSrcDir, filepath.Base(file)+".proto")
+			genCode := filepath.Join(protoCodeDir, filepath.Base(file)+".proto")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
+	}
+}
+
+func TestParseRuby(t *testing.T) {
+	err := PrepareOutputDir(rubyCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           rubyCodeDir,
+			Lang:                "Ruby",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(rubySrcDir, filepath.Base(file)+".rb")
+			genCode := filepath.Join(rubyCodeDir, filepath.Base(file)+".rb")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
 	}
 }
+
+func TestParseCPP(t *testing.T) {
+	err := PrepareOutputDir(cppCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           cppCodeDir,
+			Lang:                "C++",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(cppSrcDir, filepath.Base(file)+".hpp")
+			genCode := filepath.Join(cppCodeDir, filepath.Base(file)+".hpp")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+
+			srcSource, srcErr := os.Stat(filepath.Join(cppSrcDir, filepath.Base(file)+".cpp"))
+			genSource, genErr := os.Stat(filepath.Join(cppCodeDir, filepath.Base(file)+".cpp"))
+			assert.Equal(t, os.IsNotExist(srcErr), os.IsNotExist(genErr), fmt.Sprintf("error in generated implementation file for %s", file))
+			if srcErr == nil && genErr == nil {
+				assert.Equal(t, srcSource.Size(), genSource.Size(), fmt.Sprintf("error in generated implementation file for %s", file))
+			}
+		}
+	}
+}
+
+func TestParseJSONSchema(t *testing.T) {
+	err := PrepareOutputDir(jsonCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           jsonCodeDir,
+			Lang:                "JSONSchema",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(jsonSrcDir, filepath.Base(file)+".json")
+			genCode := filepath.Join(jsonCodeDir, filepath.Base(file)+".json")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
+	}
+}
+
+func TestParsePython(t *testing.T) {
+	err := PrepareOutputDir(pyCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           pyCodeDir,
+			Lang:                "Python",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(pySrcDir, filepath.Base(file)+".py")
+			genCode := filepath.Join(pyCodeDir, filepath.Base(file)+".py")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
+	}
+}
+
+func TestParseCSharp(t *testing.T) {
+	err := PrepareOutputDir(csCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           csCodeDir,
+			Lang:                "C#",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(csSrcDir, filepath.Base(file)+".cs")
+			genCode := filepath.Join(csCodeDir, filepath.Base(file)+".cs")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
+	}
+}
+
+func TestParsePHP(t *testing.T) {
+	err := PrepareOutputDir(phpCodeDir)
+	assert.NoError(t, err)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           phpCodeDir,
+			Lang:                "PHP",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		err = parser.Parse()
+		assert.NoError(t, err)
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			srcCode := filepath.Join(phpSrcDir, filepath.Base(file)+".php")
+			genCode := filepath.Join(phpCodeDir, filepath.Base(file)+".php")
+
+			srcFile, err := os.Stat(srcCode)
+			assert.NoError(t, err)
+
+			genFile, err := os.Stat(genCode)
+			assert.NoError(t, err)
+
+			assert.Equal(t, srcFile.Size(), genFile.Size(), fmt.Sprintf("error in generated code for %s", file))
+		}
+	}
+}
+
+func TestFileLayout(t *testing.T) {
+	for layout, expected := range map[string][]string{
+		FileLayoutSingle:       {"base64.xsd.go"},
+		FileLayoutPerType:      {"MyType1.go", "MyType2.go", "MyType3.go", "MyType4.go", "MyType5.go", "XSDDate.go", "XSDDateTime.go", "XSDGDay.go"},
+		FileLayoutPerNamespace: {"ExampleOrg.go"},
+	} {
+		outputDir, err := ioutil.TempDir("", "xgen")
+		assert.NoError(t, err)
+		defer os.RemoveAll(outputDir)
+		parser := NewParser(&Options{
+			FilePath:            filepath.Join(xsdSrcDir, "base64.xsd"),
+			OutputDir:           outputDir,
+			Lang:                "Go",
+			FileLayout:          layout,
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		assert.NoError(t, parser.Parse(), layout)
+		files, err := ioutil.ReadDir(outputDir)
+		assert.NoError(t, err)
+		var fileNames []string
+		for _, file := range files {
+			fileNames = append(fileNames, file.Name())
+		}
+		sort.Strings(fileNames)
+		assert.Equal(t, expected, fileNames, layout)
+	}
+
+	// the schemas in the same namespace are written into the same file.
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	var files []string
+	for _, name := range []string{"alpha", "beta"} {
+		file := filepath.Join(outputDir, name+".xsd")
+		assert.NoError(t, ioutil.WriteFile(file, []byte(fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="urn:x">
+	<xs:complexType name="%s">
+		<xs:sequence>
+			<xs:element name="value" type="xs:string"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`, name)), 0644))
+		files = append(files, file)
+	}
+	assert.NoError(t, ParseFiles(files, &Options{OutputDir: outputDir, Lang: "Go", FileLayout: FileLayoutPerNamespace}))
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "X.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "type Alpha struct {")
+	assert.Contains(t, string(source), "type Beta struct {")
+}
+
+func TestTimeLayout(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "base64.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		TimeLayout:          map[string]string{"dateTime": "2006-01-02 15:04:05"},
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "base64.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), `time.Time(t).Format("2006-01-02 15:04:05")`)
+	assert.Contains(t, string(source), `time.Time(t).Format("---02")`)
+}
+
+func TestUniqueFileName(t *testing.T) {
+	names := map[string]int{}
+	assert.Equal(t, "Foo", uniqueFileName("Foo", names))
+	assert.Equal(t, "foo_2", uniqueFileName("foo", names))
+	assert.Equal(t, "Foo_3", uniqueFileName("Foo", names))
+	assert.Equal(t, "Bar", uniqueFileName("Bar", names))
+}
+
+func TestGroupReference(t *testing.T) {
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "group.xsd"),
+		Extract:             true,
+		Lang:                "Go",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	elements := map[string][]Element{}
+	for _, ele := range parser.ProtoTree {
+		if complexType, ok := ele.(*ComplexType); ok {
+			assert.Empty(t, complexType.Groups, complexType.Name)
+			elements[complexType.Name] = complexType.Elements
+		}
+	}
+	assert.Equal(t, []Element{
+		{Name: "customerId", Type: "string"},
+		{Name: "firstName", Type: "string"},
+		{Name: "lastName", Type: "string"},
+		{Name: "email", Type: "string", Plural: true},
+	}, elements["customer"])
+	assert.Equal(t, []Element{
+		{Name: "company", Type: "string"},
+		{Name: "firstName", Type: "string", Optional: true},
+		{Name: "lastName", Type: "string", Optional: true},
+		{Name: "email", Type: "string", Plural: true, Optional: true},
+	}, elements["supplier"])
+
+	// the unresolved group references nested in the referenced groups are
+	// kept like the direct ones.
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:group name="a">
+		<xs:sequence>
+			<xs:element name="a" type="xs:string"/>
+			<xs:group ref="Missing"/>
+		</xs:sequence>
+	</xs:group>
+	<xs:complexType name="nested">
+		<xs:sequence>
+			<xs:group ref="a"/>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="direct">
+		<xs:sequence>
+			<xs:element name="a" type="xs:string"/>
+			<xs:group ref="Missing"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go"}))
+	assert.Contains(t, buf.String(), "type Nested struct {\n\tXMLName xml.Name `xml:\"nested\"`\n\tMissing *Missing\n\tA       string `xml:\"a\"`\n}\n")
+	assert.Contains(t, buf.String(), "type Direct struct {\n\tXMLName xml.Name `xml:\"direct\"`\n\tMissing *Missing\n\tA       string `xml:\"a\"`\n}\n")
+}
+
+func TestAttributeGroupReference(t *testing.T) {
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "attributeGroup.xsd"),
+		Extract:             true,
+		Lang:                "Go",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	var attributeGroups []string
+	for _, ele := range parser.ProtoTree {
+		switch v := ele.(type) {
+		case *ComplexType:
+			assert.Empty(t, v.AttributeGroup)
+			assert.Equal(t, []Attribute{
+				{Name: "price", Type: "float64", Optional: true},
+				{Name: "sku", Type: "string"},
+				{Name: "id", Type: "string"},
+				{Name: "lang", Type: "string", Optional: true},
+			}, v.Attributes)
+		case *AttributeGroup:
+			attributeGroups = append(attributeGroups, v.Name)
+		}
+	}
+	assert.Equal(t, []string{"productAttrs", "commonAttrs"}, attributeGroups)
+
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:attributeGroup name="auditAttrs">
+		<xs:attribute name="author" type="xs:string"/>
+	</xs:attributeGroup>
+	<xs:complexType name="amount">
+		<xs:simpleContent>
+			<xs:extension base="xs:decimal">
+				<xs:attributeGroup ref="auditAttrs"/>
+			</xs:extension>
+		</xs:simpleContent>
+	</xs:complexType>
+	<xs:complexType name="entry">
+		<xs:sequence>
+			<xs:element name="memo" type="xs:string"/>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="auditedEntry">
+		<xs:complexContent>
+			<xs:extension base="entry">
+				<xs:attributeGroup ref="auditAttrs"/>
+			</xs:extension>
+		</xs:complexContent>
+	</xs:complexType>
+</xs:schema>`
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go"}))
+	source := buf.String()
+	assert.Contains(t, source, "type Amount struct {\n\tXMLName    xml.Name `xml:\"amount\"`\n\tAuthorAttr string   `xml:\"author,attr,omitempty\"`\n")
+	assert.Contains(t, source, "type AuditedEntry struct {\n\tXMLName xml.Name `xml:\"auditedEntry\"`\n\tEntry\n\tAuthorAttr string `xml:\"author,attr,omitempty\"`\n")
+	// the unresolved attribute group references nested in the referenced
+	// attribute groups are kept like the direct ones.
+	schema = `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:attributeGroup name="a">
+		<xs:attribute name="a" type="xs:string"/>
+		<xs:attributeGroup ref="Missing"/>
+	</xs:attributeGroup>
+	<xs:complexType name="nested">
+		<xs:attributeGroup ref="a"/>
+	</xs:complexType>
+	<xs:complexType name="direct">
+		<xs:attribute name="a" type="xs:string"/>
+		<xs:attributeGroup ref="Missing"/>
+	</xs:complexType>
+</xs:schema>`
+	buf.Reset()
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go"}))
+	assert.Contains(t, buf.String(), "type Nested struct {\n\tXMLName xml.Name `xml:\"nested\"`\n\tMissing *Missing\n\tAAttr   string `xml:\"a,attr,omitempty\"`\n}\n")
+	assert.Contains(t, buf.String(), "type Direct struct {\n\tXMLName xml.Name `xml:\"direct\"`\n\tMissing *Missing\n\tAAttr   string `xml:\"a,attr,omitempty\"`\n}\n")
+}
+
+func TestTree(t *testing.T) {
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "shipOrder.xsd"),
+		Extract:             true,
+		Lang:                "Go",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	tree := parser.Tree()
+	assert.Equal(t, filepath.Join(xsdSrcDir, "shipOrder.xsd"), tree.FilePath)
+	assert.Equal(t, "http://example.org/", tree.TargetNamespace)
+	assert.Empty(t, tree.Elements)
+	assert.Empty(t, tree.Attributes)
+	assert.Empty(t, tree.Groups)
+	assert.Empty(t, tree.AttributeGroups)
+
+	assert.Len(t, tree.SimpleTypes, 1)
+	assert.Equal(t, "orderStatus", tree.SimpleTypes[0].Name)
+	assert.Equal(t, []string{"pending", "in-transit", "delivered"}, tree.SimpleTypes[0].Restriction.Enum)
+
+	assert.Len(t, tree.ComplexTypes, 1)
+	shipOrder := tree.ComplexTypes[0]
+	assert.Equal(t, "shipOrder", shipOrder.Name)
+	var elements []string
+	for _, element := range shipOrder.Elements {
+		elements = append(elements, element.Name+":"+element.Type)
+	}
+	assert.Equal(t, []string{"orderPerson:string", "note:string", "item:string", "status:orderStatus"}, elements)
+	assert.Equal(t, []Attribute{
+		{Name: "orderid", Type: "string"},
+		{Name: "priority", Type: "int", Optional: true},
+	}, shipOrder.Attributes)
+}
+
+func TestParseWSDL(t *testing.T) {
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "stockQuote.wsdl"),
+		Extract:             true,
+		Lang:                "Go",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	tree := parser.Tree()
+	assert.Len(t, tree.SimpleTypes, 1)
+	assert.Equal(t, "tickerSymbol", tree.SimpleTypes[0].Name)
+	var complexTypes []string
+	for _, complexType := range tree.ComplexTypes {
+		complexTypes = append(complexTypes, complexType.Name)
+	}
+	assert.Equal(t, []string{"money", "tradePriceRequest", "tradePrice"}, complexTypes)
+	assert.Empty(t, tree.Elements)
+	assert.Equal(t, []Element{
+		{Name: "tickerSymbol", Type: "string"},
+		{Name: "price", Type: "money"},
+	}, tree.ComplexTypes[2].Elements)
+}
+
+func TestParseFiles(t *testing.T) {
+	inputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(inputDir)
+	validFile, brokenFile := filepath.Join(inputDir, "valid.xsd"), filepath.Join(inputDir, "broken.xsd")
+	assert.NoError(t, ioutil.WriteFile(validFile, []byte(`<schema xmlns="http://www.w3.org/2001/XMLSchema">
+  <element name="valid" type="string"/>
+</schema>`), 0644))
+	assert.NoError(t, ioutil.WriteFile(brokenFile, []byte(`<schema xmlns="http://www.w3.org/2001/XMLSchema">
+  <element name="broken" type="string">
+</schema>`), 0644))
+
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	err = ParseFiles([]string{brokenFile, validFile}, &Options{OutputDir: outputDir, Lang: "Go"})
+	assert.EqualError(t, err, brokenFile+":3: XML syntax error on line 3: element <element> closed by </schema>")
+	parseErrs, ok := err.(ParseErrors)
+	assert.True(t, ok)
+	assert.Len(t, parseErrs, 1)
+	assert.Equal(t, brokenFile, parseErrs[0].FilePath)
+	assert.Equal(t, 3, parseErrs[0].Line)
+
+	_, err = os.Stat(filepath.Join(outputDir, "valid.xsd.go"))
+	assert.NoError(t, err)
+	assert.NoError(t, ParseFiles([]string{validFile}, &Options{OutputDir: outputDir, Lang: "Go"}))
+}
+
+func TestDryRun(t *testing.T) {
+	inputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(inputDir)
+	customerFile, orderFile := filepath.Join(inputDir, "customer.xsd"), filepath.Join(inputDir, "order.xsd")
+	assert.NoError(t, ioutil.WriteFile(customerFile, []byte(`<schema xmlns="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/customer">
+  <simpleType name="status">
+    <restriction base="string">
+      <enumeration value="active"/>
+    </restriction>
+  </simpleType>
+  <complexType name="customer">
+    <attribute name="status" type="string"/>
+  </complexType>
+</schema>`), 0644))
+	assert.NoError(t, ioutil.WriteFile(orderFile, []byte(`<schema xmlns="http://www.w3.org/2001/XMLSchema">
+  <complexType name="order">
+    <attribute name="id" type="string"/>
+  </complexType>
+  <complexType name="item">
+    <attribute name="sku" type="string"/>
+  </complexType>
+</schema>`), 0644))
+
+	outputDir := filepath.Join(inputDir, "out")
+	options := &Options{OutputDir: outputDir, Lang: "Go", PackagePerNamespace: true, GenRoundTripTests: true, DryRun: true}
+	assert.NoError(t, ParseFiles([]string{customerFile, orderFile}, options))
+	assert.Equal(t, map[string][]string{
+		filepath.Join(outputDir, "customer", "customer.xsd.go"):      {"Status", "Customer"},
+		filepath.Join(outputDir, "customer", "customer.xsd_test.go"): {"Customer"},
+		filepath.Join(outputDir, "order.xsd.go"):                     {"Order", "Item"},
+		filepath.Join(outputDir, "order.xsd_test.go"):                {"Order", "Item"},
+	}, options.Manifest)
+	_, err = os.Stat(outputDir)
+	assert.True(t, os.IsNotExist(err))
+
+	options = &Options{OutputDir: inputDir, Lang: "TypeScript", FileLayout: FileLayoutPerType, DryRun: true}
+	assert.NoError(t, ParseFiles([]string{orderFile}, options))
+	assert.Equal(t, map[string][]string{
+		filepath.Join(inputDir, "Order.ts"): {"Order"},
+		filepath.Join(inputDir, "Item.ts"):  {"Item"},
+	}, options.Manifest)
+	files, err := ioutil.ReadDir(inputDir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+}
+
+func TestParseDirectory(t *testing.T) {
+	inputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(inputDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, "a.xsd"), []byte(`<schema xmlns="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/shop">
+  <simpleType name="sku">
+    <restriction base="string">
+      <pattern value="[A-Z]{3}"/>
+    </restriction>
+  </simpleType>
+  <complexType name="address">
+    <sequence>
+      <element name="street" type="string"/>
+      <element name="since" type="date"/>
+    </sequence>
+  </complexType>
+</schema>`), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, "b.xsd"), []byte(`<schema xmlns="http://www.w3.org/2001/XMLSchema" xmlns:tns="http://example.com/shop" targetNamespace="http://example.com/shop">
+  <complexType name="customer">
+    <sequence>
+      <element name="address" type="tns:address"/>
+      <element name="sku" type="tns:sku"/>
+      <element name="born" type="date"/>
+    </sequence>
+  </complexType>
+</schema>`), 0644))
+
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	files, err := GetFileList(inputDir, ".xsd")
+	assert.NoError(t, err)
+	var sources []string
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, ParseFiles(files, &Options{OutputDir: outputDir, Lang: "Go", UnresolvedAsAny: true}))
+		a, err := ioutil.ReadFile(filepath.Join(outputDir, "a.xsd.go"))
+		assert.NoError(t, err)
+		b, err := ioutil.ReadFile(filepath.Join(outputDir, "b.xsd.go"))
+		assert.NoError(t, err)
+		sources = append(sources, string(a)+string(b))
+		assert.Contains(t, string(a), "type XSDDate time.Time\n")
+		assert.NotContains(t, string(b), "type XSDDate")
+		assert.Contains(t, string(b), "\tAddress *Address `xml:\"address\"`\n\tSku     string   `xml:\"sku\"`\n\tBorn    XSDDate  `xml:\"born\"`\n")
+	}
+	assert.Equal(t, sources[0], sources[1])
+
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not available")
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "go.mod"), []byte("module schema\n\ngo 1.14\n"), 0644))
+	cmd := exec.Command(goTool, "vet", ".")
+	cmd.Dir = outputDir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(output))
+}
+
+func TestRedefine(t *testing.T) {
+	inputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(inputDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, "base.xsd"), []byte(`<schema xmlns="http://www.w3.org/2001/XMLSchema">
+  <simpleType name="sizeType">
+    <restriction base="integer">
+      <maxInclusive value="10"/>
+    </restriction>
+  </simpleType>
+  <complexType name="addressType">
+    <sequence>
+      <element name="street" type="string"/>
+      <element name="city" type="string"/>
+    </sequence>
+  </complexType>
+  <group name="nameGroup">
+    <sequence>
+      <element name="firstName" type="string"/>
+    </sequence>
+  </group>
+  <attributeGroup name="commonAttrs">
+    <attribute name="id" type="string" use="required"/>
+  </attributeGroup>
+  <complexType name="person">
+    <sequence>
+      <group ref="nameGroup"/>
+    </sequence>
+    <attributeGroup ref="commonAttrs"/>
+  </complexType>
+</schema>`), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, "redefine.xsd"), []byte(`<schema xmlns="http://www.w3.org/2001/XMLSchema">
+  <redefine schemaLocation="base.xsd">
+    <simpleType name="sizeType">
+      <restriction base="sizeType">
+        <maxInclusive value="5"/>
+      </restriction>
+    </simpleType>
+    <complexType name="addressType">
+      <complexContent>
+        <extension base="addressType">
+          <sequence>
+            <element name="country" type="string"/>
+          </sequence>
+        </extension>
+      </complexContent>
+    </complexType>
+    <group name="nameGroup">
+      <sequence>
+        <group ref="nameGroup"/>
+        <element name="lastName" type="string"/>
+      </sequence>
+    </group>
+    <attributeGroup name="commonAttrs">
+      <attributeGroup ref="commonAttrs"/>
+      <attribute name="lang" type="string"/>
+    </attributeGroup>
+  </redefine>
+</schema>`), 0644))
+
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(inputDir, "redefine.xsd"),
+		Extract:             true,
+		Lang:                "Go",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	tree := parser.Tree()
+	assert.Len(t, tree.SimpleTypes, 1)
+	assert.Equal(t, "int", tree.SimpleTypes[0].Base)
+
+	complexTypes := map[string]*ComplexType{}
+	for _, complexType := range tree.ComplexTypes {
+		complexTypes[complexType.Name] = complexType
+	}
+	assert.Len(t, complexTypes, 2)
+	assert.Equal(t, []Element{
+		{Name: "street", Type: "string"},
+		{Name: "city", Type: "string"},
+		{Name: "country", Type: "string"},
+	}, complexTypes["addressType"].Elements)
+	assert.Equal(t, []Element{
+		{Name: "firstName", Type: "string"},
+		{Name: "lastName", Type: "string"},
+	}, complexTypes["person"].Elements)
+	assert.Equal(t, []Attribute{
+		{Name: "id", Type: "string"},
+		{Name: "lang", Type: "string", Optional: true},
+	}, complexTypes["person"].Attributes)
+
+	assert.Len(t, tree.Groups, 1)
+	assert.Empty(t, tree.Groups[0].Groups)
+	assert.Len(t, tree.AttributeGroups, 1)
+	assert.Empty(t, tree.AttributeGroups[0].AttributeGroup)
+}
+
+func TestOverride(t *testing.T) {
+	inputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(inputDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, "base.xsd"), []byte(`<schema xmlns="http://www.w3.org/2001/XMLSchema">
+  <simpleType name="sizeType">
+    <restriction base="integer"/>
+  </simpleType>
+  <complexType name="addressType">
+    <sequence>
+      <element name="street" type="string"/>
+    </sequence>
+  </complexType>
+  <element name="note" type="string"/>
+</schema>`), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, "override.xsd"), []byte(`<schema xmlns="http://www.w3.org/2001/XMLSchema">
+  <override schemaLocation="base.xsd">
+    <simpleType name="sizeType">
+      <restriction base="string"/>
+    </simpleType>
+    <complexType name="addressType">
+      <sequence>
+        <element name="country" type="string"/>
+      </sequence>
+    </complexType>
+    <element name="memo" type="string"/>
+  </override>
+</schema>`), 0644))
+
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(inputDir, "override.xsd"),
+		Extract:             true,
+		Lang:                "Go",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	tree := parser.Tree()
+	assert.Len(t, tree.SimpleTypes, 1)
+	assert.Equal(t, "string", tree.SimpleTypes[0].Base)
+	assert.Len(t, tree.ComplexTypes, 1)
+	assert.Equal(t, []Element{{Name: "country", Type: "string"}}, tree.ComplexTypes[0].Elements)
+	assert.Len(t, tree.Elements, 1)
+	assert.Equal(t, "note", tree.Elements[0].Name)
+}
+
+func TestRustFieldCardinality(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "shipOrder.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Rust",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "shipOrder.xsd.rs"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "    #[serde(rename = \"orderPerson\")]\n    pub OrderPerson: char,\n")
+	assert.Contains(t, string(source), "    #[serde(rename = \"note\", default, skip_serializing_if = \"Option::is_none\")]\n    pub Note: Option<char>,\n")
+	assert.Contains(t, string(source), "    #[serde(rename = \"item\")]\n    pub Item: Vec<char>,\n")
+	assert.Contains(t, string(source), "    #[serde(rename = \"orderid\")]\n    pub Orderid: char,\n")
+	assert.Contains(t, string(source), "    #[serde(rename = \"priority\", default, skip_serializing_if = \"Option::is_none\")]\n    pub Priority: Option<isize>,\n")
+}
+
+func TestCHeaderSyntax(t *testing.T) {
+	compiler, err := exec.LookPath("gcc")
+	if err != nil {
+		t.Skip("gcc is not available")
+	}
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           outputDir,
+			Lang:                "C",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		assert.NoError(t, parser.Parse())
+		if ext := filepath.Ext(file); ext == ".xsd" || ext == ".wsdl" {
+			header := filepath.Join(outputDir, filepath.Base(file)+".h")
+			output, err := exec.Command(compiler, "-fsyntax-only", "-x", "c", header).CombinedOutput()
+			assert.NoError(t, err, string(output))
+		}
+	}
+}
+
+func TestCSharpSyntax(t *testing.T) {
+	dotnet, err := exec.LookPath("dotnet")
+	if err != nil {
+		t.Skip("dotnet is not available")
+	}
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "schema.csproj"), []byte(`<Project Sdk="Microsoft.NET.Sdk">
+  <PropertyGroup>
+    <OutputType>Library</OutputType>
+    <TargetFramework>net8.0</TargetFramework>
+  </PropertyGroup>
+</Project>
+`), 0644))
+	files, err := filepath.Glob(filepath.Join(csSrcDir, "*.cs"))
+	assert.NoError(t, err)
+	for i, file := range files {
+		source, err := ioutil.ReadFile(file)
+		assert.NoError(t, err)
+		// each golden file is compiled in its own namespace, since the
+		// declarations of them are in the same namespace.
+		source = bytes.Replace(source, []byte("\nnamespace Schema\n"), []byte(fmt.Sprintf("\nnamespace Schema%d\n", i)), 1)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, filepath.Base(file)), source, 0644))
+	}
+	cmd := exec.Command(dotnet, "build", "-nologo")
+	cmd.Dir = outputDir
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(output))
+}
+
+func TestGenRoundTripTests(t *testing.T) {
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not available")
+	}
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "go.mod"), []byte("module schema\n\ngo 1.14\n"), 0644))
+	assert.NoError(t, os.Mkdir(filepath.Join(outputDir, "testdata"), 0755))
+	xsdFile := filepath.Join(outputDir, "order.xsd")
+	assert.NoError(t, ioutil.WriteFile(xsdFile, []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:complexType name="order">
+		<xs:sequence>
+			<xs:element name="item" type="xs:string" maxOccurs="unbounded"/>
+			<xs:element name="quantity" type="xs:int"/>
+		</xs:sequence>
+		<xs:attribute name="id" type="xs:string"/>
+	</xs:complexType>
+	<xs:complexType name="customer">
+		<xs:sequence>
+			<xs:element name="name" type="xs:string"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "testdata", "order.xml"), []byte(`<order id="1">
+	<item>apple</item>
+	<item>pear</item>
+	<quantity>2</quantity>
+</order>`), 0644))
+	parser := NewParser(&Options{
+		FilePath:            xsdFile,
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		GenRoundTripTests:   true,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	_, err = os.Stat(filepath.Join(outputDir, "order.xsd_test.go"))
+	assert.NoError(t, err)
+
+	cmd := exec.Command(goTool, "test", "-v", ".")
+	cmd.Dir = outputDir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(output))
+	assert.Contains(t, string(output), "--- PASS: TestRoundTripOrder")
+	assert.Contains(t, string(output), "--- SKIP: TestRoundTripCustomer")
+}
+
+func TestGoGenerics(t *testing.T) {
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:complexType name="order">
+		<xs:sequence>
+			<xs:element name="items">
+				<xs:complexType>
+					<xs:sequence>
+						<xs:element name="item" type="xs:string" maxOccurs="unbounded"/>
+					</xs:sequence>
+				</xs:complexType>
+			</xs:element>
+			<xs:element name="tags" type="tags"/>
+		</xs:sequence>
+		<xs:attribute name="id" type="xs:string"/>
+	</xs:complexType>
+	<xs:complexType name="tags">
+		<xs:sequence>
+			<xs:element name="tag" type="xs:string" maxOccurs="unbounded"/>
+		</xs:sequence>
+		<xs:attribute name="lang" type="xs:string"/>
+	</xs:complexType>
+</xs:schema>`
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go", GoGenerics: true}))
+	assert.Contains(t, buf.String(), "type OrderItems = List[string, ItemName]\n")
+	assert.Contains(t, buf.String(), "\tItems   OrderItems `xml:\"items\"`\n")
+	assert.Contains(t, buf.String(), "type List[T any, N ListItemName] []T\n")
+	assert.Contains(t, buf.String(), "type Tags struct {\n")
+
+	buf.Reset()
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go"}))
+	assert.Contains(t, buf.String(), "type OrderItems struct {\n")
+	assert.NotContains(t, buf.String(), "List[")
+
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not available")
+	}
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "go.mod"), []byte("module schema\n\ngo 1.18\n"), 0644))
+	assert.NoError(t, os.Mkdir(filepath.Join(outputDir, "testdata"), 0755))
+	xsdFile := filepath.Join(outputDir, "order.xsd")
+	assert.NoError(t, ioutil.WriteFile(xsdFile, []byte(schema), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "testdata", "order.xml"), []byte(`<order id="1">
+	<items>
+		<item>apple</item>
+		<item>pear</item>
+	</items>
+	<tags lang="en">
+		<tag>fruit</tag>
+	</tags>
+</order>`), 0644))
+	parser := NewParser(&Options{
+		FilePath:            xsdFile,
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		GenRoundTripTests:   true,
+		GoGenerics:          true,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+
+	cmd := exec.Command(goTool, "test", "-v", "-run", "TestRoundTripOrder", ".")
+	cmd.Dir = outputDir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(output))
+	assert.Contains(t, string(output), "--- PASS: TestRoundTripOrder")
+}
+
+func TestAnonymousTypes(t *testing.T) {
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:element name="library">
+		<xs:complexType>
+			<xs:sequence>
+				<xs:element name="book" maxOccurs="unbounded">
+					<xs:complexType>
+						<xs:sequence>
+							<xs:element name="author">
+								<xs:complexType>
+									<xs:sequence>
+										<xs:element name="name" type="xs:string"/>
+									</xs:sequence>
+								</xs:complexType>
+							</xs:element>
+							<xs:element name="format">
+								<xs:simpleType>
+									<xs:restriction base="xs:string">
+										<xs:enumeration value="paperback"/>
+										<xs:enumeration value="hardcover"/>
+									</xs:restriction>
+								</xs:simpleType>
+							</xs:element>
+						</xs:sequence>
+						<xs:attribute name="lang">
+							<xs:simpleType>
+								<xs:restriction base="xs:string">
+									<xs:maxLength value="3"/>
+								</xs:restriction>
+							</xs:simpleType>
+						</xs:attribute>
+					</xs:complexType>
+				</xs:element>
+				<xs:element name="shelf">
+					<xs:complexType>
+						<xs:sequence>
+							<xs:element name="author">
+								<xs:complexType>
+									<xs:attribute name="id" type="xs:int"/>
+								</xs:complexType>
+							</xs:element>
+						</xs:sequence>
+					</xs:complexType>
+				</xs:element>
+			</xs:sequence>
+		</xs:complexType>
+	</xs:element>
+</xs:schema>`
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go"}))
+	source := buf.String()
+	assert.Contains(t, source, "type LibraryBookAuthor struct {\n\tName string `xml:\"name\"`\n}\n")
+	assert.Contains(t, source, "type LibraryShelfAuthor struct {\n\tIdAttr int `xml:\"id,attr,omitempty\"`\n}\n")
+	assert.Contains(t, source, "type LibraryBookFormat string\n")
+	assert.Contains(t, source, "\tLangAttr string             `xml:\"lang,attr,omitempty\"`\n")
+	assert.Contains(t, source, "\tAuthor   *LibraryBookAuthor `xml:\"author\"`\n")
+	assert.Contains(t, source, "\tFormat   LibraryBookFormat  `xml:\"format\"`\n")
+	assert.Contains(t, source, "\tBook    []*LibraryBook `xml:\"book\"`\n")
+	assert.Contains(t, source, "\tShelf   *LibraryShelf  `xml:\"shelf\"`\n")
+	assert.NotContains(t, source, "type Author ")
+
+	for i := 0; i < 3; i++ {
+		var regenerated bytes.Buffer
+		assert.NoError(t, Generate(strings.NewReader(schema), &regenerated, Options{Lang: "Go"}))
+		assert.Equal(t, source, regenerated.String())
+	}
+
+	buf.Reset()
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go", AnonymousTypeNaming: AnonymousTypeNamingHash}))
+	authors := regexp.MustCompile(`type (Author[0-9a-f]{8}) struct`).FindAllStringSubmatch(buf.String(), -1)
+	assert.Len(t, authors, 2)
+	assert.NotEqual(t, authors[0][1], authors[1][1])
+	assert.True(t, regexp.MustCompile(`type Format[0-9a-f]{8} string\n`).MatchString(buf.String()))
+
+	buf.Reset()
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go", AnonymousTypeName: func(parent, name string) string {
+		return name
+	}}))
+	source = buf.String()
+	assert.Contains(t, source, "type Author struct {\n\tName string `xml:\"name\"`\n}\n")
+	assert.Contains(t, source, "type Author2 struct {\n\tIdAttr int `xml:\"id,attr,omitempty\"`\n}\n")
+	assert.Contains(t, source, "\tBook    []*Book  `xml:\"book\"`\n")
+
+	assert.EqualError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go", AnonymousTypeNaming: "flat"}), "schema.xsd: unsupported anonymous type naming flat")
+
+	schema = `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:complexType name="order">
+		<xs:sequence>
+			<xs:element name="line">
+				<xs:complexType>
+					<xs:attribute name="sku" type="xs:string"/>
+				</xs:complexType>
+			</xs:element>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="orderLine">
+		<xs:attribute name="total" type="xs:decimal"/>
+	</xs:complexType>
+</xs:schema>`
+	buf.Reset()
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go"}))
+	assert.Contains(t, buf.String(), "type OrderLine2 struct {\n\tSkuAttr string `xml:\"sku,attr,omitempty\"`\n}\n")
+	assert.Contains(t, buf.String(), "\tLine    *OrderLine2 `xml:\"line\"`\n")
+	assert.Contains(t, buf.String(), "type OrderLine struct {\n")
+}
+
+func TestOccurrence(t *testing.T) {
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:group name="contact">
+		<xs:sequence>
+			<xs:element name="phone" type="xs:string"/>
+		</xs:sequence>
+	</xs:group>
+	<xs:group name="crew">
+		<xs:sequence minOccurs="0">
+			<xs:group ref="contact"/>
+		</xs:sequence>
+	</xs:group>
+	<xs:complexType name="route">
+		<xs:sequence>
+			<xs:element name="name" type="xs:string" maxOccurs="1"/>
+			<xs:element name="note" type="xs:string" minOccurs="0"/>
+			<xs:element name="distance" type="xs:int" minOccurs="0" maxOccurs="1"/>
+			<xs:sequence maxOccurs="unbounded">
+				<xs:element name="stop" type="xs:string"/>
+			</xs:sequence>
+			<xs:sequence minOccurs="0">
+				<xs:element name="operator" type="xs:string"/>
+			</xs:sequence>
+			<xs:choice>
+				<xs:group ref="contact"/>
+				<xs:element name="radio" type="xs:string"/>
+			</xs:choice>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="vessel">
+		<xs:group ref="crew"/>
+	</xs:complexType>
+</xs:schema>`
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go"}))
+	source := buf.String()
+	assert.Contains(t, source, "\tName     string   `xml:\"name\"`\n")
+	assert.Contains(t, source, "\tNote     *string  `xml:\"note,omitempty\"`\n")
+	assert.Contains(t, source, "\tDistance *int     `xml:\"distance,omitempty\"`\n")
+	assert.Contains(t, source, "\tStop     []string `xml:\"stop\"`\n")
+	assert.Contains(t, source, "\tOperator *string  `xml:\"operator,omitempty\"`\n")
+	assert.Contains(t, source, "\tRadio    *string  `xml:\"radio,omitempty\"`\n")
+	assert.Contains(t, source, "\tPhone    *string  `xml:\"phone,omitempty\"`\n")
+	assert.Contains(t, source, "type Vessel struct {\n\tXMLName xml.Name `xml:\"vessel\"`\n\tPhone   *string  `xml:\"phone,omitempty\"`\n")
+}
+
+func TestGenGoFieldName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"type":    "Type",
+		"func":    "Func",
+		"range":   "Range",
+		"123abc":  "X123abc",
+		"foo-bar": "Foobar",
+		"":        "X",
+		"élan":    "Élan",
+		"名前":      "X名前",
+		"a b/c":   "Abc",
+	} {
+		assert.Equal(t, expected, genGoFieldName(name), name)
+	}
+
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:complexType name="名前">
+		<xs:sequence>
+			<xs:element name="123abc" type="xs:string"/>
+			<xs:element name="type" type="xs:string"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go"}))
+	assert.Contains(t, buf.String(), "type X名前 struct {\n\tXMLName xml.Name `xml:\"名前\"`\n")
+	assert.Contains(t, buf.String(), "\tX123abc string   `xml:\"123abc\"`\n")
+	assert.Contains(t, buf.String(), "\tType    string   `xml:\"type\"`\n")
+}
+
+func TestSchemaLocationMap(t *testing.T) {
+	inputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(inputDir)
+	mirror := filepath.Join(inputDir, "a.xsd")
+	assert.NoError(t, ioutil.WriteFile(mirror, []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/a">
+	<xs:simpleType name="code">
+		<xs:restriction base="xs:int"/>
+	</xs:simpleType>
+</xs:schema>`), 0644))
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:a="http://example.com/a">
+	<xs:import namespace="http://example.com/a" schemaLocation="http://example.com/a.xsd"/>
+	<xs:complexType name="address">
+		<xs:sequence>
+			<xs:element name="zip" type="a:code"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{
+		FilePath:          filepath.Join(inputDir, "address.xsd"),
+		Lang:              "Go",
+		SchemaLocationMap: map[string]string{"http://example.com/a.xsd": mirror},
+	}))
+	assert.Contains(t, buf.String(), "\tZip     int      `xml:\"zip\"`\n")
+}
+
+func TestUnresolvedAsAny(t *testing.T) {
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:ext="http://example.org/external">
+	<xs:complexType name="envelope">
+		<xs:sequence>
+			<xs:element name="id" type="xs:string"/>
+			<xs:element name="payload" type="ext:payload"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`
+	var logs bytes.Buffer
+	for lang, expected := range map[string]string{
+		"Go":         "\tPayload interface{} `xml:\"payload\"`\n",
+		"TypeScript": "  Payload: Array<any>;\n",
+		"Java":       "    protected Object Payload;\n",
+	} {
+		var buf bytes.Buffer
+		logs.Reset()
+		assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: lang, UnresolvedAsAny: true, Logger: log.New(&logs, "", 0)}), lang)
+		assert.Contains(t, buf.String(), expected, lang)
+		assert.Contains(t, logs.String(), "xgen: schema.xsd: unresolved type payload is replaced by ", lang)
+	}
+}
+
+func TestFilterTypes(t *testing.T) {
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:tns="http://example.org/shop">
+	<xs:complexType name="price">
+		<xs:sequence>
+			<xs:element name="amount" type="xs:decimal"/>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="vendor">
+		<xs:sequence>
+			<xs:element name="name" type="xs:string"/>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="product">
+		<xs:sequence>
+			<xs:element name="price" type="tns:price"/>
+			<xs:element name="vendor" type="tns:vendor"/>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="customer">
+		<xs:sequence>
+			<xs:element name="name" type="xs:string"/>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="warehouse">
+		<xs:sequence>
+			<xs:element name="product" type="tns:product" maxOccurs="unbounded"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go", IncludeTypes: []string{"product"}}))
+	assert.Equal(t, 3, strings.Count(buf.String(), "\ntype "))
+	assert.Contains(t, buf.String(), "type Price struct {\n")
+	assert.Contains(t, buf.String(), "type Vendor struct {\n")
+	assert.Contains(t, buf.String(), "type Product struct {\n")
+
+	buf.Reset()
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go", ExcludeTypes: []string{"warehouse"}}))
+	assert.Equal(t, 4, strings.Count(buf.String(), "\ntype "))
+	assert.NotContains(t, buf.String(), "type Warehouse ")
+}
+
+func TestTypeNameAffixes(t *testing.T) {
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:tns="http://example.org/order">
+	<xs:simpleType name="country">
+		<xs:restriction base="xs:string"/>
+	</xs:simpleType>
+	<xs:complexType name="address">
+		<xs:sequence>
+			<xs:element name="street" type="xs:string"/>
+			<xs:element name="country" type="tns:country"/>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="order">
+		<xs:sequence>
+			<xs:element name="address" type="tns:address" maxOccurs="unbounded"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go", TypeNamePrefix: "Billing", TypeNameSuffix: "Type"}))
+	assert.Contains(t, buf.String(), "type BillingCountryType string\n")
+	assert.Contains(t, buf.String(), "type BillingAddressType struct {\n\tXMLName xml.Name `xml:\"address\"`\n")
+	assert.Contains(t, buf.String(), "\tAddress []*BillingAddressType `xml:\"address\"`\n")
+	assert.NotContains(t, buf.String(), "type Address ")
+
+	buf.Reset()
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "TypeScript", TypeNamePrefix: "Billing"}))
+	assert.Contains(t, buf.String(), "export class BillingAddress {\n")
+	assert.Contains(t, buf.String(), "  Address: Array<BillingAddress>;\n")
+
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not available")
+	}
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "go.mod"), []byte("module schema\n\ngo 1.14\n"), 0644))
+	for _, prefix := range []string{"Billing", "Shipping"} {
+		xsdFile := filepath.Join(outputDir, strings.ToLower(prefix)+".xsd")
+		assert.NoError(t, ioutil.WriteFile(xsdFile, []byte(schema), 0644))
+		parser := NewParser(&Options{
+			FilePath:            xsdFile,
+			OutputDir:           outputDir,
+			Lang:                "Go",
+			Package:             "schema",
+			TypeNamePrefix:      prefix,
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		assert.NoError(t, parser.Parse())
+	}
+	cmd := exec.Command(goTool, "build", ".")
+	cmd.Dir = outputDir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(output))
+}
+
+func TestTypeNameCollision(t *testing.T) {
+	inputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(inputDir)
+	for ns, content := range map[string]string{"foo": `<xs:element name="sku" type="xs:string"/>`, "bar": `<xs:element name="code" type="xs:int"/>`} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, ns+".xsd"), []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:tns="http://example.com/`+ns+`" targetNamespace="http://example.com/`+ns+`">
+	<xs:complexType name="item">
+		<xs:sequence>
+			`+content+`
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="`+ns+`List">
+		<xs:sequence>
+			<xs:element name="item" type="tns:item" maxOccurs="unbounded"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`), 0644))
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, "order.xsd"), []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:foo="http://example.com/foo" xmlns:bar="http://example.com/bar">
+	<xs:import namespace="http://example.com/foo" schemaLocation="foo.xsd"/>
+	<xs:import namespace="http://example.com/bar" schemaLocation="bar.xsd"/>
+	<xs:complexType name="order">
+		<xs:sequence>
+			<xs:element name="fooItem" type="foo:item"/>
+			<xs:element name="barItem" type="bar:item"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`), 0644))
+
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(inputDir, "order.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		Package:             "schema",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "order.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "type Order struct {\n")
+	assert.Contains(t, string(source), "\tFooItem *FooItem `xml:\"fooItem\"`\n")
+	assert.Contains(t, string(source), "\tBarItem *BarItem `xml:\"barItem\"`\n")
+	for _, ns := range []string{"Foo", "Bar"} {
+		source, err = ioutil.ReadFile(filepath.Join(outputDir, strings.ToLower(ns)+".xsd.go"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(source), "type "+ns+"Item struct {\n\tXMLName xml.Name `xml:\"item\"`\n")
+		assert.Contains(t, string(source), "type "+ns+"List struct {\n")
+		assert.Contains(t, string(source), "\tItem    []*"+ns+"Item `xml:\"item\"`\n")
+	}
+
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not available")
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "go.mod"), []byte("module schema\n\ngo 1.14\n"), 0644))
+	cmd := exec.Command(goTool, "build", ".")
+	cmd.Dir = outputDir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(output))
+}
+
+func TestCircularImport(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	newParser := func(file string, logger *log.Logger) *Options {
+		return NewParser(&Options{
+			FilePath:            file,
+			OutputDir:           outputDir,
+			Lang:                "Go",
+			Logger:              logger,
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+	}
+	cycleDir := filepath.Join(testDir, "import", "cycle")
+	a, b := filepath.Join(cycleDir, "a.xsd"), filepath.Join(cycleDir, "b.xsd")
+	assert.EqualError(t, newParser(a, nil).Parse(), fmt.Sprintf("%s: circular import %s → %s → %s", a, a, b, a))
+
+	diamondDir := filepath.Join(testDir, "import", "diamond")
+	var logs bytes.Buffer
+	assert.NoError(t, newParser(filepath.Join(diamondDir, "root.xsd"), log.New(&logs, "", 0)).Parse())
+	assert.Equal(t, 1, strings.Count(logs.String(), "parsing "+filepath.Join(diamondDir, "common.xsd")+"\n"))
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "root.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "\tLeft    *LeftItem  `xml:\"left\"`\n")
+	assert.Contains(t, string(source), "\tRight   *RightItem `xml:\"right\"`\n")
+	for _, side := range []string{"left", "right"} {
+		source, err = ioutil.ReadFile(filepath.Join(outputDir, side+".xsd.go"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(source), "\tCode    string   `xml:\"code\"`\n")
+	}
+}
+func TestPackagePerNamespace(t *testing.T) {
+	inputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(inputDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, "customer.xsd"), []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/customer">
+	<xs:complexType name="customer">
+		<xs:sequence>
+			<xs:element name="name" type="xs:string"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, "order.xsd"), []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:c="http://example.com/customer" xmlns:o="http://example.com/order" targetNamespace="http://example.com/order">
+	<xs:import namespace="http://example.com/customer" schemaLocation="customer.xsd"/>
+	<xs:complexType name="item">
+		<xs:sequence>
+			<xs:element name="sku" type="xs:string"/>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="order">
+		<xs:sequence>
+			<xs:element name="buyer" type="c:customer"/>
+			<xs:element name="item" type="o:item" maxOccurs="unbounded"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`), 0644))
+
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(inputDir, "order.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		Package:             "example.com/schema",
+		PackagePerNamespace: true,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "order", "order.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "package order\n")
+	assert.Contains(t, string(source), "import (\n\t\"encoding/xml\"\n\t\"example.com/schema/customer\"\n)\n")
+	assert.Contains(t, string(source), "\tBuyer   *customer.Customer `xml:\"buyer\"`\n")
+	assert.Contains(t, string(source), "\tItem    []*Item            `xml:\"item\"`\n")
+	source, err = ioutil.ReadFile(filepath.Join(outputDir, "customer", "customer.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "package customer\n")
+	assert.NotContains(t, string(source), "example.com/schema/")
+
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not available")
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outputDir, "go.mod"), []byte("module example.com/schema\n\ngo 1.14\n"), 0644))
+	cmd := exec.Command(goTool, "build", "./...")
+	cmd.Dir = outputDir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(output))
+}
+
+func TestLogger(t *testing.T) {
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:simpleType name="status">
+		<xs:restriction base="xs:string">
+			<xs:enumeration value="open"/>
+			<xs:enumeration value="closed"/>
+		</xs:restriction>
+	</xs:simpleType>
+	<xs:complexType name="ticket">
+		<xs:sequence>
+			<xs:element name="status" type="status"/>
+			<xs:element name="note" type="xs:string" minOccurs="0" maxOccurs="unbounded"/>
+			<xs:element name="owner" type="person"/>
+		</xs:sequence>
+		<xs:attribute name="id" type="xs:int" use="required"/>
+	</xs:complexType>
+</xs:schema>`
+	var buf, logs bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go", Logger: log.New(&logs, "", 0), DumpAST: true}))
+	assert.Contains(t, logs.String(), "parsing schema.xsd\n")
+	assert.Contains(t, logs.String(), "registered complexType ticket\n")
+	assert.Contains(t, logs.String(), "unresolved reference person in ticket\n")
+	assert.NotContains(t, logs.String(), "unresolved reference string")
+	assert.Contains(t, logs.String(), "AST of schema.xsd\n\tsimpleType status base string enum open|closed\n\tcomplexType ticket\n\t\tattribute id int [1..1]\n\t\telement status status [1..1]\n\t\telement note string [0..*]\n\t\telement owner person [1..1]\n")
+	assert.Contains(t, logs.String(), "generating Go code for schema.xsd\n")
+	assert.Contains(t, buf.String(), "type Ticket struct {\n")
+}
+
+func TestIndent(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	xsdFile := filepath.Join(outputDir, "indent.xsd")
+	assert.NoError(t, ioutil.WriteFile(xsdFile, []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:complexType name="item">
+		<xs:sequence>
+			<xs:element name="title" type="xs:string"/>
+		</xs:sequence>
+		<xs:attribute name="id" type="xs:int" use="required"/>
+	</xs:complexType>
+</xs:schema>`), 0644))
+	for lang, expected := range map[string]string{
+		"Go": "type Item struct {\n" +
+			"  XMLName xml.Name `xml:\"item\"`\n" +
+			"  IdAttr  int      `xml:\"id,attr\"`\n" +
+			"  Title   string   `xml:\"title\"`\n" +
+			"}\n",
+		"Java": "public class Item {\n" +
+			"  @XmlAttribute(name = \"id\", required = true)\n" +
+			"  protected Integer IdAttr;\n" +
+			"  @XmlElement(required = true, name = \"title\")\n" +
+			"  protected String Title;\n" +
+			"}\n",
+	} {
+		parser := NewParser(&Options{
+			FilePath:            xsdFile,
+			OutputDir:           outputDir,
+			Lang:                lang,
+			Indent:              "  ",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		assert.NoError(t, parser.Parse())
+		ext := map[string]string{"Go": ".go", "Java": ".java"}[lang]
+		source, err := ioutil.ReadFile(xsdFile + ext)
+		assert.NoError(t, err)
+		assert.Contains(t, string(source), expected)
+	}
+}
+
+func TestFormatters(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	xsdFile := filepath.Join(outputDir, "format.xsd")
+	assert.NoError(t, ioutil.WriteFile(xsdFile, []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:complexType name="item">
+		<xs:sequence>
+			<xs:element name="title" type="xs:string"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`), 0644))
+	newParser := func(lang string, formatters map[string]func([]byte) ([]byte, error)) *Options {
+		return NewParser(&Options{
+			FilePath:            xsdFile,
+			OutputDir:           outputDir,
+			Lang:                lang,
+			Formatters:          formatters,
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+	}
+	assert.NoError(t, newParser("TypeScript", map[string]func([]byte) ([]byte, error){
+		"TypeScript": func(source []byte) ([]byte, error) {
+			return bytes.ToUpper(source), nil
+		},
+	}).Parse())
+	source, err := ioutil.ReadFile(xsdFile + ".ts")
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "EXPORT CLASS ITEM {")
+	assert.Equal(t, strings.ToUpper(string(source)), string(source))
+
+	assert.EqualError(t, newParser("TypeScript", map[string]func([]byte) ([]byte, error){
+		"TypeScript": func(source []byte) ([]byte, error) {
+			return nil, errors.New("unexpected token")
+		},
+	}).Parse(), "TypeScript formatter: unexpected token")
+
+	assert.NoError(t, newParser("Go", nil).Parse())
+	source, err = ioutil.ReadFile(xsdFile + ".go")
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "\tTitle   string   `xml:\"title\"`\n")
+	assert.NoError(t, newParser("Go", map[string]func([]byte) ([]byte, error){"Go": nil}).Parse())
+	source, err = ioutil.ReadFile(xsdFile + ".go")
+	assert.NoError(t, err)
+	assert.NotContains(t, string(source), "\tTitle   string   `xml:\"title\"`\n")
+
+	// the output of the formatter is written unchanged with the indentation.
+	formatted := "export class Item {\n\ttitle: string;\n}\n"
+	options := newParser("TypeScript", map[string]func([]byte) ([]byte, error){
+		"TypeScript": func(source []byte) ([]byte, error) {
+			return []byte(formatted), nil
+		},
+	})
+	options.Indent = "    "
+	assert.NoError(t, options.Parse())
+	source, err = ioutil.ReadFile(xsdFile + ".ts")
+	assert.NoError(t, err)
+	assert.Equal(t, formatted, string(source))
+}
+
+func TestGenerate(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:complexType name="item">
+		<xs:sequence>
+			<xs:element name="title" type="xs:string"/>
+		</xs:sequence>
+		<xs:attribute name="id" type="xs:int" use="required"/>
+	</xs:complexType>
+</xs:schema>`
+	for lang, expected := range map[string]string{
+		"Go": "type Item struct {\n" +
+			"\tXMLName xml.Name `xml:\"item\"`\n" +
+			"\tIdAttr  int      `xml:\"id,attr\"`\n" +
+			"\tTitle   string   `xml:\"title\"`\n" +
+			"}\n",
+		"TypeScript": "export class Item {\n" +
+			"  IdAttr: number;\n" +
+			"  Title: Array<string>;\n" +
+			"}\n",
+	} {
+		var buf bytes.Buffer
+		assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{
+			OutputDir: outputDir,
+			Lang:      lang,
+		}), lang)
+		assert.Contains(t, buf.String(), expected, lang)
+	}
+	files, err := ioutil.ReadDir(outputDir)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+
+	var buf bytes.Buffer
+	err = Generate(strings.NewReader(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">`), &buf, Options{Lang: "Go"})
+	assert.EqualError(t, err, "schema.xsd:1: XML syntax error on line 1: unexpected EOF")
+}
+
+func TestXSD11(t *testing.T) {
+	var logs bytes.Buffer
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "assert.xsd"),
+		Extract:             true,
+		Lang:                "Go",
+		Logger:              log.New(&logs, "", 0),
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	tree := parser.Tree()
+	assert.Len(t, tree.ComplexTypes, 2)
+	var elements []string
+	for _, complexType := range tree.ComplexTypes {
+		for _, element := range complexType.Elements {
+			elements = append(elements, complexType.Name+"."+element.Name)
+		}
+	}
+	assert.Equal(t, []string{"temperatureRange.low", "temperatureRange.high", "reading.value"}, elements)
+	assert.Equal(t, []string{"low le high"}, tree.ComplexTypes[0].Assertions)
+	assert.Len(t, tree.SimpleTypes, 1)
+	assert.Equal(t, "int", tree.SimpleTypes[0].Base)
+	assert.Equal(t, []string{"$value mod 2 = 0"}, tree.SimpleTypes[0].Restriction.Assertions)
+	elements = nil
+	for _, element := range tree.Elements {
+		elements = append(elements, element.Name+":"+element.Type)
+	}
+	assert.Equal(t, []string{"sensor:reading", "measurement:temperatureRange"}, elements)
+	assert.Contains(t, logs.String(), fmt.Sprintf("xgen: %s: skipping unsupported XML schema 1.1 element alternative\n", filepath.Join(xsdSrcDir, "assert.xsd")))
+}
+
+func TestGoAssertions(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "assert.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		Package:             "schema",
+		GoValidate:          true,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "assert.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "// TemperatureRange ...\n// assert: low le high\ntype TemperatureRange struct {\n")
+	assert.Contains(t, string(source), "var AssertTemperatureRange func(v *TemperatureRange) error\n")
+	assert.Contains(t, string(source), "var AssertEvenNumber func(v EvenNumber) error\n")
+}
+
+func TestGetFileList(t *testing.T) {
+	dir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "sub", "nested.xsd"), 0755))
+	for _, name := range []string{"a.xsd", "B.XSD", "notes.txt", filepath.Join("sub", "c.xsd"), filepath.Join("sub", "d.wsdl")} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte{}, 0644))
+	}
+
+	files, err := GetFileList(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "B.XSD"),
+		filepath.Join(dir, "a.xsd"),
+		filepath.Join(dir, "sub", "c.xsd"),
+	}, files)
+
+	files, err = GetFileList(dir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "B.XSD"),
+		filepath.Join(dir, "a.xsd"),
+		filepath.Join(dir, "sub", "c.xsd"),
+		filepath.Join(dir, "sub", "d.wsdl"),
+	}, files)
+
+	files, err = GetFileList(filepath.Join(dir, "notes.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "notes.txt")}, files)
+
+	_, err = GetFileList(filepath.Join(dir, "missing.xsd"))
+	assert.Error(t, err)
+}
+
+func TestRemoteSchemaLocation(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		switch r.URL.Path {
+		case "/a/main.xsd":
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:c="http://example.org/common">
+	<xs:import namespace="http://example.org/common" schemaLocation="common/types.xsd"/>
+	<xs:complexType name="invoice">
+		<xs:sequence>
+			<xs:element name="total" type="c:amount"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`)
+		case "/a/common/types.xsd":
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.org/common">
+	<xs:simpleType name="amount">
+		<xs:restriction base="xs:decimal"/>
+	</xs:simpleType>
+</xs:schema>`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            server.URL + "/a/main.xsd",
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+		RemoteSchema:        make(map[string][]byte),
+	})
+	assert.NoError(t, parser.Parse())
+	assert.Equal(t, []string{"/a/main.xsd", "/a/common/types.xsd"}, requests)
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "main.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "Total   float64  `xml:\"total\"`")
+	_, err = os.Stat(filepath.Join(outputDir, "types.xsd.go"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "https://host/a/common/types.xsd", resolveSchemaLocation("https://host/a/main.xsd", "common/types.xsd"))
+	assert.Equal(t, "https://host/types.xsd", resolveSchemaLocation("https://host/a/main.xsd", "/types.xsd"))
+	assert.Equal(t, "https://other/types.xsd", resolveSchemaLocation("main.xsd", "https://other/types.xsd"))
+	assert.Equal(t, filepath.Join("schemas", "common", "types.xsd"), resolveSchemaLocation(filepath.Join("schemas", "main.xsd"), filepath.Join("common", "types.xsd")))
+
+	parser.FilePath = server.URL + "/a/missing.xsd"
+	assert.EqualError(t, parser.Parse(), "fetch "+server.URL+"/a/missing.xsd: 404 Not Found")
+}
+
+func TestRemoteSchemaProxy(t *testing.T) {
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:simpleType name="sku">
+		<xs:restriction base="xs:string"/>
+	</xs:simpleType>
+</xs:schema>`
+	var requests []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.String())
+		fmt.Fprint(w, schema)
+	}))
+	defer proxy.Close()
+
+	newParser := func(filePath string) *Options {
+		return NewParser(&Options{
+			FilePath:            filePath,
+			Extract:             true,
+			Lang:                "Go",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+			RemoteSchema:        make(map[string][]byte),
+		})
+	}
+	parser := newParser("http://schemas.example.invalid/sku.xsd")
+	parser.Proxy = proxy.URL
+	assert.NoError(t, parser.Parse())
+	assert.Equal(t, []string{"http://schemas.example.invalid/sku.xsd"}, requests)
+	assert.Len(t, parser.ProtoTree, 1)
+
+	parser = newParser("http://schemas.example.invalid/sku.xsd")
+	parser.Proxy = "http://[::1"
+	assert.EqualError(t, parser.Parse(), `invalid proxy URL http://[::1: parse "http://[::1": missing ']' in host`)
+
+	// The certificate of the test server is self-signed, which is only
+	// accepted if the verification is skipped.
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, schema)
+	}))
+	defer server.Close()
+	parser = newParser(server.URL + "/sku.xsd")
+	assert.Error(t, parser.Parse())
+	parser = newParser(server.URL + "/sku.xsd")
+	parser.InsecureSkipVerify = true
+	assert.NoError(t, parser.Parse())
+	assert.Len(t, parser.ProtoTree, 1)
+}
+
+func TestDerivationConstraints(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	for lang, ext := range map[string]string{"Go": ".go", "TypeScript": ".ts", "Java": ".java"} {
+		parser := NewParser(&Options{
+			FilePath:            filepath.Join(xsdSrcDir, "final.xsd"),
+			OutputDir:           outputDir,
+			Lang:                lang,
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		assert.NoError(t, parser.Parse())
+		source, err := ioutil.ReadFile(filepath.Join(outputDir, "final.xsd"+ext))
+		assert.NoError(t, err)
+		assert.Contains(t, string(source), "// final=\"restriction\": derivation by restriction is prohibited\n", lang)
+	}
+
+	assert.Equal(t, map[string][]string{"account": {"savingsAccount"}}, getDerivedTypes([]interface{}{
+		&ComplexType{Name: "account", Final: "restriction"},
+		&ComplexType{Name: "savingsAccount", Base: "account", Extension: true},
+		&ComplexType{Name: "frozenAccount", Base: "account"},
+	}))
+	assert.Equal(t, map[string][]string{"savingsAccount": {"bonusAccount"}}, getDerivedTypes([]interface{}{
+		&ComplexType{Name: "account", Final: "#all"},
+		&ComplexType{Name: "savingsAccount", Base: "account", Extension: true},
+		&ComplexType{Name: "bonusAccount", Base: "savingsAccount", Extension: true},
+	}))
+}
+
+func TestNamespacePrefix(t *testing.T) {
+	assert.Equal(t, "xs", getNSPrefix("xs:string"))
+	assert.Equal(t, "", getNSPrefix("string"))
+	assert.Equal(t, "string", trimNSPrefix("xs:string"))
+	assert.Equal(t, "string", trimNSPrefix("string"))
+	assert.Equal(t, "", trimNSPrefix(""))
+
+	for name, schema := range map[string]string{
+		"xs prefix":  `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:element name="title" type="xs:string"/></xs:schema>`,
+		"xsd prefix": `<xsd:schema xmlns:xsd="http://www.w3.org/2001/XMLSchema"><xsd:element name="title" type="xsd:string"/></xsd:schema>`,
+		"default":    `<schema xmlns="http://www.w3.org/2001/XMLSchema"><element name="title" type="string"/></schema>`,
+		"target": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns="urn:example" targetNamespace="urn:example">
+	<xs:simpleType name="decimal"><xs:restriction base="xs:string"/></xs:simpleType>
+	<xs:element name="title" type="decimal"/>
+</xs:schema>`,
+	} {
+		opt := &Options{
+			FilePath:            "namespace.xsd",
+			Extract:             true,
+			Lang:                "Go",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		}
+		assert.NoError(t, opt.parse(strings.NewReader(schema)), name)
+		elements := opt.Tree().Elements
+		if assert.Len(t, elements, 1, name) {
+			assert.Equal(t, "string", elements[0].Type, name)
+		}
+	}
+
+	opt := &Options{Extract: true, Lang: "Go", LocalNameNSMap: map[string]string{"": "urn:example", "xs": xsdNamespace}}
+	valueType, err := opt.GetValueType("decimal", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "decimal", valueType)
+	valueType, err = opt.GetValueType("xs:decimal", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "float64", valueType)
+}
+
+func TestMalformedSchema(t *testing.T) {
+	for name, c := range map[string]struct {
+		schema, err string
+	}{
+		"empty":     {"", "schema.xsd: no root element found, expected an XML schema document"},
+		"blank":     {"<?xml version=\"1.0\"?>\n<!-- comment -->\n", "schema.xsd: no root element found, expected an XML schema document"},
+		"truncated": {`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:element name="title" type="xs:str`, "schema.xsd:1: XML syntax error on line 1: unexpected EOF"},
+		"unclosed":  {`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:element name="title" type="xs:string"/>`, "schema.xsd:1: XML syntax error on line 1: unexpected EOF"},
+		"root":      {`<shipOrder><item>title</item></shipOrder>`, "schema.xsd: offset 11: unexpected root element shipOrder, expected an XML schema document"},
+		"namespace": {`<xs:schema xmlns:xs="urn:example"/>`, "schema.xsd: offset 35: unexpected root element schema, expected an XML schema document"},
+		"panic":     {`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:complexType>`, "schema.xsd: offset 71: malformed XML schema: interface conversion: interface {} is nil, not *xgen.Element"},
+	} {
+		var buf bytes.Buffer
+		err := Generate(strings.NewReader(c.schema), &buf, Options{Lang: "Go"})
+		assert.EqualError(t, err, c.err, name)
+		_, ok := err.(*ParseError)
+		assert.True(t, ok, name)
+		assert.Empty(t, buf.String(), name)
+	}
+}
+
+// recordGenerator is a custom code generator which records the names of the
+// definitions it's called with.
+type recordGenerator struct {
+	calls []string
+}
+
+func (g *recordGenerator) record(kind, name string) string {
+	g.calls = append(g.calls, kind+" "+name)
+	return kind + " " + name + "\n"
+}
+
+func (g *recordGenerator) FileExtension() string { return ".txt" }
+
+func (g *recordGenerator) SimpleType(v *SimpleType) string { return g.record("simpleType", v.Name) }
+
+func (g *recordGenerator) ComplexType(v *ComplexType) string {
+	return g.record("complexType", v.Name)
+}
+
+func (g *recordGenerator) Attribute(v *Attribute) string { return g.record("attribute", v.Name) }
+
+func (g *recordGenerator) Element(v *Element) string { return g.record("element", v.Name) }
+
+func (g *recordGenerator) Group(v *Group) string { return g.record("group", v.Name) }
+
+func (g *recordGenerator) AttributeGroup(v *AttributeGroup) string {
+	return g.record("attributeGroup", v.Name)
+}
+
+func (g *recordGenerator) Render(field string) ([]byte, error) {
+	return []byte("# generated\n" + field), nil
+}
+
+func TestRegisterGenerator(t *testing.T) {
+	generator := &recordGenerator{}
+	RegisterGenerator("Record", generator)
+	defer RegisterGenerator("Record", nil)
+
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:simpleType name="status">
+		<xs:restriction base="xs:string"/>
+	</xs:simpleType>
+	<xs:attributeGroup name="commonAttrs">
+		<xs:attribute name="id" type="xs:string"/>
+	</xs:attributeGroup>
+	<xs:group name="nameGroup">
+		<xs:sequence>
+			<xs:element name="name" type="xs:string"/>
+		</xs:sequence>
+	</xs:group>
+	<xs:complexType name="customer">
+		<xs:sequence>
+			<xs:group ref="nameGroup"/>
+		</xs:sequence>
+		<xs:attributeGroup ref="commonAttrs"/>
+	</xs:complexType>
+	<xs:element name="title" type="xs:string"/>
+	<xs:attribute name="lang" type="xs:language"/>
+</xs:schema>`), &buf, Options{Lang: "Record"}))
+	assert.Equal(t, []string{
+		"simpleType status",
+		"attributeGroup commonAttrs",
+		"group nameGroup",
+		"complexType customer",
+		"element title",
+		"attribute lang",
+	}, generator.calls)
+	assert.Equal(t, "# generated\n"+strings.Join(generator.calls, "\n")+"\n", buf.String())
+
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	assert.NoError(t, ParseFiles([]string{filepath.Join(xsdSrcDir, "shipOrder.xsd")}, &Options{
+		OutputDir:  outputDir,
+		Lang:       "Record",
+		FileLayout: FileLayoutPerType,
+	}))
+	for _, name := range []string{"OrderStatus.txt", "ShipOrder.txt"} {
+		_, err = os.Stat(filepath.Join(outputDir, name))
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestTypeIndex(t *testing.T) {
+	files, err := GetFileList(xsdSrcDir, ".xsd", ".wsdl")
+	assert.NoError(t, err)
+	for _, file := range files {
+		parser := NewParser(&Options{
+			FilePath:            file,
+			Extract:             true,
+			Lang:                "Go",
+			IncludeMap:          make(map[string]bool),
+			LocalNameNSMap:      make(map[string]string),
+			NSSchemaLocationMap: make(map[string]string),
+			ParseFileList:       make(map[string]bool),
+			ParseFileMap:        make(map[string][]interface{}),
+			ProtoTree:           make([]interface{}, 0),
+		})
+		assert.NoError(t, parser.Parse(), file)
+		names := []string{"", "undefined", "string"}
+		for _, ele := range parser.ProtoTree {
+			switch v := ele.(type) {
+			case *SimpleType:
+				names = append(names, v.Name, v.Base)
+			case *ComplexType:
+				names = append(names, v.Name, v.Base)
+				for _, element := range v.Elements {
+					names = append(names, element.Name, element.Type)
+				}
+				for _, attribute := range v.Attributes {
+					names = append(names, attribute.Name, attribute.Type)
+				}
+			case *Element:
+				names = append(names, v.Name, v.Type)
+			case *Attribute:
+				names = append(names, v.Name, v.Type)
+			}
+		}
+		index := newTypeIndex(parser.ProtoTree)
+		for _, name := range names {
+			assert.Equal(t, getBasefromSimpleType(name, parser.ProtoTree), index.base(name), file+": "+name)
+		}
+	}
+
+	protoTree := []interface{}{
+		&SimpleType{Name: "code", List: true, Base: "int"},
+		&SimpleType{Name: "code", Base: "string"},
+		&Element{Name: "code", Type: "int"},
+		&SimpleType{Name: "amount", Union: true},
+		&Attribute{Name: "lang", Type: "language"},
+	}
+	index := newTypeIndex(protoTree)
+	for _, name := range []string{"code", "amount", "lang", "undefined"} {
+		assert.Equal(t, getBasefromSimpleType(name, protoTree), index.base(name), name)
+	}
+}
+
+// benchmarkProtoTree returns a proto tree with the simple types derived by
+// restriction of each other, as the large schemas with thousands of types.
+func benchmarkProtoTree(n int) (protoTree []interface{}, names []string) {
+	for i := 0; i < n; i++ {
+		name := fmt.Sprintf("type%d", i)
+		protoTree = append(protoTree, &SimpleType{Name: name, Base: fmt.Sprintf("type%d", i+1)})
+		names = append(names, name)
+	}
+	return
+}
+
+func BenchmarkGetBasefromSimpleType(b *testing.B) {
+	protoTree, names := benchmarkProtoTree(5000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, name := range names {
+			getBasefromSimpleType(name, protoTree)
+		}
+	}
+}
+
+func BenchmarkTypeIndex(b *testing.B) {
+	protoTree, names := benchmarkProtoTree(5000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		gen := &CodeGenerator{ProtoTree: protoTree}
+		for _, name := range names {
+			gen.getBasefromSimpleType(name)
+		}
+	}
+}
+
+func TestGenCPPRecursiveType(t *testing.T) {
+	inputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(inputDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, "tree.xsd"), []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:simpleType name="color">
+		<xs:restriction base="xs:string">
+			<xs:enumeration value="red"/>
+			<xs:enumeration value="black"/>
+		</xs:restriction>
+	</xs:simpleType>
+	<xs:complexType name="node">
+		<xs:sequence>
+			<xs:element name="label" type="xs:string"/>
+			<xs:element name="color" type="color" minOccurs="0"/>
+			<xs:element name="parent" type="node" minOccurs="0"/>
+			<xs:element name="child" type="node" minOccurs="0" maxOccurs="unbounded"/>
+			<xs:element name="annotation" type="annotation" minOccurs="0"/>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="annotation">
+		<xs:sequence>
+			<xs:element name="text" type="xs:string"/>
+			<xs:element name="target" type="node"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`), 0644))
+
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(inputDir, "tree.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "C++",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "tree.xsd.hpp"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "#ifndef TREE_XSD_HPP_\n#define TREE_XSD_HPP_\n")
+	assert.Contains(t, string(source), "#endif  // TREE_XSD_HPP_\n")
+	assert.Contains(t, string(source), "namespace schema {\n\nenum class Color;\nclass Annotation;\nclass Node;\n")
+	assert.Contains(t, string(source), "enum class Color {\n  Red,  // red\n  Black,  // black\n};\n")
+	assert.Contains(t, string(source), "class Annotation {\npublic:\n  std::string text;\n  std::shared_ptr<Node> target;\n};\n")
+	assert.Contains(t, string(source), "class Node {\npublic:\n  std::string label;\n  std::optional<Color> color;\n  std::shared_ptr<Node> parent;\n  std::vector<Node> child;\n  std::optional<Annotation> annotation;\n};\n")
+
+	compiler, err := exec.LookPath("c++")
+	if err != nil {
+		t.Skip("c++ is not available")
+	}
+	cmd := exec.Command(compiler, "-std=c++17", "-fsyntax-only", "-x", "c++", filepath.Join(outputDir, "tree.xsd.hpp"))
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(output))
+}
+
+func TestGoValidate(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "bank.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		Package:             "schema",
+		GoValidate:          true,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "bank.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "\tIdAttr     *string  `xml:\"id,attr\"`\n")
+	assert.Contains(t, string(source), "\tLegacyAttr *string  `xml:\"legacy,attr,omitempty\"`\n")
+	assert.Contains(t, string(source), "\tNoteAttr   string   `xml:\"note,attr,omitempty\"`\n")
+	assert.Contains(t, string(source), "\tCodeAttr *int                 `xml:\"code,attr\"`\n")
+	assert.Contains(t, string(source), "func (v *Bank) Validate() error {\n")
+	assert.Contains(t, string(source), "\t\tif value, ok := item.Value.(interface{ Validate() error }); ok {\n")
+}
+
+func TestGoChoice(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "choice.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		Package:             "schema",
+		GoValidate:          true,
+		GoConstructors:      true,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	for _, ele := range parser.ProtoTree {
+		if v, ok := ele.(*ComplexType); ok && v.Name == "shape" {
+			assert.Equal(t, []Choice{{Elements: []string{"circle", "square", "path"}}, {Elements: []string{"fill", "stroke"}, Optional: true}}, v.Choices)
+		}
+		if v, ok := ele.(*ComplexType); ok && v.Name == "drawing" {
+			assert.Empty(t, v.Choices)
+		}
+	}
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "choice.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "func (v *Shape) Choice() string {\n")
+	assert.Contains(t, string(source), "\t\treturn fmt.Errorf(\"shape: exactly one of the elements circle, square, path must be present\")\n")
+}
+
+func TestGoSubstitutionGroup(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "substitutionGroup.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		Package:             "schema",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	var names []string
+	for _, member := range getSubstitutionGroups(parser.ProtoTree)["animal"] {
+		names = append(names, member.Name)
+	}
+	assert.Equal(t, []string{"cat", "dog", "puppy"}, names)
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "substitutionGroup.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "\tTnsAnimal []AnimalSubstitution `xml:\",any\"`\n")
+}
+
+func TestGoAbstractType(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "abstract.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		Package:             "schema",
+		GoConstructors:      true,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	for _, ele := range parser.ProtoTree {
+		if v, ok := ele.(*ComplexType); ok {
+			assert.Equal(t, v.Name == "payment", v.Abstract, v.Name)
+		}
+	}
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "abstract.xsd.go"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(source), "func (*Payment) isPayment() {}")
+	assert.NotContains(t, string(source), "func NewPayment(")
+}
+
+func TestGoNillable(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "nillable.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		Package:             "schema",
+		GoValidate:          true,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	for _, ele := range parser.ProtoTree {
+		if v, ok := ele.(*ComplexType); ok && v.Name == "reviewer" {
+			for _, element := range v.Elements {
+				assert.True(t, element.Nillable, element.Name)
+			}
+		}
+	}
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "nillable.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "\tNickname    *XSDNillableString `xml:\"nickname\"`\n")
+	assert.Contains(t, string(source), "\tTags        []string           `xml:\"tags\"`\n")
+}
+
+func TestGoFacets(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "facets.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		Package:             "schema",
+		GoValidate:          true,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "facets.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "var patternProductCode = regexp.MustCompile(`^(?:[A-Z]{3}-\\d{4})$`)\n")
+	assert.Contains(t, string(source), "func (v Quantity) Validate() error {\n")
+	assert.Contains(t, string(source), "\tDiscountAttr *float64 `xml:\"discount,attr,omitempty\"`\n")
+	assert.NotContains(t, string(source), "func (v Color) Validate() error {\n")
+
+	// the patterns which can't be compiled are ignored with a warning.
+	var logs bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:simpleType name="latin">
+		<xs:restriction base="xs:string">
+			<xs:pattern value="\p{IsBasicLatin}+"/>
+		</xs:restriction>
+	</xs:simpleType>
+</xs:schema>`), ioutil.Discard, Options{Lang: "Go", GoValidate: true, Logger: log.New(&logs, "", 0)}))
+	assert.Contains(t, logs.String(), "xgen: schema.xsd: ignoring unsupported pattern \\p{IsBasicLatin}+\n")
+}
+
+func TestGoConstructors(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "setting.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		Package:             "schema",
+		GoConstructors:      true,
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "setting.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "func NewSetting(idAttr int, key string, options ...SettingOption) *Setting {\n")
+	assert.Contains(t, string(source), "\tv.EnabledAttr = true\n")
+	assert.Contains(t, string(source), "\tv.Retries = new(int)\n\t*v.Retries = 3\n")
+	assert.Contains(t, string(source), "\t*v.Level = Level(\"low\")\n")
+	assert.Contains(t, string(source), "func WithSettingNoteAttr(value string) SettingOption {\n")
+	assert.Contains(t, string(source), "func NewOverride(idAttr int, key string, scope string, options ...OverrideOption) *Override {\n")
+}
+
+func TestGoDocument(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(xsdSrcDir, "order.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		Package:             "schema",
+		GoDocument:          true,
+		GoDocumentEncoding:  "ISO-8859-1",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	source, err := ioutil.ReadFile(filepath.Join(outputDir, "order.xsd.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "type Document struct {\n\tXMLName xml.Name `xml:\"http://example.com/order order\"`\n\tOrderType\n}\n")
+	assert.Contains(t, string(source), "func Unmarshal(data []byte) (*Document, error) {\n")
+	assert.Contains(t, string(source), "func (v *Document) Marshal() ([]byte, error) {\n")
+}
+
+func TestDocumentation(t *testing.T) {
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:simpleType name="grade">
+		<xs:annotation>
+			<xs:documentation>The grade of a course,
+				from A to F.</xs:documentation>
+		</xs:annotation>
+		<xs:restriction base="xs:string"/>
+	</xs:simpleType>
+	<xs:complexType name="course">
+		<xs:annotation>
+			<xs:appinfo>ignored</xs:appinfo>
+			<xs:documentation>A course of the <b>curriculum</b>.</xs:documentation>
+			<xs:documentation>
+
+				Courses are listed by title.
+			</xs:documentation>
+		</xs:annotation>
+		<xs:sequence>
+			<xs:element name="title" type="xs:string">
+				<xs:annotation>
+					<xs:documentation>The title of the course.</xs:documentation>
+				</xs:annotation>
+			</xs:element>
+		</xs:sequence>
+		<xs:attribute name="code" type="xs:string">
+			<xs:annotation>
+				<xs:documentation>The code of the course.</xs:documentation>
+			</xs:annotation>
+		</xs:attribute>
+	</xs:complexType>
+</xs:schema>`
+	for lang, expected := range map[string][]string{
+		"Go": {
+			"// Grade ...\n//\n// The grade of a course,\n// from A to F.\ntype Grade string",
+			"// Course ...\n//\n// A course of the curriculum.\n//\n// Courses are listed by title.\ntype Course struct {",
+			"\t// The code of the course.\n\tCodeAttr string",
+			"\t// The title of the course.\n\tTitle string",
+		},
+		"TypeScript": {
+			"/**\n * A course of the curriculum.\n *\n * Courses are listed by title.\n */\nexport class Course {",
+			"  /**\n   * The title of the course.\n   */\n  Title:",
+		},
+		"Java": {
+			"/**\n * The grade of a course,\n * from A to F.\n */\n",
+			"    /**\n     * The code of the course.\n     */\n    @XmlAttribute(name = \"code\"",
+		},
+		"Rust": {
+			"/// A course of the curriculum.\n///\n/// Courses are listed by title.\n#[derive(Debug, Serialize, Deserialize)]\nstruct Course {",
+			"    /// The title of the course.\n    #[serde(rename = \"title\")]",
+		},
+	} {
+		var buf bytes.Buffer
+		assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: lang}))
+		for _, snippet := range expected {
+			assert.Contains(t, buf.String(), snippet, lang)
+		}
+	}
+}
+
+func TestAppinfo(t *testing.T) {
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:xgen="https://github.com/xuri/xgen" xmlns:sch="http://purl.oclc.org/dsdl/schematron">
+	<xs:simpleType name="isbn">
+		<xs:annotation>
+			<xs:appinfo>
+				<sch:pattern value="[0-9]+"/>
+			</xs:appinfo>
+		</xs:annotation>
+		<xs:restriction base="xs:string"/>
+	</xs:simpleType>
+	<xs:complexType name="volume">
+		<xs:sequence>
+			<xs:element name="isbn" type="isbn">
+				<xs:annotation>
+					<xs:appinfo>
+						<xgen:tag>json:"isbn"</xgen:tag>
+						<sch:rule context="isbn"><sch:assert test="string-length(.) = 13"/></sch:rule>
+					</xs:appinfo>
+				</xs:annotation>
+			</xs:element>
+		</xs:sequence>
+		<xs:attribute name="shelf" type="xs:string">
+			<xs:annotation>
+				<xs:appinfo><xgen:tag>json:"shelf,omitempty"</xgen:tag></xs:appinfo>
+			</xs:annotation>
+		</xs:attribute>
+	</xs:complexType>
+</xs:schema>`
+	parser := NewParser(&Options{
+		FilePath:            "appinfo.xsd",
+		Extract:             true,
+		Lang:                "Go",
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.parse(strings.NewReader(schema)))
+	for _, ele := range parser.ProtoTree {
+		switch v := ele.(type) {
+		case *SimpleType:
+			assert.Nil(t, v.Restriction.Pattern)
+		case *ComplexType:
+			assert.Equal(t, []Hint{
+				{Space: xgenNamespace, Name: "tag", Value: `json:"isbn"`},
+				{Space: "http://purl.oclc.org/dsdl/schematron", Name: "rule"},
+			}, v.Elements[0].Hints)
+			assert.Equal(t, []Hint{{Space: xgenNamespace, Name: "tag", Value: `json:"shelf,omitempty"`}}, v.Attributes[0].Hints)
+		}
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go"}))
+	assert.Contains(t, buf.String(), "\tShelfAttr string   `xml:\"shelf,attr,omitempty\" json:\"shelf,omitempty\"`\n\tIsbn      string   `xml:\"isbn\" json:\"isbn\"`\n")
+}
+
+func TestImportChain(t *testing.T) {
+	inputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(inputDir)
+	for file, schema := range map[string]string{
+		"main.xsd": `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:s="http://example.com/shipping">
+	<xs:import namespace="http://www.w3.org/XML/1998/namespace" schemaLocation="http://www.w3.org/2001/xml.xsd"/>
+	<xs:import namespace="http://example.com/shipping" schemaLocation="shipping/shipping.xsd"/>
+	<xs:import namespace="http://example.com/audit" schemaLocation="./audit.xsd"/>
+	<xs:complexType name="shipment">
+		<xs:sequence>
+			<xs:element name="parcel" type="s:parcel"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`,
+		"audit.xsd": `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:u="http://example.com/units" targetNamespace="http://example.com/audit">
+	<xs:import namespace="http://example.com/units" schemaLocation="units/units.xsd"/>
+	<xs:complexType name="auditRecord">
+		<xs:sequence>
+			<xs:element name="measure" type="u:measure"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`,
+		filepath.Join("shipping", "shipping.xsd"): `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:s="http://example.com/shipping" xmlns:u="http://example.com/units" targetNamespace="http://example.com/shipping">
+	<xs:import namespace="http://example.com/units" schemaLocation="../units/units.xsd"/>
+	<xs:include schemaLocation="carrier.xsd"/>
+	<xs:complexType name="parcel">
+		<xs:sequence>
+			<xs:element name="weight" type="u:weight"/>
+			<xs:element name="carrier" type="s:carrier"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`,
+		filepath.Join("shipping", "carrier.xsd"): `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/shipping">
+	<xs:complexType name="carrier">
+		<xs:attribute name="code" type="xs:string"/>
+	</xs:complexType>
+</xs:schema>`,
+		filepath.Join("units", "units.xsd"): `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/units">
+	<xs:simpleType name="weight">
+		<xs:restriction base="xs:decimal"/>
+	</xs:simpleType>
+	<xs:complexType name="measure">
+		<xs:attribute name="unit" type="xs:string"/>
+	</xs:complexType>
+</xs:schema>`,
+	} {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(inputDir, file)), 0755))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(inputDir, file), []byte(schema), 0644))
+	}
+
+	outputDir, err := ioutil.TempDir("", "xgen")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+	var logs bytes.Buffer
+	parser := NewParser(&Options{
+		FilePath:            filepath.Join(inputDir, "main.xsd"),
+		OutputDir:           outputDir,
+		Lang:                "Go",
+		Package:             "schema",
+		Logger:              log.New(&logs, "", 0),
+		IncludeMap:          make(map[string]bool),
+		LocalNameNSMap:      make(map[string]string),
+		NSSchemaLocationMap: make(map[string]string),
+		ParseFileList:       make(map[string]bool),
+		ParseFileMap:        make(map[string][]interface{}),
+		ProtoTree:           make([]interface{}, 0),
+	})
+	assert.NoError(t, parser.Parse())
+	for file, declaration := range map[string]string{
+		"main.xsd.go":     "type Shipment struct {\n",
+		"shipping.xsd.go": "type Parcel struct {\n",
+		"carrier.xsd.go":  "type Carrier struct {\n",
+		"units.xsd.go":    "type Measure struct {\n",
+		"audit.xsd.go":    "type AuditRecord struct {\n",
+	} {
+		source, err := ioutil.ReadFile(filepath.Join(outputDir, file))
+		assert.NoError(t, err)
+		assert.Contains(t, string(source), declaration)
+	}
+	assert.Equal(t, 1, strings.Count(logs.String(), "generating Go code for "+filepath.Join(inputDir, "units", "units.xsd")+"\n"))
+	assert.NotContains(t, logs.String(), "parsing http://www.w3.org/2001/xml.xsd")
+}
+
+func TestRecursiveTypes(t *testing.T) {
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:complexType name="node">
+		<xs:sequence>
+			<xs:element name="label" type="xs:string"/>
+			<xs:element name="parent" type="node" minOccurs="0"/>
+			<xs:element name="child" type="node" minOccurs="0" maxOccurs="unbounded"/>
+			<xs:element name="branch" type="branch"/>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:complexType name="branch">
+		<xs:sequence>
+			<xs:group ref="leaves"/>
+		</xs:sequence>
+	</xs:complexType>
+	<xs:group name="leaves">
+		<xs:sequence>
+			<xs:element name="root" type="node"/>
+			<xs:element name="label" type="xs:string"/>
+		</xs:sequence>
+	</xs:group>
+</xs:schema>`
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go"}))
+	assert.Contains(t, buf.String(), "\tParent  *Node    `xml:\"parent,omitempty\"`\n\tChild   []*Node  `xml:\"child\"`\n\tBranch  *Branch  `xml:\"branch\"`\n")
+
+	buf.Reset()
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Rust"}))
+	source := buf.String()
+	assert.Contains(t, source, "    pub Parent: Option<Box<Node>>,\n")
+	assert.Contains(t, source, "    pub Child: Vec<Node>,\n")
+	assert.Contains(t, source, "    pub Branch: Box<Branch>,\n")
+	assert.Contains(t, source, "    pub Root: Box<Node>,\n")
+	assert.Contains(t, source, "    pub Label: char,\n")
+}
+
+func TestGoOptionalPointers(t *testing.T) {
+	schema := `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:complexType name="reading">
+		<xs:sequence>
+			<xs:element name="value" type="xs:int"/>
+			<xs:element name="offset" type="xs:int" minOccurs="0"/>
+			<xs:group ref="calibration"/>
+		</xs:sequence>
+		<xs:attribute name="sensor" type="xs:string" use="required"/>
+		<xs:attribute name="verified" type="xs:boolean"/>
+		<xs:attributeGroup ref="timing"/>
+	</xs:complexType>
+	<xs:group name="calibration">
+		<xs:sequence>
+			<xs:element name="scale" type="xs:double" minOccurs="0"/>
+			<xs:element name="step" type="xs:int" minOccurs="0" maxOccurs="unbounded"/>
+		</xs:sequence>
+	</xs:group>
+	<xs:attributeGroup name="timing">
+		<xs:attribute name="interval" type="xs:int"/>
+	</xs:attributeGroup>
+</xs:schema>`
+	var buf bytes.Buffer
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go"}))
+	source := buf.String()
+	assert.Contains(t, source, "\tVerifiedAttr bool     `xml:\"verified,attr,omitempty\"`\n\tIntervalAttr int      `xml:\"interval,attr,omitempty\"`\n")
+	assert.Contains(t, source, "\tScale   float64  `xml:\"scale,omitempty\"`\n")
+
+	buf.Reset()
+	assert.NoError(t, Generate(strings.NewReader(schema), &buf, Options{Lang: "Go", GoOptionalPointers: true}))
+	source = buf.String()
+	assert.Contains(t, source, "\tSensorAttr   string   `xml:\"sensor,attr\"`\n\tVerifiedAttr *bool    `xml:\"verified,attr,omitempty\"`\n\tIntervalAttr *int     `xml:\"interval,attr,omitempty\"`\n")
+	assert.Contains(t, source, "\tValue        int      `xml:\"value\"`\n\tOffset       *int     `xml:\"offset,omitempty\"`\n")
+	assert.Contains(t, source, "\tScale   *float64 `xml:\"scale,omitempty\"`\n\tStep    []int\n")
+	assert.Contains(t, source, "\tXMLName      xml.Name `xml:\"timing\"`\n\tIntervalAttr *int     `xml:\"interval,attr,omitempty\"`\n")
+}
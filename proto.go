@@ -11,16 +11,20 @@ package xgen
 import "regexp"
 
 // SimpleType definitions provide for constraining character information item
-// [children] of element and attribute information items.
+// [children] of element and attribute information items. The Members are
+// the names of the member types of the union in the declared order, in which
+// the values are tried against them.
 // https://www.w3.org/TR/xmlschema-1/#Simple_Type_Definitions
 type SimpleType struct {
 	Doc         string
 	Name        string
 	Base        string
 	Anonymous   bool
+	Final       string
 	List        bool
 	Union       bool
 	MemberTypes map[string]string
+	Members     []string
 	Restriction Restriction
 }
 
@@ -32,15 +36,48 @@ type SimpleType struct {
 // mechanism of element substitution groups.
 // https://www.w3.org/TR/xmlschema-1/#cElement_Declarations
 type Element struct {
-	Doc      string
+	Doc               string
+	Name              string
+	Wildcard          bool
+	Type              string
+	Namespace         string
+	Abstract          bool
+	SubstitutionGroup string
+	Plural            bool
+	Optional          bool
+	Nillable          bool
+	Default           string
+	Fixed             bool
+	Block             string
+	Final             string
+	Constraints       []IdentityConstraint
+	Hints             []Hint
+}
+
+// IdentityConstraint definitions provide for uniqueness and reference
+// constraint relationships among the values of the elements and attributes
+// selected by the XPath expressions relative to the element declaring them.
+// The Kind is one of key, keyref and unique, and the Refer names the key or
+// unique constraint referenced by the keyref.
+// https://www.w3.org/TR/xmlschema-1/#cIdentity-constraint_Definitions
+type IdentityConstraint struct {
 	Name     string
-	Wildcard bool
-	Type     string
-	Abstract bool
-	Plural   bool
-	Optional bool
-	Nillable bool
-	Default  string
+	Kind     string
+	Refer    string
+	Selector string
+	Fields   []string
+}
+
+// Hint is the customization given by the child element of the appinfo in the
+// annotation of the element or attribute declaration, which is identified by
+// the namespace and local name of the child element, and the Value is the
+// text content of it. The hints in the xgen namespace are applied by the
+// generators, others are kept for the users of the parser.
+// https://www.w3.org/TR/xmlschema-1/#cAnnotations
+type Hint struct {
+	Space string
+	Name  string
+	Value string
 }
 
 // Attribute declarations provide for: Local validation of attribute
@@ -48,12 +85,16 @@ type Element struct {
 // or fixed values for attribute information items.
 // https://www.w3.org/TR/xmlschema-1/structures.html#element-attribute
 type Attribute struct {
-	Name     string
-	Doc      string
-	Type     string
-	Plural   bool
-	Default  string
-	Optional bool
+	Name       string
+	Doc        string
+	Type       string
+	Namespace  string
+	Plural     bool
+	Default    string
+	Fixed      bool
+	Optional   bool
+	Prohibited bool
+	Hints      []Hint
 }
 
 // ComplexType definitions are identified by their {name} and {target
@@ -65,18 +106,40 @@ type Attribute struct {
 // namespace}s are provided for reference from instances, and for use in the
 // XML representation of schema components (specifically in <element>). See
 // References to schema components across namespaces for the use of component
-// identifiers when importing one schema into another.
+// identifiers when importing one schema into another. The Assertions are
+// the XPath expressions of the XML schema 1.1 asserts on the content. The
+// Namespace qualifies the name of the global element declaring the
+// anonymous complex type.
 // https://www.w3.org/TR/xmlschema-1/structures.html#element-complexType
 type ComplexType struct {
 	Doc            string
 	Name           string
+	Namespace      string
 	Base           string
 	Anonymous      bool
+	Abstract       bool
 	Elements       []Element
 	Attributes     []Attribute
 	Groups         []Group
 	AttributeGroup []AttributeGroup
 	Mixed          bool
+	AnyAttribute   bool
+	Extension      bool
+	Block          string
+	Final          string
+	Choices        []Choice
+	Assertions     []string
+}
+
+// Choice is the model group of the complex type in which only one of the
+// elements is present, the Elements are the names of them, and the choice
+// may be absent if it's Optional. The elements of the choice are declared as
+// optional, the choices which may repeat are declared as the repeating
+// elements instead.
+// https://www.w3.org/TR/xmlschema-1/#element-choice
+type Choice struct {
+	Elements []string
+	Optional bool
 }
 
 // Group (model group) definitions are provided primarily for reference from
@@ -90,6 +153,7 @@ type Group struct {
 	Elements []Element
 	Groups   []Group
 	Plural   bool
+	Optional bool
 	Ref      string
 }
 
@@ -103,20 +167,29 @@ type Group struct {
 // <attributeGroup>).
 // https://www.w3.org/TR/xmlschema-1/structures.html#Attribute_Group_Definition
 type AttributeGroup struct {
-	Doc        string
-	Name       string
-	Ref        string
-	Attributes []Attribute
+	Doc            string
+	Name           string
+	Ref            string
+	Attributes     []Attribute
+	AttributeGroup []AttributeGroup
 }
 
 // Restriction are used to define acceptable values for XML elements or
-// attributes. Restriction on XML elements are called facets.
+// attributes. Restriction on XML elements are called facets. The bounds and
+// the maximum length are valid only if they're declared by the facets, the
+// pattern matches the whole value, and the Assertions are the XPath
+// expressions of the XML schema 1.1 assertion facets.
 // https://www.w3.org/TR/xmlschema-1/structures.html#element-restriction
 type Restriction struct {
-	Doc                  string
-	Precision            int
-	Enum                 []string
-	Min, Max             float64
-	MinLength, MaxLength int
-	Pattern              *regexp.Regexp
+	Doc                        string
+	Precision                  int
+	Enum                       []string
+	Min, Max                   float64
+	HasMin, HasMax             bool
+	MinExclusive, MaxExclusive bool
+	MinLength, MaxLength       int
+	HasMaxLength               bool
+	Pattern                    *regexp.Regexp
+	WhiteSpace                 string
+	Assertions                 []string
 }
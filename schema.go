@@ -8,17 +8,108 @@
 
 package xgen
 
-import "encoding/xml"
+import (
+	"bytes"
+	"encoding/xml"
+	"strconv"
+
+	"golang.org/x/net/html/charset"
+)
+
+// xsdNamespace is the namespace name of the XML schema elements.
+const xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+
+// xmlNamespace is the namespace name bound to the xml prefix by definition,
+// which declares the attributes such as xml:lang.
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// versioningNamespace is the namespace name of the conditional inclusion
+// attributes defined by XML schema 1.1, such as vc:minVersion.
+const versioningNamespace = "http://www.w3.org/2007/XMLSchema-versioning"
+
+// xgenNamespace is the namespace name of the customization hints in the
+// appinfo of the annotations, which are applied by the generators.
+const xgenNamespace = "https://github.com/xuri/xgen"
+
+// xsdVersion is the version of XML schema processed by the parser, which is
+// compared with the vc:minVersion and vc:maxVersion attributes.
+const xsdVersion = 1.1
+
+// isExcludedByVersion reports whether the element and its descendants should
+// be ignored by the conditional inclusion attributes, the element is
+// excluded if vc:minVersion is greater than the processed version or
+// vc:maxVersion is not greater than it.
+func isExcludedByVersion(element xml.StartElement) bool {
+	for _, attr := range element.Attr {
+		if attr.Name.Space != versioningNamespace {
+			continue
+		}
+		version, err := strconv.ParseFloat(attr.Value, 64)
+		if err != nil {
+			continue
+		}
+		if attr.Name.Local == "minVersion" && version > xsdVersion ||
+			attr.Name.Local == "maxVersion" && version <= xsdVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// isSchemaRoot reports whether the element is allowed as the root element of
+// the document, which must be the schema element in the XML schema
+// namespace, or the definitions (WSDL 1.1) or description (WSDL 2.0) element
+// for the WSDL documents.
+func isSchemaRoot(element xml.StartElement, wsdl bool) bool {
+	if wsdl {
+		return element.Name.Local == "definitions" || element.Name.Local == "description"
+	}
+	return element.Name.Space == xsdNamespace && element.Name.Local == "schema"
+}
+
+// schemaTargetNamespace returns the target namespace of the XML schema
+// document, ok is false if the root element of the document isn't the schema
+// element, such as the WSDL documents.
+func schemaTargetNamespace(body []byte) (ns string, ok bool) {
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	decoder.CharsetReader = charset.NewReaderLabel
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return
+		}
+		element, isStart := token.(xml.StartElement)
+		if !isStart {
+			continue
+		}
+		if !isSchemaRoot(element, false) {
+			return
+		}
+		for _, attr := range element.Attr {
+			if attr.Name.Space == "" && attr.Name.Local == "targetNamespace" {
+				return attr.Value, true
+			}
+		}
+		return "", true
+	}
+}
 
 func (opt *Options) prepareLocalNameNSMap(element xml.StartElement) {
 	for _, ele := range element.Attr {
 		if ele.Name.Space == "xmlns" {
 			opt.LocalNameNSMap[ele.Name.Local] = ele.Value
 		}
+		if ele.Name.Space == "" && ele.Name.Local == "xmlns" {
+			opt.LocalNameNSMap[""] = ele.Value
+		}
 	}
 	return
 }
 
+// prepareNSSchemaLocationMap records the location of the schema imported by
+// the import element, which is resolved against the location of the
+// document. The schemas for the XML and the XML schema namespaces are not
+// generated, since their definitions are built in.
 func (opt *Options) prepareNSSchemaLocationMap(element xml.StartElement) {
 	var currentNS string
 	for _, ele := range element.Attr {
@@ -26,23 +117,50 @@ func (opt *Options) prepareNSSchemaLocationMap(element xml.StartElement) {
 			currentNS = ele.Value
 		}
 		if ele.Name.Local == "schemaLocation" {
-			if _, ok := opt.NSSchemaLocationMap[currentNS]; ok {
-				continue
+			location := opt.locateSchema(opt.FilePath, ele.Value)
+			if currentNS != xmlNamespace && currentNS != xsdNamespace {
+				opt.dependencies = append(opt.dependencies, location)
 			}
-			if isValidURL(ele.Value) {
+			if _, ok := opt.NSSchemaLocationMap[currentNS]; ok {
 				continue
-				// TODO: fetch remote schema
-				// var err error
-				// if opt.RemoteSchema[ele.Value], err = fetchSchema(ele.Value); err != nil {
-				// 	continue
-				// }
 			}
-			opt.NSSchemaLocationMap[currentNS] = ele.Value
+			opt.NSSchemaLocationMap[currentNS] = location
+			opt.logf("import %s from %s", currentNS, location)
 		}
 	}
 	return
 }
 
+// parseNS returns the namespace name of the QName by the namespace prefix,
+// the unprefixed QName is in the default namespace.
 func (opt *Options) parseNS(str string) (ns string) {
+	if getNSPrefix(str) == "xml" {
+		return xmlNamespace
+	}
 	return opt.LocalNameNSMap[getNSPrefix(str)]
 }
+
+// declNamespace returns the namespace qualifying the name of the element or
+// attribute declaration in the instances, which is the namespace of the
+// referenced declaration for the references, and the target namespace for
+// the global declarations and the qualified local declarations. The local
+// declarations take the default form of the schema unless they override it
+// by the form attribute, an empty string is returned if they are
+// unqualified.
+func (opt *Options) declNamespace(ref, form, formDefault string, global bool) string {
+	if ref != "" {
+		// the schemas declaring the XML schema namespace as the default
+		// namespace refer to their own declarations without the prefix.
+		if ns := opt.parseNS(ref); ns != xsdNamespace {
+			return ns
+		}
+		return opt.TargetNamespace
+	}
+	if form == "" {
+		form = formDefault
+	}
+	if global || form == "qualified" {
+		return opt.TargetNamespace
+	}
+	return ""
+}
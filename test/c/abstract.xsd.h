@@ -0,0 +1,31 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef ABSTRACT_XSD_H_
+#define ABSTRACT_XSD_H_
+
+typedef struct Payment Payment;
+typedef struct CardPayment CardPayment;
+typedef struct CashPayment CashPayment;
+typedef struct Invoice Invoice;
+
+struct Payment {
+	float Amount;
+};
+
+struct CardPayment {
+	char CardNumber;
+};
+
+struct CashPayment {
+	char CurrencyAttr; // attr, optional
+};
+
+struct Invoice {
+	Payment *Payment;
+};
+
+#endif /* ABSTRACT_XSD_H_ */
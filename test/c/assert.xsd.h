@@ -0,0 +1,29 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef ASSERT_XSD_H_
+#define ASSERT_XSD_H_
+
+typedef struct TemperatureRange TemperatureRange;
+typedef struct Reading Reading;
+
+struct TemperatureRange {
+	int Low;
+	int High;
+};
+
+typedef int EvenNumber;
+
+struct Reading {
+	char UnitAttr; // attr, optional
+	float Value;
+};
+
+typedef Reading Sensor;
+
+typedef TemperatureRange Measurement;
+
+#endif /* ASSERT_XSD_H_ */
@@ -0,0 +1,33 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef ATTRIBUTEGROUP_XSD_H_
+#define ATTRIBUTEGROUP_XSD_H_
+
+typedef struct Product Product;
+typedef struct ProductAttrs ProductAttrs;
+typedef struct CommonAttrs CommonAttrs;
+
+struct Product {
+	float PriceAttr; // attr, optional
+	char SkuAttr; // attr
+	char IdAttr; // attr
+	char LangAttr; // attr, optional
+	char Title;
+};
+
+struct ProductAttrs {
+	char SkuAttr; // attr
+	char IdAttr; // attr
+	char LangAttr; // attr, optional
+};
+
+struct CommonAttrs {
+	char IdAttr; // attr
+	char LangAttr; // attr, optional
+};
+
+#endif /* ATTRIBUTEGROUP_XSD_H_ */
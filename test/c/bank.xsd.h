@@ -0,0 +1,31 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef BANK_XSD_H_
+#define BANK_XSD_H_
+
+typedef struct BankAccount BankAccount;
+typedef struct PremiumAccount PremiumAccount;
+typedef struct Bank Bank;
+
+struct BankAccount {
+	char IdAttr; // attr
+	char LegacyAttr; // attr, optional
+	char NoteAttr; // attr, optional
+	char UnitAttr; // attr, optional
+};
+
+struct PremiumAccount {
+	char TierAttr; // attr
+};
+
+struct Bank {
+	int CodeAttr; // attr
+	BankAccount *Account;
+	int Iso;
+};
+
+#endif /* BANK_XSD_H_ */
@@ -4,21 +4,29 @@
 //
 // Use of this source code is governed by a BSD-style license that can be
 // found in the LICENSE file.
+#ifndef BASE64_XSD_H_
+#define BASE64_XSD_H_
+
+typedef struct MyType2 MyType2;
+typedef struct MyType3 MyType3;
+typedef struct MyType4 MyType4;
 
 typedef char MyType1[];
 
-typedef struct {
+struct MyType2 {
 	int LengthAttr; // attr, optional
-} MyType2;
+};
 
-typedef struct {
+struct MyType3 {
 	int LengthAttr; // attr, optional
-} MyType3;
+};
 
-typedef struct {
+struct MyType4 {
 	char Title;
-	char Blob[];
+	char *Blob;
 	char Timestamp;
-} MyType4;
+};
 
 typedef char MyType5;
+
+#endif /* BASE64_XSD_H_ */
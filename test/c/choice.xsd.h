@@ -0,0 +1,37 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef CHOICE_XSD_H_
+#define CHOICE_XSD_H_
+
+typedef struct Circle Circle;
+typedef struct Square Square;
+typedef struct Shape Shape;
+typedef struct Drawing Drawing;
+
+struct Circle {
+	float RadiusAttr; // attr, optional
+};
+
+struct Square {
+	float SideAttr; // attr, optional
+};
+
+struct Shape {
+	char Label;
+	Circle *Circle;
+	Square *Square;
+	char Path;
+	char Fill;
+	int Stroke;
+};
+
+struct Drawing {
+	Shape *Shape;
+	char *Text;
+};
+
+#endif /* CHOICE_XSD_H_ */
@@ -0,0 +1,43 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef EXTENSION_XSD_H_
+#define EXTENSION_XSD_H_
+
+typedef struct Vehicle Vehicle;
+typedef struct Car Car;
+typedef struct SportsCar SportsCar;
+typedef struct CompactCar CompactCar;
+typedef struct Garage Garage;
+
+struct Vehicle {
+	char VinAttr; // attr
+	char Make;
+	int Year;
+};
+
+struct Car {
+	int Doors;
+	char Model;
+};
+
+struct SportsCar {
+	int TopSpeed;
+};
+
+struct CompactCar {
+	char VinAttr; // attr
+	char Make;
+	int Year;
+	int Doors;
+	char Model;
+};
+
+struct Garage {
+	Vehicle *Vehicle;
+};
+
+#endif /* EXTENSION_XSD_H_ */
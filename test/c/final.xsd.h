@@ -0,0 +1,31 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef FINAL_XSD_H_
+#define FINAL_XSD_H_
+
+typedef struct Account Account;
+typedef struct SavingsAccount SavingsAccount;
+
+// final="#all": derivation is prohibited
+typedef char AccountNumber;
+
+// final="restriction": derivation by restriction is prohibited
+// block="extension": substitution by extension is blocked
+struct Account {
+	char Number;
+	float Balance;
+};
+
+struct SavingsAccount {
+	float Rate;
+};
+
+// final="extension": derivation by extension is prohibited
+// block="restriction substitution": substitution by restriction or substitution group members is blocked
+typedef Account PrimaryAccount;
+
+#endif /* FINAL_XSD_H_ */
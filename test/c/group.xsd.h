@@ -0,0 +1,39 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef GROUP_XSD_H_
+#define GROUP_XSD_H_
+
+typedef struct Customer Customer;
+typedef struct Supplier Supplier;
+typedef struct PersonGroup PersonGroup;
+typedef struct ContactGroup ContactGroup;
+
+struct Customer {
+	char CustomerId;
+	char FirstName;
+	char LastName;
+	char *Email;
+};
+
+struct Supplier {
+	char Company;
+	char FirstName;
+	char LastName;
+	char *Email;
+};
+
+struct PersonGroup {
+	char FirstName;
+	char LastName;
+	char *Email;
+};
+
+struct ContactGroup {
+	char Email;
+};
+
+#endif /* GROUP_XSD_H_ */
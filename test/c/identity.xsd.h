@@ -0,0 +1,29 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef IDENTITY_XSD_H_
+#define IDENTITY_XSD_H_
+
+typedef struct Part Part;
+typedef struct InventoryBin InventoryBin;
+typedef struct Inventory Inventory;
+
+struct Part {
+	char SkuAttr; // attr
+	char Serial;
+};
+
+struct InventoryBin {
+	char CodeAttr; // attr, optional
+	char SkuAttr; // attr, optional
+};
+
+struct Inventory {
+	Part *Part;
+	InventoryBin *Bin;
+};
+
+#endif /* IDENTITY_XSD_H_ */
@@ -0,0 +1,22 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef LOCALIZED_XSD_H_
+#define LOCALIZED_XSD_H_
+
+typedef struct BookTitle BookTitle;
+typedef struct Book Book;
+
+struct BookTitle {
+	char XmlLangAttr; // attr, optional
+};
+
+struct Book {
+	BookTitle *Title;
+	char Isbn;
+};
+
+#endif /* LOCALIZED_XSD_H_ */
@@ -0,0 +1,25 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef PLAYLIST_XSD_H_
+#define PLAYLIST_XSD_H_
+
+#include <stdbool.h>
+
+typedef struct Playlist Playlist;
+
+typedef char Genre;
+
+struct Playlist {
+	int IdAttr; // attr
+	bool SharedAttr; // attr, optional
+	char Title;
+	char Genre;
+	char *Track;
+	float Rating;
+};
+
+#endif /* PLAYLIST_XSD_H_ */
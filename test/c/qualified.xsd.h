@@ -0,0 +1,24 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef QUALIFIED_XSD_H_
+#define QUALIFIED_XSD_H_
+
+typedef struct QualifiedContact QualifiedContact;
+typedef struct QualifiedCard QualifiedCard;
+
+struct QualifiedContact {
+	int IdAttr; // attr, optional
+	char TierAttr; // attr, optional
+	char Name;
+	char Note;
+};
+
+struct QualifiedCard {
+	char Holder;
+};
+
+#endif /* QUALIFIED_XSD_H_ */
@@ -0,0 +1,30 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef SETTING_XSD_H_
+#define SETTING_XSD_H_
+
+#include <stdbool.h>
+
+typedef struct Setting Setting;
+typedef struct Override Override;
+
+typedef char Level;
+
+struct Setting {
+	int IdAttr; // attr
+	bool EnabledAttr; // attr, optional
+	char NoteAttr; // attr, optional
+	char Key;
+	int Retries;
+	char Level;
+};
+
+struct Override {
+	char Scope;
+};
+
+#endif /* SETTING_XSD_H_ */
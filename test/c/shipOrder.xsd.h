@@ -0,0 +1,23 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef SHIPORDER_XSD_H_
+#define SHIPORDER_XSD_H_
+
+typedef struct ShipOrder ShipOrder;
+
+typedef char OrderStatus;
+
+struct ShipOrder {
+	char OrderidAttr; // attr
+	int PriorityAttr; // attr, optional
+	char OrderPerson;
+	char Note;
+	char *Item;
+	char Status;
+};
+
+#endif /* SHIPORDER_XSD_H_ */
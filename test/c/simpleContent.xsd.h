@@ -0,0 +1,28 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef SIMPLECONTENT_XSD_H_
+#define SIMPLECONTENT_XSD_H_
+
+typedef struct Price Price;
+typedef struct DiscountPrice DiscountPrice;
+typedef struct LocalPrice LocalPrice;
+
+typedef float AmountType;
+
+struct Price {
+	char CurrencyAttr; // attr
+};
+
+struct DiscountPrice {
+	int DiscountAttr; // attr, optional
+};
+
+struct LocalPrice {
+	char CurrencyAttr; // attr, optional
+};
+
+#endif /* SIMPLECONTENT_XSD_H_ */
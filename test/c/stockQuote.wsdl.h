@@ -0,0 +1,30 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef STOCKQUOTE_WSDL_H_
+#define STOCKQUOTE_WSDL_H_
+
+typedef struct Money Money;
+typedef struct TradePriceRequest TradePriceRequest;
+typedef struct TradePrice TradePrice;
+
+typedef char TickerSymbol;
+
+struct Money {
+	float Amount;
+	char Currency;
+};
+
+struct TradePriceRequest {
+	char TickerSymbol;
+};
+
+struct TradePrice {
+	char TickerSymbol;
+	Money *Price;
+};
+
+#endif /* STOCKQUOTE_WSDL_H_ */
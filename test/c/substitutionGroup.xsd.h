@@ -0,0 +1,42 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef SUBSTITUTIONGROUP_XSD_H_
+#define SUBSTITUTIONGROUP_XSD_H_
+
+typedef struct AnimalType AnimalType;
+typedef struct CatType CatType;
+typedef struct DogType DogType;
+typedef struct ZooType ZooType;
+
+struct AnimalType {
+	char IdAttr; // attr, optional
+};
+
+struct CatType {
+	int LivesAttr; // attr, optional
+};
+
+struct DogType {
+	char BreedAttr; // attr, optional
+};
+
+typedef AnimalType Animal;
+
+typedef CatType Cat;
+
+typedef DogType Dog;
+
+typedef DogType Puppy;
+
+struct ZooType {
+	char Name;
+	AnimalType *TnsAnimal;
+};
+
+typedef ZooType Zoo;
+
+#endif /* SUBSTITUTIONGROUP_XSD_H_ */
@@ -0,0 +1,35 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef UNION_XSD_H_
+#define UNION_XSD_H_
+
+#include <stdbool.h>
+
+typedef struct Dimension Dimension;
+typedef struct TagValue TagValue;
+typedef struct Crate Crate;
+
+typedef char DimensionToken;
+
+struct Dimension {
+	int Int;
+	char DimensionToken;
+	char GMonth;
+};
+
+struct TagValue {
+	bool Boolean;
+	char String;
+	float Decimal;
+};
+
+struct Crate {
+	TagValue *TagAttr; // attr, optional
+	Dimension *Dimension;
+};
+
+#endif /* UNION_XSD_H_ */
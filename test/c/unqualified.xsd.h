@@ -0,0 +1,19 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef UNQUALIFIED_XSD_H_
+#define UNQUALIFIED_XSD_H_
+
+typedef struct UnqualifiedContact UnqualifiedContact;
+
+struct UnqualifiedContact {
+	int IdAttr; // attr, optional
+	char TierAttr; // attr, optional
+	char Name;
+	char Note;
+};
+
+#endif /* UNQUALIFIED_XSD_H_ */
@@ -0,0 +1,25 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef WHITESPACE_XSD_H_
+#define WHITESPACE_XSD_H_
+
+typedef struct Caption Caption;
+
+typedef char CollapsedCode;
+
+typedef char ReplacedText;
+
+typedef char TokenSize;
+
+struct Caption {
+	char LangAttr; // attr, optional
+	char Code;
+	char Text;
+	char Size;
+};
+
+#endif /* WHITESPACE_XSD_H_ */
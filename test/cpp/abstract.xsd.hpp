@@ -0,0 +1,43 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef ABSTRACT_XSD_HPP_
+#define ABSTRACT_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <vector>
+
+namespace schema {
+
+class Payment;
+class CardPayment;
+class CashPayment;
+class Invoice;
+
+class Payment {
+public:
+  double amount;
+};
+
+class CardPayment : public Payment {
+public:
+  std::string cardNumber;
+};
+
+class CashPayment : public Payment {
+public:
+  std::optional<std::string> currencyAttr;
+};
+
+class Invoice {
+public:
+  std::vector<Payment> payment;
+};
+
+}  // namespace schema
+
+#endif  // ABSTRACT_XSD_HPP_
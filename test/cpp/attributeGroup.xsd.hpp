@@ -0,0 +1,43 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef ATTRIBUTEGROUP_XSD_HPP_
+#define ATTRIBUTEGROUP_XSD_HPP_
+
+#include <optional>
+#include <string>
+
+namespace schema {
+
+class Product;
+class ProductAttrs;
+class CommonAttrs;
+
+class Product {
+public:
+  std::optional<double> priceAttr;
+  std::string skuAttr;
+  std::string idAttr;
+  std::optional<std::string> langAttr;
+  std::string title;
+};
+
+class ProductAttrs {
+public:
+  std::string skuAttr;
+  std::string idAttr;
+  std::optional<std::string> langAttr;
+};
+
+class CommonAttrs {
+public:
+  std::string idAttr;
+  std::optional<std::string> langAttr;
+};
+
+}  // namespace schema
+
+#endif  // ATTRIBUTEGROUP_XSD_HPP_
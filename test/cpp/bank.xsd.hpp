@@ -0,0 +1,42 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef BANK_XSD_HPP_
+#define BANK_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <vector>
+
+namespace schema {
+
+class BankAccount;
+class PremiumAccount;
+class Bank;
+
+class BankAccount {
+public:
+  std::string idAttr;
+  std::optional<std::string> legacyAttr;
+  std::optional<std::string> noteAttr;
+  std::optional<std::string> unitAttr;
+};
+
+class PremiumAccount : public BankAccount {
+public:
+  std::string tierAttr;
+};
+
+class Bank {
+public:
+  int codeAttr;
+  std::vector<BankAccount> account;
+  std::optional<int> iso;
+};
+
+}  // namespace schema
+
+#endif  // BANK_XSD_HPP_
@@ -0,0 +1,45 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef BASE64_XSD_HPP_
+#define BASE64_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <vector>
+
+namespace schema {
+
+class MyType2;
+class MyType3;
+class MyType4;
+
+using MyType1 = std::vector<unsigned char>;
+
+class MyType2 {
+public:
+  std::optional<int> lengthAttr;
+  std::vector<unsigned char> value;
+};
+
+class MyType3 {
+public:
+  std::optional<int> lengthAttr;
+  std::string value;
+};
+
+class MyType4 {
+public:
+  std::string title;
+  std::vector<unsigned char> blob;
+  std::string timestamp;
+};
+
+using MyType5 = std::string;
+
+}  // namespace schema
+
+#endif  // BASE64_XSD_HPP_
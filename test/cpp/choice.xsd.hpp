@@ -0,0 +1,49 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef CHOICE_XSD_HPP_
+#define CHOICE_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <vector>
+
+namespace schema {
+
+class Circle;
+class Square;
+class Shape;
+class Drawing;
+
+class Circle {
+public:
+  std::optional<double> radiusAttr;
+};
+
+class Square {
+public:
+  std::optional<double> sideAttr;
+};
+
+class Shape {
+public:
+  std::string label;
+  std::optional<Circle> circle;
+  std::optional<Square> square;
+  std::optional<std::string> path;
+  std::optional<std::string> fill;
+  std::optional<int> stroke;
+};
+
+class Drawing {
+public:
+  std::vector<Shape> shape;
+  std::vector<std::string> text;
+};
+
+}  // namespace schema
+
+#endif  // CHOICE_XSD_HPP_
@@ -0,0 +1,29 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef DURATION_XSD_HPP_
+#define DURATION_XSD_HPP_
+
+#include <optional>
+#include <string>
+
+namespace schema {
+
+class Reminder;
+
+using LeadTime = std::string;
+
+class Reminder {
+public:
+  std::optional<std::string> leadAttr;
+  std::string subject;
+  std::string interval;
+  std::optional<std::string> snooze;
+};
+
+}  // namespace schema
+
+#endif  // DURATION_XSD_HPP_
@@ -0,0 +1,56 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef EXTENSION_XSD_HPP_
+#define EXTENSION_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <vector>
+
+namespace schema {
+
+class Vehicle;
+class Car;
+class SportsCar;
+class CompactCar;
+class Garage;
+
+class Vehicle {
+public:
+  std::string vinAttr;
+  std::string make;
+  int year;
+};
+
+class Car : public Vehicle {
+public:
+  int doors;
+  std::optional<std::string> model;
+};
+
+class SportsCar : public Car {
+public:
+  int topSpeed;
+};
+
+class CompactCar {
+public:
+  std::string vinAttr;
+  std::string make;
+  int year;
+  int doors;
+  std::string model;
+};
+
+class Garage {
+public:
+  std::vector<Vehicle> vehicle;
+};
+
+}  // namespace schema
+
+#endif  // EXTENSION_XSD_HPP_
@@ -0,0 +1,45 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef FACETS_XSD_HPP_
+#define FACETS_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <string_view>
+#include <vector>
+
+namespace schema {
+
+enum class Color;
+class CatalogItem;
+
+enum class Color {
+  Red,  // red
+  Green,  // green
+  Blue,  // blue
+};
+
+std::string_view toString(Color value);
+std::optional<Color> parseColor(std::string_view value);
+
+using ProductCode = std::string;
+
+using Quantity = long long;
+
+using Ratio = double;
+
+class CatalogItem {
+public:
+  std::optional<double> discountAttr;
+  std::string code;
+  std::vector<Color> color;
+  std::optional<long long> quantity;
+};
+
+}  // namespace schema
+
+#endif  // FACETS_XSD_HPP_
@@ -0,0 +1,39 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef FINAL_XSD_HPP_
+#define FINAL_XSD_HPP_
+
+#include <string>
+
+namespace schema {
+
+class Account;
+class SavingsAccount;
+
+// final="#all": derivation is prohibited
+using AccountNumber = std::string;
+
+// final="restriction": derivation by restriction is prohibited
+// block="extension": substitution by extension is blocked
+class Account {
+public:
+  std::string number;
+  double balance;
+};
+
+class SavingsAccount : public Account {
+public:
+  double rate;
+};
+
+// final="extension": derivation by extension is prohibited
+// block="restriction substitution": substitution by restriction or substitution group members is blocked
+using PrimaryAccount = Account;
+
+}  // namespace schema
+
+#endif  // FINAL_XSD_HPP_
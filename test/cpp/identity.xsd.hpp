@@ -0,0 +1,40 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef IDENTITY_XSD_HPP_
+#define IDENTITY_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <vector>
+
+namespace schema {
+
+class Part;
+class InventoryBin;
+class Inventory;
+
+class Part {
+public:
+  std::string skuAttr;
+  std::string serial;
+};
+
+class InventoryBin {
+public:
+  std::optional<std::string> codeAttr;
+  std::optional<std::string> skuAttr;
+};
+
+class Inventory {
+public:
+  std::vector<Part> part;
+  std::vector<InventoryBin> bin;
+};
+
+}  // namespace schema
+
+#endif  // IDENTITY_XSD_HPP_
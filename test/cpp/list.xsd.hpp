@@ -0,0 +1,33 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef LIST_XSD_HPP_
+#define LIST_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <vector>
+
+namespace schema {
+
+class Swatch;
+
+using MeasureList = std::vector<double>;
+
+using YearList = std::vector<std::string>;
+
+using ShadeList = std::vector<std::string>;
+
+class Swatch {
+public:
+  std::optional<std::vector<std::string>> yearsAttr;
+  std::vector<double> measures;
+  std::optional<std::vector<std::string>> shades;
+};
+
+}  // namespace schema
+
+#endif  // LIST_XSD_HPP_
@@ -0,0 +1,33 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef LOCALIZED_XSD_HPP_
+#define LOCALIZED_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <vector>
+
+namespace schema {
+
+class BookTitle;
+class Book;
+
+class BookTitle {
+public:
+  std::optional<std::string> xmlLangAttr;
+  std::string value;
+};
+
+class Book {
+public:
+  std::vector<BookTitle> title;
+  std::string isbn;
+};
+
+}  // namespace schema
+
+#endif  // LOCALIZED_XSD_HPP_
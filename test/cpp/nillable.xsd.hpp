@@ -0,0 +1,36 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef NILLABLE_XSD_HPP_
+#define NILLABLE_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <vector>
+
+namespace schema {
+
+class MailAddress;
+class Reviewer;
+
+using RatingType = int;
+
+class MailAddress {
+public:
+  std::string city;
+};
+
+class Reviewer {
+public:
+  std::string nickname;
+  std::optional<int> rating;
+  std::optional<MailAddress> mailAddress;
+  std::vector<std::string> tags;
+};
+
+}  // namespace schema
+
+#endif  // NILLABLE_XSD_HPP_
@@ -0,0 +1,36 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#include "playlist.xsd.hpp"
+
+namespace schema {
+
+std::string_view toString(Genre value) {
+  switch (value) {
+  case Genre::Rock:
+    return "rock";
+  case Genre::HipHop:
+    return "hip-hop";
+  case Genre::Classical:
+    return "classical";
+  }
+  return {};
+}
+
+std::optional<Genre> parseGenre(std::string_view value) {
+  if (value == "rock") {
+    return Genre::Rock;
+  }
+  if (value == "hip-hop") {
+    return Genre::HipHop;
+  }
+  if (value == "classical") {
+    return Genre::Classical;
+  }
+  return std::nullopt;
+}
+
+}  // namespace schema
@@ -0,0 +1,41 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef PLAYLIST_XSD_HPP_
+#define PLAYLIST_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <string_view>
+#include <vector>
+
+namespace schema {
+
+enum class Genre;
+class Playlist;
+
+enum class Genre {
+  Rock,  // rock
+  HipHop,  // hip-hop
+  Classical,  // classical
+};
+
+std::string_view toString(Genre value);
+std::optional<Genre> parseGenre(std::string_view value);
+
+class Playlist {
+public:
+  int idAttr;
+  std::optional<bool> sharedAttr;
+  std::string title;
+  std::optional<Genre> genre;
+  std::vector<std::string> track;
+  std::optional<double> rating;
+};
+
+}  // namespace schema
+
+#endif  // PLAYLIST_XSD_HPP_
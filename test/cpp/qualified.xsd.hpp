@@ -0,0 +1,33 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef QUALIFIED_XSD_HPP_
+#define QUALIFIED_XSD_HPP_
+
+#include <optional>
+#include <string>
+
+namespace schema {
+
+class QualifiedContact;
+class QualifiedCard;
+
+class QualifiedContact {
+public:
+  std::optional<int> idAttr;
+  std::optional<std::string> tierAttr;
+  std::string name;
+  std::string note;
+};
+
+class QualifiedCard {
+public:
+  std::string holder;
+};
+
+}  // namespace schema
+
+#endif  // QUALIFIED_XSD_HPP_
@@ -0,0 +1,45 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef SETTING_XSD_HPP_
+#define SETTING_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <string_view>
+
+namespace schema {
+
+enum class Level;
+class Setting;
+class Override;
+
+enum class Level {
+  Low,  // low
+  High,  // high
+};
+
+std::string_view toString(Level value);
+std::optional<Level> parseLevel(std::string_view value);
+
+class Setting {
+public:
+  int idAttr;
+  std::optional<bool> enabledAttr;
+  std::optional<std::string> noteAttr;
+  std::string key;
+  std::optional<int> retries;
+  std::optional<Level> level;
+};
+
+class Override : public Setting {
+public:
+  std::string scope;
+};
+
+}  // namespace schema
+
+#endif  // SETTING_XSD_HPP_
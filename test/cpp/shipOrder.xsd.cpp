@@ -0,0 +1,36 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#include "shipOrder.xsd.hpp"
+
+namespace schema {
+
+std::string_view toString(OrderStatus value) {
+  switch (value) {
+  case OrderStatus::Pending:
+    return "pending";
+  case OrderStatus::InTransit:
+    return "in-transit";
+  case OrderStatus::Delivered:
+    return "delivered";
+  }
+  return {};
+}
+
+std::optional<OrderStatus> parseOrderStatus(std::string_view value) {
+  if (value == "pending") {
+    return OrderStatus::Pending;
+  }
+  if (value == "in-transit") {
+    return OrderStatus::InTransit;
+  }
+  if (value == "delivered") {
+    return OrderStatus::Delivered;
+  }
+  return std::nullopt;
+}
+
+}  // namespace schema
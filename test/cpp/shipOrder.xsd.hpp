@@ -0,0 +1,41 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef SHIPORDER_XSD_HPP_
+#define SHIPORDER_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <string_view>
+#include <vector>
+
+namespace schema {
+
+enum class OrderStatus;
+class ShipOrder;
+
+enum class OrderStatus {
+  Pending,  // pending
+  InTransit,  // in-transit
+  Delivered,  // delivered
+};
+
+std::string_view toString(OrderStatus value);
+std::optional<OrderStatus> parseOrderStatus(std::string_view value);
+
+class ShipOrder {
+public:
+  std::string orderidAttr;
+  std::optional<int> priorityAttr;
+  std::string orderPerson;
+  std::optional<std::string> note;
+  std::vector<std::string> item;
+  OrderStatus status;
+};
+
+}  // namespace schema
+
+#endif  // SHIPORDER_XSD_HPP_
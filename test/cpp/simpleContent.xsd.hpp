@@ -0,0 +1,39 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef SIMPLECONTENT_XSD_HPP_
+#define SIMPLECONTENT_XSD_HPP_
+
+#include <optional>
+#include <string>
+
+namespace schema {
+
+class Price;
+class DiscountPrice;
+class LocalPrice;
+
+using AmountType = double;
+
+class Price {
+public:
+  std::string currencyAttr;
+  double value;
+};
+
+class DiscountPrice : public Price {
+public:
+  std::optional<int> discountAttr;
+};
+
+class LocalPrice {
+public:
+  std::optional<std::string> currencyAttr;
+};
+
+}  // namespace schema
+
+#endif  // SIMPLECONTENT_XSD_HPP_
@@ -0,0 +1,39 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef STOCKQUOTE_WSDL_HPP_
+#define STOCKQUOTE_WSDL_HPP_
+
+#include <string>
+
+namespace schema {
+
+class Money;
+class TradePriceRequest;
+class TradePrice;
+
+using TickerSymbol = std::string;
+
+class Money {
+public:
+  double amount;
+  std::string currency;
+};
+
+class TradePriceRequest {
+public:
+  std::string tickerSymbol;
+};
+
+class TradePrice {
+public:
+  std::string tickerSymbol;
+  Money price;
+};
+
+}  // namespace schema
+
+#endif  // STOCKQUOTE_WSDL_HPP_
@@ -0,0 +1,54 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef SUBSTITUTIONGROUP_XSD_HPP_
+#define SUBSTITUTIONGROUP_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <vector>
+
+namespace schema {
+
+class AnimalType;
+class CatType;
+class DogType;
+class ZooType;
+
+class AnimalType {
+public:
+  std::optional<std::string> idAttr;
+};
+
+class CatType : public AnimalType {
+public:
+  std::optional<int> livesAttr;
+};
+
+class DogType : public AnimalType {
+public:
+  std::optional<std::string> breedAttr;
+};
+
+using Animal = AnimalType;
+
+using Cat = CatType;
+
+using Dog = DogType;
+
+using Puppy = DogType;
+
+class ZooType {
+public:
+  std::string name;
+  std::vector<AnimalType> tnsAnimal;
+};
+
+using Zoo = ZooType;
+
+}  // namespace schema
+
+#endif  // SUBSTITUTIONGROUP_XSD_HPP_
@@ -0,0 +1,31 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#include "union.xsd.hpp"
+
+namespace schema {
+
+std::string_view toString(DimensionToken value) {
+  switch (value) {
+  case DimensionToken::Small:
+    return "small";
+  case DimensionToken::Large:
+    return "large";
+  }
+  return {};
+}
+
+std::optional<DimensionToken> parseDimensionToken(std::string_view value) {
+  if (value == "small") {
+    return DimensionToken::Small;
+  }
+  if (value == "large") {
+    return DimensionToken::Large;
+  }
+  return std::nullopt;
+}
+
+}  // namespace schema
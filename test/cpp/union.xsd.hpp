@@ -0,0 +1,40 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef UNION_XSD_HPP_
+#define UNION_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <string_view>
+#include <variant>
+
+namespace schema {
+
+enum class DimensionToken;
+class Crate;
+
+enum class DimensionToken {
+  Small,  // small
+  Large,  // large
+};
+
+std::string_view toString(DimensionToken value);
+std::optional<DimensionToken> parseDimensionToken(std::string_view value);
+
+using Dimension = std::variant<DimensionToken, std::string, int>;
+
+using TagValue = std::variant<bool, double, std::string>;
+
+class Crate {
+public:
+  std::optional<std::variant<bool, double, std::string>> tagAttr;
+  std::variant<DimensionToken, std::string, int> dimension;
+};
+
+}  // namespace schema
+
+#endif  // UNION_XSD_HPP_
@@ -0,0 +1,27 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef UNQUALIFIED_XSD_HPP_
+#define UNQUALIFIED_XSD_HPP_
+
+#include <optional>
+#include <string>
+
+namespace schema {
+
+class UnqualifiedContact;
+
+class UnqualifiedContact {
+public:
+  std::optional<int> idAttr;
+  std::optional<std::string> tierAttr;
+  std::string name;
+  std::string note;
+};
+
+}  // namespace schema
+
+#endif  // UNQUALIFIED_XSD_HPP_
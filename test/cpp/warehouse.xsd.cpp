@@ -0,0 +1,36 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#include "warehouse.xsd.hpp"
+
+namespace schema {
+
+std::string_view toString(StockLevel value) {
+  switch (value) {
+  case StockLevel::InStock:
+    return "in-stock";
+  case StockLevel::Backordered:
+    return "backordered";
+  case StockLevel::Discontinued:
+    return "discontinued";
+  }
+  return {};
+}
+
+std::optional<StockLevel> parseStockLevel(std::string_view value) {
+  if (value == "in-stock") {
+    return StockLevel::InStock;
+  }
+  if (value == "backordered") {
+    return StockLevel::Backordered;
+  }
+  if (value == "discontinued") {
+    return StockLevel::Discontinued;
+  }
+  return std::nullopt;
+}
+
+}  // namespace schema
@@ -0,0 +1,49 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef WAREHOUSE_XSD_HPP_
+#define WAREHOUSE_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <string_view>
+#include <vector>
+
+namespace schema {
+
+enum class StockLevel;
+class Location;
+class Warehouse;
+
+enum class StockLevel {
+  InStock,  // in-stock
+  Backordered,  // backordered
+  Discontinued,  // discontinued
+};
+
+std::string_view toString(StockLevel value);
+std::optional<StockLevel> parseStockLevel(std::string_view value);
+
+class Location {
+public:
+  std::string street;
+  std::string city;
+  std::optional<std::string> postalCode;
+};
+
+class Warehouse {
+public:
+  std::string codeAttr;
+  std::string name;
+  Location location;
+  std::vector<std::string> sku;
+  long long capacity;
+  StockLevel level;
+};
+
+}  // namespace schema
+
+#endif  // WAREHOUSE_XSD_HPP_
@@ -0,0 +1,41 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef WHITESPACE_XSD_HPP_
+#define WHITESPACE_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <string_view>
+
+namespace schema {
+
+enum class TokenSize;
+class Caption;
+
+using CollapsedCode = std::string;
+
+using ReplacedText = std::string;
+
+enum class TokenSize {
+  Small,  // small
+  Large,  // large
+};
+
+std::string_view toString(TokenSize value);
+std::optional<TokenSize> parseTokenSize(std::string_view value);
+
+class Caption {
+public:
+  std::optional<std::string> langAttr;
+  std::string code;
+  std::string text;
+  TokenSize size;
+};
+
+}  // namespace schema
+
+#endif  // WHITESPACE_XSD_HPP_
@@ -0,0 +1,34 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+#ifndef WILDCARD_XSD_HPP_
+#define WILDCARD_XSD_HPP_
+
+#include <optional>
+#include <string>
+#include <vector>
+
+namespace schema {
+
+class Extensible;
+class Envelope;
+
+class Extensible {
+public:
+  std::optional<std::string> versionAttr;
+  std::string id;
+  std::vector<std::string> any;
+};
+
+class Envelope {
+public:
+  std::string header;
+  std::string any;
+};
+
+}  // namespace schema
+
+#endif  // WILDCARD_XSD_HPP_
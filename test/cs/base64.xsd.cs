@@ -0,0 +1,52 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+using System;
+using System.Xml.Schema;
+using System.Xml.Serialization;
+
+namespace Schema
+{
+    [XmlType("myType2", Namespace = "http://example.org/")]
+    public class MyType2
+    {
+        [XmlAttribute("length")]
+        public int LengthAttr { get; set; }
+
+        [XmlIgnore]
+        public bool LengthAttrSpecified { get; set; }
+
+        [XmlText]
+        public byte[] Value { get; set; }
+    }
+
+    [XmlType("myType3", Namespace = "http://example.org/")]
+    public class MyType3
+    {
+        [XmlAttribute("length")]
+        public int LengthAttr { get; set; }
+
+        [XmlIgnore]
+        public bool LengthAttrSpecified { get; set; }
+
+        [XmlText(DataType = "date")]
+        public DateTime Value { get; set; }
+    }
+
+    [XmlType("myType4", Namespace = "http://example.org/")]
+    public class MyType4
+    {
+        [XmlElement("title", Form = XmlSchemaForm.Unqualified)]
+        public string Title { get; set; }
+
+        [XmlElement("blob", Form = XmlSchemaForm.Unqualified)]
+        public byte[] Blob { get; set; }
+
+        [XmlElement("timestamp", Form = XmlSchemaForm.Unqualified)]
+        public DateTime Timestamp { get; set; }
+    }
+}
@@ -0,0 +1,39 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+using System.Xml.Schema;
+using System.Xml.Serialization;
+
+namespace Schema
+{
+    [XmlType("qualifiedContact", Namespace = "http://example.org/qualified")]
+    public class QualifiedContact
+    {
+        [XmlAttribute("id", Namespace = "http://example.org/qualified")]
+        public int IdAttr { get; set; }
+
+        [XmlIgnore]
+        public bool IdAttrSpecified { get; set; }
+
+        [XmlAttribute("tier")]
+        public string TierAttr { get; set; }
+
+        [XmlElement("name", Namespace = "http://example.org/qualified")]
+        public string Name { get; set; }
+
+        [XmlElement("note", Form = XmlSchemaForm.Unqualified)]
+        public string Note { get; set; }
+    }
+
+    [XmlRoot("qualifiedCard", Namespace = "http://example.org/qualified")]
+    [XmlType("qualifiedCard", Namespace = "http://example.org/qualified")]
+    public class QualifiedCard
+    {
+        [XmlElement("holder", Namespace = "http://example.org/qualified")]
+        public string Holder { get; set; }
+    }
+}
@@ -0,0 +1,58 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+using System.Xml.Serialization;
+
+namespace Schema
+{
+    [XmlType("level")]
+    public enum Level
+    {
+        [XmlEnum("low")]
+        Low,
+        [XmlEnum("high")]
+        High,
+    }
+
+    [XmlType("setting")]
+    public class Setting
+    {
+        [XmlAttribute("id")]
+        public int IdAttr { get; set; }
+
+        [XmlAttribute("enabled")]
+        public bool EnabledAttr { get; set; }
+
+        [XmlIgnore]
+        public bool EnabledAttrSpecified { get; set; }
+
+        [XmlAttribute("note")]
+        public string NoteAttr { get; set; }
+
+        [XmlElement("key")]
+        public string Key { get; set; }
+
+        [XmlElement("retries")]
+        public int Retries { get; set; }
+
+        [XmlIgnore]
+        public bool RetriesSpecified { get; set; }
+
+        [XmlElement("level")]
+        public Level Level { get; set; }
+
+        [XmlIgnore]
+        public bool LevelSpecified { get; set; }
+    }
+
+    [XmlType("override")]
+    public class Override : Setting
+    {
+        [XmlElement("scope")]
+        public string Scope { get; set; }
+    }
+}
@@ -0,0 +1,39 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+using System.Xml.Schema;
+using System.Xml.Serialization;
+
+namespace Schema
+{
+    [XmlType("money", Namespace = "http://example.com/stockquote")]
+    public class Money
+    {
+        [XmlElement("amount", Form = XmlSchemaForm.Unqualified)]
+        public decimal Amount { get; set; }
+
+        [XmlElement("currency", Form = XmlSchemaForm.Unqualified)]
+        public string Currency { get; set; }
+    }
+
+    [XmlType("tradePriceRequest", Namespace = "http://example.com/stockquote")]
+    public class TradePriceRequest
+    {
+        [XmlElement("tickerSymbol", Form = XmlSchemaForm.Unqualified)]
+        public string TickerSymbol { get; set; }
+    }
+
+    [XmlType("tradePrice", Namespace = "http://example.com/stockquote")]
+    public class TradePrice
+    {
+        [XmlElement("tickerSymbol", Form = XmlSchemaForm.Unqualified)]
+        public string TickerSymbol { get; set; }
+
+        [XmlElement("price", Form = XmlSchemaForm.Unqualified)]
+        public Money Price { get; set; }
+    }
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+using System.Xml.Serialization;
+
+namespace Schema
+{
+    [XmlType("dimensionToken")]
+    public enum DimensionToken
+    {
+        [XmlEnum("small")]
+        Small,
+        [XmlEnum("large")]
+        Large,
+    }
+
+    public class Dimension
+    {
+        [XmlElement("dimensionToken")]
+        public DimensionToken DimensionToken { get; set; }
+
+        [XmlElement("gMonth")]
+        public string GMonth { get; set; }
+
+        [XmlElement("int")]
+        public int Int { get; set; }
+    }
+
+    public class TagValue
+    {
+        [XmlElement("boolean")]
+        public bool Boolean { get; set; }
+
+        [XmlElement("decimal")]
+        public decimal Decimal { get; set; }
+
+        [XmlElement("string")]
+        public string String { get; set; }
+    }
+
+    [XmlType("crate")]
+    public class Crate
+    {
+        [XmlAttribute("tag")]
+        public TagValue TagAttr { get; set; }
+
+        [XmlElement("dimension")]
+        public Dimension Dimension { get; set; }
+    }
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class Payment {
+  double amount;
+
+  Payment({required this.amount});
+
+  factory Payment.fromXml(XmlElement element) => Payment(
+    amount: double.parse(element.getElement('amount')!.innerText),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('amount', nest: amount);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class CardPayment {
+  String cardNumber;
+
+  CardPayment({required this.cardNumber});
+
+  factory CardPayment.fromXml(XmlElement element) => CardPayment(
+    cardNumber: element.getElement('cardNumber')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('cardNumber', nest: cardNumber);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class CashPayment {
+  String? currencyAttr;
+
+  CashPayment({this.currencyAttr});
+
+  factory CashPayment.fromXml(XmlElement element) => CashPayment(
+    currencyAttr: element.getAttribute('currency'),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (currencyAttr != null) builder.attribute('currency', currencyAttr!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Invoice {
+  List<Payment> payment;
+
+  Invoice({required this.payment});
+
+  factory Invoice.fromXml(XmlElement element) => Invoice(
+    payment: element.findElements('payment').map(Payment.fromXml).toList(),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    for (final e in payment) builder.element('payment', nest: () => e.buildXml(builder));
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
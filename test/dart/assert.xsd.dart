@@ -0,0 +1,60 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class TemperatureRange {
+  int low;
+  int high;
+
+  TemperatureRange({required this.low, required this.high});
+
+  factory TemperatureRange.fromXml(XmlElement element) => TemperatureRange(
+    low: int.parse(element.getElement('low')!.innerText),
+    high: int.parse(element.getElement('high')!.innerText),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('low', nest: low);
+    builder.element('high', nest: high);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+typedef EvenNumber = int;
+
+class Reading {
+  String? unitAttr;
+  double value;
+
+  Reading({this.unitAttr, required this.value});
+
+  factory Reading.fromXml(XmlElement element) => Reading(
+    unitAttr: element.getAttribute('unit'),
+    value: double.parse(element.getElement('value')!.innerText),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (unitAttr != null) builder.attribute('unit', unitAttr!);
+    builder.element('value', nest: value);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+typedef Sensor = Reading;
+
+typedef Measurement = TemperatureRange;
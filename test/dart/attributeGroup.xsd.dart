@@ -0,0 +1,89 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class Product {
+  double? priceAttr;
+  String skuAttr;
+  String idAttr;
+  String? langAttr;
+  String title;
+
+  Product({this.priceAttr, required this.skuAttr, required this.idAttr, this.langAttr, required this.title});
+
+  factory Product.fromXml(XmlElement element) => Product(
+    priceAttr: switch (element.getAttribute('price')) { final v? => double.parse(v), _ => null },
+    skuAttr: element.getAttribute('sku')!,
+    idAttr: element.getAttribute('id')!,
+    langAttr: element.getAttribute('lang'),
+    title: element.getElement('title')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (priceAttr != null) builder.attribute('price', priceAttr!);
+    builder.attribute('sku', skuAttr);
+    builder.attribute('id', idAttr);
+    if (langAttr != null) builder.attribute('lang', langAttr!);
+    builder.element('title', nest: title);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class ProductAttrs {
+  String skuAttr;
+  String idAttr;
+  String? langAttr;
+
+  ProductAttrs({required this.skuAttr, required this.idAttr, this.langAttr});
+
+  factory ProductAttrs.fromXml(XmlElement element) => ProductAttrs(
+    skuAttr: element.getAttribute('sku')!,
+    idAttr: element.getAttribute('id')!,
+    langAttr: element.getAttribute('lang'),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('sku', skuAttr);
+    builder.attribute('id', idAttr);
+    if (langAttr != null) builder.attribute('lang', langAttr!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class CommonAttrs {
+  String idAttr;
+  String? langAttr;
+
+  CommonAttrs({required this.idAttr, this.langAttr});
+
+  factory CommonAttrs.fromXml(XmlElement element) => CommonAttrs(
+    idAttr: element.getAttribute('id')!,
+    langAttr: element.getAttribute('lang'),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('id', idAttr);
+    if (langAttr != null) builder.attribute('lang', langAttr!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
@@ -0,0 +1,83 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class BankAccount {
+  String idAttr;
+  String? legacyAttr;
+  String? noteAttr;
+  String? unitAttr;
+
+  BankAccount({required this.idAttr, this.legacyAttr, this.noteAttr, this.unitAttr});
+
+  factory BankAccount.fromXml(XmlElement element) => BankAccount(
+    idAttr: element.getAttribute('id')!,
+    legacyAttr: element.getAttribute('legacy'),
+    noteAttr: element.getAttribute('note'),
+    unitAttr: element.getAttribute('unit'),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('id', idAttr);
+    if (legacyAttr != null) builder.attribute('legacy', legacyAttr!);
+    if (noteAttr != null) builder.attribute('note', noteAttr!);
+    if (unitAttr != null) builder.attribute('unit', unitAttr!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class PremiumAccount {
+  String tierAttr;
+
+  PremiumAccount({required this.tierAttr});
+
+  factory PremiumAccount.fromXml(XmlElement element) => PremiumAccount(
+    tierAttr: element.getAttribute('tier')!,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('tier', tierAttr);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Bank {
+  int codeAttr;
+  List<BankAccount> account;
+  int? iso;
+
+  Bank({required this.codeAttr, required this.account, this.iso});
+
+  factory Bank.fromXml(XmlElement element) => Bank(
+    codeAttr: int.parse(element.getAttribute('code')!),
+    account: element.findElements('account').map(BankAccount.fromXml).toList(),
+    iso: switch (element.getElement('iso')?.innerText) { final v? => int.parse(v), _ => null },
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('code', codeAttr);
+    for (final e in account) builder.element('account', nest: () => e.buildXml(builder));
+    if (iso != null) builder.element('iso', nest: iso!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
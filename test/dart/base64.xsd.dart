@@ -0,0 +1,85 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'dart:convert';
+import 'package:xml/xml.dart';
+
+typedef MyType1 = List<int>;
+
+class MyType2 {
+  int? lengthAttr;
+  List<int> value;
+
+  MyType2({this.lengthAttr, required this.value});
+
+  factory MyType2.fromXml(XmlElement element) => MyType2(
+    lengthAttr: switch (element.getAttribute('length')) { final v? => int.parse(v), _ => null },
+    value: base64Decode(element.innerText),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (lengthAttr != null) builder.attribute('length', lengthAttr!);
+    builder.text(base64Encode(value));
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class MyType3 {
+  int? lengthAttr;
+  DateTime value;
+
+  MyType3({this.lengthAttr, required this.value});
+
+  factory MyType3.fromXml(XmlElement element) => MyType3(
+    lengthAttr: switch (element.getAttribute('length')) { final v? => int.parse(v), _ => null },
+    value: DateTime.parse(element.innerText),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (lengthAttr != null) builder.attribute('length', lengthAttr!);
+    builder.text(value.toIso8601String().substring(0, 10));
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class MyType4 {
+  String title;
+  List<int> blob;
+  DateTime timestamp;
+
+  MyType4({required this.title, required this.blob, required this.timestamp});
+
+  factory MyType4.fromXml(XmlElement element) => MyType4(
+    title: element.getElement('title')!.innerText,
+    blob: base64Decode(element.getElement('blob')!.innerText),
+    timestamp: DateTime.parse(element.getElement('timestamp')!.innerText),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('title', nest: title);
+    builder.element('blob', nest: base64Encode(blob));
+    builder.element('timestamp', nest: timestamp.toIso8601String());
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+typedef MyType5 = String;
@@ -0,0 +1,106 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class Circle {
+  double? radiusAttr;
+
+  Circle({this.radiusAttr});
+
+  factory Circle.fromXml(XmlElement element) => Circle(
+    radiusAttr: switch (element.getAttribute('radius')) { final v? => double.parse(v), _ => null },
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (radiusAttr != null) builder.attribute('radius', radiusAttr!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Square {
+  double? sideAttr;
+
+  Square({this.sideAttr});
+
+  factory Square.fromXml(XmlElement element) => Square(
+    sideAttr: switch (element.getAttribute('side')) { final v? => double.parse(v), _ => null },
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (sideAttr != null) builder.attribute('side', sideAttr!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Shape {
+  String label;
+  Circle? circle;
+  Square? square;
+  String? path;
+  String? fill;
+  int? stroke;
+
+  Shape({required this.label, this.circle, this.square, this.path, this.fill, this.stroke});
+
+  factory Shape.fromXml(XmlElement element) => Shape(
+    label: element.getElement('label')!.innerText,
+    circle: switch (element.getElement('circle')) { final e? => Circle.fromXml(e), _ => null },
+    square: switch (element.getElement('square')) { final e? => Square.fromXml(e), _ => null },
+    path: element.getElement('path')?.innerText,
+    fill: element.getElement('fill')?.innerText,
+    stroke: switch (element.getElement('stroke')?.innerText) { final v? => int.parse(v), _ => null },
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('label', nest: label);
+    if (circle != null) builder.element('circle', nest: () => circle!.buildXml(builder));
+    if (square != null) builder.element('square', nest: () => square!.buildXml(builder));
+    if (path != null) builder.element('path', nest: path!);
+    if (fill != null) builder.element('fill', nest: fill!);
+    if (stroke != null) builder.element('stroke', nest: stroke!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Drawing {
+  List<Shape>? shape;
+  List<String>? text;
+
+  Drawing({this.shape, this.text});
+
+  factory Drawing.fromXml(XmlElement element) => Drawing(
+    shape: element.findElements('shape').map(Shape.fromXml).toList(),
+    text: element.findElements('text').map((e) => e.innerText).toList(),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    for (final e in shape ?? const []) builder.element('shape', nest: () => e.buildXml(builder));
+    for (final e in text ?? const []) builder.element('text', nest: e);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
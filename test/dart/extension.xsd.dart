@@ -0,0 +1,129 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class Vehicle {
+  String vinAttr;
+  String make;
+  int year;
+
+  Vehicle({required this.vinAttr, required this.make, required this.year});
+
+  factory Vehicle.fromXml(XmlElement element) => Vehicle(
+    vinAttr: element.getAttribute('vin')!,
+    make: element.getElement('make')!.innerText,
+    year: int.parse(element.getElement('year')!.innerText),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('vin', vinAttr);
+    builder.element('make', nest: make);
+    builder.element('year', nest: year);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Car {
+  int doors;
+  String? model;
+
+  Car({required this.doors, this.model});
+
+  factory Car.fromXml(XmlElement element) => Car(
+    doors: int.parse(element.getElement('doors')!.innerText),
+    model: element.getElement('model')?.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('doors', nest: doors);
+    if (model != null) builder.element('model', nest: model!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class SportsCar {
+  int topSpeed;
+
+  SportsCar({required this.topSpeed});
+
+  factory SportsCar.fromXml(XmlElement element) => SportsCar(
+    topSpeed: int.parse(element.getElement('topSpeed')!.innerText),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('topSpeed', nest: topSpeed);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class CompactCar {
+  String vinAttr;
+  String make;
+  int year;
+  int doors;
+  String model;
+
+  CompactCar({required this.vinAttr, required this.make, required this.year, required this.doors, required this.model});
+
+  factory CompactCar.fromXml(XmlElement element) => CompactCar(
+    vinAttr: element.getAttribute('vin')!,
+    make: element.getElement('make')!.innerText,
+    year: int.parse(element.getElement('year')!.innerText),
+    doors: int.parse(element.getElement('doors')!.innerText),
+    model: element.getElement('model')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('vin', vinAttr);
+    builder.element('make', nest: make);
+    builder.element('year', nest: year);
+    builder.element('doors', nest: doors);
+    builder.element('model', nest: model);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Garage {
+  List<Vehicle> vehicle;
+
+  Garage({required this.vehicle});
+
+  factory Garage.fromXml(XmlElement element) => Garage(
+    vehicle: element.findElements('vehicle').map(Vehicle.fromXml).toList(),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    for (final e in vehicle) builder.element('vehicle', nest: () => e.buildXml(builder));
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
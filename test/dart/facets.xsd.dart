@@ -0,0 +1,52 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+enum Color {
+  red('red'),
+  green('green'),
+  blue('blue');
+
+  const Color(this.value);
+  final String value;
+}
+
+typedef ProductCode = String;
+
+typedef Quantity = int;
+
+typedef Ratio = double;
+
+class CatalogItem {
+  double? discountAttr;
+  String code;
+  List<String> color;
+  int? quantity;
+
+  CatalogItem({this.discountAttr, required this.code, required this.color, this.quantity});
+
+  factory CatalogItem.fromXml(XmlElement element) => CatalogItem(
+    discountAttr: switch (element.getAttribute('discount')) { final v? => double.parse(v), _ => null },
+    code: element.getElement('code')!.innerText,
+    color: element.findElements('color').map((e) => e.innerText).toList(),
+    quantity: switch (element.getElement('quantity')?.innerText) { final v? => int.parse(v), _ => null },
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (discountAttr != null) builder.attribute('discount', discountAttr!);
+    builder.element('code', nest: code);
+    for (final e in color) builder.element('color', nest: e);
+    if (quantity != null) builder.element('quantity', nest: quantity!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
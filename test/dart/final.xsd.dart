@@ -0,0 +1,60 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+// final="#all": derivation is prohibited
+typedef AccountNumber = String;
+
+// final="restriction": derivation by restriction is prohibited
+// block="extension": substitution by extension is blocked
+class Account {
+  String number;
+  double balance;
+
+  Account({required this.number, required this.balance});
+
+  factory Account.fromXml(XmlElement element) => Account(
+    number: element.getElement('number')!.innerText,
+    balance: double.parse(element.getElement('balance')!.innerText),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('number', nest: number);
+    builder.element('balance', nest: balance);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class SavingsAccount {
+  double rate;
+
+  SavingsAccount({required this.rate});
+
+  factory SavingsAccount.fromXml(XmlElement element) => SavingsAccount(
+    rate: double.parse(element.getElement('rate')!.innerText),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('rate', nest: rate);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+// final="extension": derivation by extension is prohibited
+// block="restriction substitution": substitution by restriction or substitution group members is blocked
+typedef PrimaryAccount = Account;
@@ -0,0 +1,112 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class Customer {
+  String customerId;
+  String firstName;
+  String lastName;
+  List<String> email;
+
+  Customer({required this.customerId, required this.firstName, required this.lastName, required this.email});
+
+  factory Customer.fromXml(XmlElement element) => Customer(
+    customerId: element.getElement('customerId')!.innerText,
+    firstName: element.getElement('firstName')!.innerText,
+    lastName: element.getElement('lastName')!.innerText,
+    email: element.findElements('email').map((e) => e.innerText).toList(),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('customerId', nest: customerId);
+    builder.element('firstName', nest: firstName);
+    builder.element('lastName', nest: lastName);
+    for (final e in email) builder.element('email', nest: e);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Supplier {
+  String company;
+  String? firstName;
+  String? lastName;
+  List<String>? email;
+
+  Supplier({required this.company, this.firstName, this.lastName, this.email});
+
+  factory Supplier.fromXml(XmlElement element) => Supplier(
+    company: element.getElement('company')!.innerText,
+    firstName: element.getElement('firstName')?.innerText,
+    lastName: element.getElement('lastName')?.innerText,
+    email: element.findElements('email').map((e) => e.innerText).toList(),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('company', nest: company);
+    if (firstName != null) builder.element('firstName', nest: firstName!);
+    if (lastName != null) builder.element('lastName', nest: lastName!);
+    for (final e in email ?? const []) builder.element('email', nest: e);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class PersonGroup {
+  String firstName;
+  String lastName;
+  List<String> email;
+
+  PersonGroup({required this.firstName, required this.lastName, required this.email});
+
+  factory PersonGroup.fromXml(XmlElement element) => PersonGroup(
+    firstName: element.getElement('firstName')!.innerText,
+    lastName: element.getElement('lastName')!.innerText,
+    email: element.findElements('email').map((e) => e.innerText).toList(),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('firstName', nest: firstName);
+    builder.element('lastName', nest: lastName);
+    for (final e in email) builder.element('email', nest: e);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class ContactGroup {
+  String email;
+
+  ContactGroup({required this.email});
+
+  factory ContactGroup.fromXml(XmlElement element) => ContactGroup(
+    email: element.getElement('email')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('email', nest: email);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class Part {
+  String skuAttr;
+  String serial;
+
+  Part({required this.skuAttr, required this.serial});
+
+  factory Part.fromXml(XmlElement element) => Part(
+    skuAttr: element.getAttribute('sku')!,
+    serial: element.getElement('serial')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('sku', skuAttr);
+    builder.element('serial', nest: serial);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class InventoryBin {
+  String? codeAttr;
+  String? skuAttr;
+
+  InventoryBin({this.codeAttr, this.skuAttr});
+
+  factory InventoryBin.fromXml(XmlElement element) => InventoryBin(
+    codeAttr: element.getAttribute('code'),
+    skuAttr: element.getAttribute('sku'),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (codeAttr != null) builder.attribute('code', codeAttr!);
+    if (skuAttr != null) builder.attribute('sku', skuAttr!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Inventory {
+  List<Part> part;
+  List<InventoryBin> bin;
+
+  Inventory({required this.part, required this.bin});
+
+  factory Inventory.fromXml(XmlElement element) => Inventory(
+    part: element.findElements('part').map(Part.fromXml).toList(),
+    bin: element.findElements('bin').map(InventoryBin.fromXml).toList(),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    for (final e in part) builder.element('part', nest: () => e.buildXml(builder));
+    for (final e in bin) builder.element('bin', nest: () => e.buildXml(builder));
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
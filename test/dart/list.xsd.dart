@@ -0,0 +1,40 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+typedef MeasureList = List<double>;
+
+typedef YearList = List<String>;
+
+typedef ShadeList = List<String>;
+
+class Swatch {
+  YearList? yearsAttr;
+  MeasureList measures;
+  ShadeList? shades;
+
+  Swatch({this.yearsAttr, required this.measures, this.shades});
+
+  factory Swatch.fromXml(XmlElement element) => Swatch(
+    yearsAttr: switch (element.getAttribute('years')) { final v? => v.split(RegExp(r'\s+')).where((s) => s.isNotEmpty).toList(), _ => null },
+    measures: element.getElement('measures')!.innerText.split(RegExp(r'\s+')).where((s) => s.isNotEmpty).map((s) => double.parse(s)).toList(),
+    shades: switch (element.getElement('shades')?.innerText) { final v? => v.split(RegExp(r'\s+')).where((s) => s.isNotEmpty).toList(), _ => null },
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (yearsAttr != null) builder.attribute('years', yearsAttr!.join(' '));
+    builder.element('measures', nest: measures.join(' '));
+    if (shades != null) builder.element('shades', nest: shades!.join(' '));
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class BookTitle {
+  String? xmlLangAttr;
+  String value;
+
+  BookTitle({this.xmlLangAttr, required this.value});
+
+  factory BookTitle.fromXml(XmlElement element) => BookTitle(
+    xmlLangAttr: element.getAttribute('xml:lang'),
+    value: element.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (xmlLangAttr != null) builder.attribute('xml:lang', xmlLangAttr!);
+    builder.text(value);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Book {
+  List<BookTitle> title;
+  String isbn;
+
+  Book({required this.title, required this.isbn});
+
+  factory Book.fromXml(XmlElement element) => Book(
+    title: element.findElements('title').map(BookTitle.fromXml).toList(),
+    isbn: element.getElement('isbn')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    for (final e in title) builder.element('title', nest: () => e.buildXml(builder));
+    builder.element('isbn', nest: isbn);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
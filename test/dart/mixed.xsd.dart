@@ -0,0 +1,31 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class LetterBody {
+  String name;
+  int orderid;
+
+  LetterBody({required this.name, required this.orderid});
+
+  factory LetterBody.fromXml(XmlElement element) => LetterBody(
+    name: element.getElement('name')!.innerText,
+    orderid: int.parse(element.getElement('orderid')!.innerText),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('name', nest: name);
+    builder.element('orderid', nest: orderid);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
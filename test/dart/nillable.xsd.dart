@@ -0,0 +1,59 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+typedef RatingType = int;
+
+class MailAddress {
+  String city;
+
+  MailAddress({required this.city});
+
+  factory MailAddress.fromXml(XmlElement element) => MailAddress(
+    city: element.getElement('city')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('city', nest: city);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Reviewer {
+  String nickname;
+  int? rating;
+  MailAddress? mailAddress;
+  List<String> tags;
+
+  Reviewer({required this.nickname, this.rating, this.mailAddress, required this.tags});
+
+  factory Reviewer.fromXml(XmlElement element) => Reviewer(
+    nickname: element.getElement('nickname')!.innerText,
+    rating: switch (element.getElement('rating')?.innerText) { final v? => int.parse(v), _ => null },
+    mailAddress: switch (element.getElement('mailAddress')) { final e? => MailAddress.fromXml(e), _ => null },
+    tags: element.findElements('tags').map((e) => e.innerText).toList(),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('nickname', nest: nickname);
+    if (rating != null) builder.element('rating', nest: rating!);
+    if (mailAddress != null) builder.element('mailAddress', nest: () => mailAddress!.buildXml(builder));
+    for (final e in tags) builder.element('tags', nest: e);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
@@ -0,0 +1,33 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class OrderType {
+  int? idAttr;
+  List<String> item;
+
+  OrderType({this.idAttr, required this.item});
+
+  factory OrderType.fromXml(XmlElement element) => OrderType(
+    idAttr: switch (element.getAttribute('id')) { final v? => int.parse(v), _ => null },
+    item: element.findElements('item').map((e) => e.innerText).toList(),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (idAttr != null) builder.attribute('id', idAttr!);
+    for (final e in item) builder.element('item', nest: e);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+typedef Order = OrderType;
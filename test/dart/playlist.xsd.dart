@@ -0,0 +1,52 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+enum Genre {
+  rock('rock'),
+  hipHop('hip-hop'),
+  classical('classical');
+
+  const Genre(this.value);
+  final String value;
+}
+
+class Playlist {
+  int idAttr;
+  bool? sharedAttr;
+  String title;
+  String? genre;
+  List<String>? track;
+  double? rating;
+
+  Playlist({required this.idAttr, this.sharedAttr, required this.title, this.genre, this.track, this.rating});
+
+  factory Playlist.fromXml(XmlElement element) => Playlist(
+    idAttr: int.parse(element.getAttribute('id')!),
+    sharedAttr: switch (element.getAttribute('shared')) { final v? => const {'true', '1'}.contains(v), _ => null },
+    title: element.getElement('title')!.innerText,
+    genre: element.getElement('genre')?.innerText,
+    track: element.findElements('track').map((e) => e.innerText).toList(),
+    rating: switch (element.getElement('rating')?.innerText) { final v? => double.parse(v), _ => null },
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('id', idAttr);
+    if (sharedAttr != null) builder.attribute('shared', sharedAttr!);
+    builder.element('title', nest: title);
+    if (genre != null) builder.element('genre', nest: genre!);
+    for (final e in track ?? const []) builder.element('track', nest: e);
+    if (rating != null) builder.element('rating', nest: rating!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
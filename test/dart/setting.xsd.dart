@@ -0,0 +1,71 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+enum Level {
+  low('low'),
+  high('high');
+
+  const Level(this.value);
+  final String value;
+}
+
+class Setting {
+  int idAttr;
+  bool? enabledAttr;
+  String? noteAttr;
+  String key;
+  int? retries;
+  String? level;
+
+  Setting({required this.idAttr, this.enabledAttr, this.noteAttr, required this.key, this.retries, this.level});
+
+  factory Setting.fromXml(XmlElement element) => Setting(
+    idAttr: int.parse(element.getAttribute('id')!),
+    enabledAttr: switch (element.getAttribute('enabled')) { final v? => const {'true', '1'}.contains(v), _ => null },
+    noteAttr: element.getAttribute('note'),
+    key: element.getElement('key')!.innerText,
+    retries: switch (element.getElement('retries')?.innerText) { final v? => int.parse(v), _ => null },
+    level: element.getElement('level')?.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('id', idAttr);
+    if (enabledAttr != null) builder.attribute('enabled', enabledAttr!);
+    if (noteAttr != null) builder.attribute('note', noteAttr!);
+    builder.element('key', nest: key);
+    if (retries != null) builder.element('retries', nest: retries!);
+    if (level != null) builder.element('level', nest: level!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Override {
+  String scope;
+
+  Override({required this.scope});
+
+  factory Override.fromXml(XmlElement element) => Override(
+    scope: element.getElement('scope')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('scope', nest: scope);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
@@ -0,0 +1,52 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+enum OrderStatus {
+  pending('pending'),
+  inTransit('in-transit'),
+  delivered('delivered');
+
+  const OrderStatus(this.value);
+  final String value;
+}
+
+class ShipOrder {
+  String orderidAttr;
+  int? priorityAttr;
+  String orderPerson;
+  String? note;
+  List<String> item;
+  String status;
+
+  ShipOrder({required this.orderidAttr, this.priorityAttr, required this.orderPerson, this.note, required this.item, required this.status});
+
+  factory ShipOrder.fromXml(XmlElement element) => ShipOrder(
+    orderidAttr: element.getAttribute('orderid')!,
+    priorityAttr: switch (element.getAttribute('priority')) { final v? => int.parse(v), _ => null },
+    orderPerson: element.getElement('orderPerson')!.innerText,
+    note: element.getElement('note')?.innerText,
+    item: element.findElements('item').map((e) => e.innerText).toList(),
+    status: element.getElement('status')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('orderid', orderidAttr);
+    if (priorityAttr != null) builder.attribute('priority', priorityAttr!);
+    builder.element('orderPerson', nest: orderPerson);
+    if (note != null) builder.element('note', nest: note!);
+    for (final e in item) builder.element('item', nest: e);
+    builder.element('status', nest: status);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
@@ -0,0 +1,73 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+typedef AmountType = double;
+
+class Price {
+  String currencyAttr;
+  double value;
+
+  Price({required this.currencyAttr, required this.value});
+
+  factory Price.fromXml(XmlElement element) => Price(
+    currencyAttr: element.getAttribute('currency')!,
+    value: double.parse(element.innerText),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('currency', currencyAttr);
+    builder.text(value);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class DiscountPrice {
+  int? discountAttr;
+
+  DiscountPrice({this.discountAttr});
+
+  factory DiscountPrice.fromXml(XmlElement element) => DiscountPrice(
+    discountAttr: switch (element.getAttribute('discount')) { final v? => int.parse(v), _ => null },
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (discountAttr != null) builder.attribute('discount', discountAttr!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class LocalPrice {
+  String? currencyAttr;
+
+  LocalPrice({this.currencyAttr});
+
+  factory LocalPrice.fromXml(XmlElement element) => LocalPrice(
+    currencyAttr: element.getAttribute('currency'),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (currencyAttr != null) builder.attribute('currency', currencyAttr!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+typedef TickerSymbol = String;
+
+class Money {
+  double amount;
+  String currency;
+
+  Money({required this.amount, required this.currency});
+
+  factory Money.fromXml(XmlElement element) => Money(
+    amount: double.parse(element.getElement('amount')!.innerText),
+    currency: element.getElement('currency')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('amount', nest: amount);
+    builder.element('currency', nest: currency);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class TradePriceRequest {
+  String tickerSymbol;
+
+  TradePriceRequest({required this.tickerSymbol});
+
+  factory TradePriceRequest.fromXml(XmlElement element) => TradePriceRequest(
+    tickerSymbol: element.getElement('tickerSymbol')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('tickerSymbol', nest: tickerSymbol);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class TradePrice {
+  String tickerSymbol;
+  Money price;
+
+  TradePrice({required this.tickerSymbol, required this.price});
+
+  factory TradePrice.fromXml(XmlElement element) => TradePrice(
+    tickerSymbol: element.getElement('tickerSymbol')!.innerText,
+    price: Money.fromXml(element.getElement('price')!),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('tickerSymbol', nest: tickerSymbol);
+    builder.element('price', nest: () => price.buildXml(builder));
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
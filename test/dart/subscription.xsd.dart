@@ -0,0 +1,34 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class Subscription {
+  String email;
+  bool active;
+  List<String> topic;
+
+  Subscription({required this.email, required this.active, required this.topic});
+
+  factory Subscription.fromXml(XmlElement element) => Subscription(
+    email: element.getElement('email')!.innerText,
+    active: const {'true', '1'}.contains(element.getElement('active')!.innerText),
+    topic: element.findElements('topic').map((e) => e.innerText).toList(),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('email', nest: email);
+    builder.element('active', nest: active);
+    for (final e in topic) builder.element('topic', nest: e);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class AnimalType {
+  String? idAttr;
+
+  AnimalType({this.idAttr});
+
+  factory AnimalType.fromXml(XmlElement element) => AnimalType(
+    idAttr: element.getAttribute('id'),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (idAttr != null) builder.attribute('id', idAttr!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class CatType {
+  int? livesAttr;
+
+  CatType({this.livesAttr});
+
+  factory CatType.fromXml(XmlElement element) => CatType(
+    livesAttr: switch (element.getAttribute('lives')) { final v? => int.parse(v), _ => null },
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (livesAttr != null) builder.attribute('lives', livesAttr!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class DogType {
+  String? breedAttr;
+
+  DogType({this.breedAttr});
+
+  factory DogType.fromXml(XmlElement element) => DogType(
+    breedAttr: element.getAttribute('breed'),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (breedAttr != null) builder.attribute('breed', breedAttr!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+typedef Animal = AnimalType;
+
+typedef Cat = CatType;
+
+typedef Dog = DogType;
+
+typedef Puppy = DogType;
+
+class ZooType {
+  String name;
+  List<AnimalType> tnsAnimal;
+
+  ZooType({required this.name, required this.tnsAnimal});
+
+  factory ZooType.fromXml(XmlElement element) => ZooType(
+    name: element.getElement('name')!.innerText,
+    tnsAnimal: element.findElements('tns:animal').map(AnimalType.fromXml).toList(),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('name', nest: name);
+    for (final e in tnsAnimal) builder.element('tns:animal', nest: () => e.buildXml(builder));
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+typedef Zoo = ZooType;
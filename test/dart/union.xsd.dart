@@ -0,0 +1,55 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+enum DimensionToken {
+  small('small'),
+  large('large');
+
+  const DimensionToken(this.value);
+  final String value;
+}
+
+class Dimension {
+  int? int;
+  String? dimensionToken;
+  String? gMonth;
+
+  Dimension({this.int, this.dimensionToken, this.gMonth});
+}
+
+class TagValue {
+  bool? boolean;
+  String? string;
+  double? decimal;
+
+  TagValue({this.boolean, this.string, this.decimal});
+}
+
+class Crate {
+  TagValue? tagAttr;
+  Dimension dimension;
+
+  Crate({this.tagAttr, required this.dimension});
+
+  factory Crate.fromXml(XmlElement element) => Crate(
+    tagAttr: element.getAttribute('tag'),
+    dimension: Dimension.fromXml(element.getElement('dimension')!),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (tagAttr != null) builder.attribute('tag', tagAttr!);
+    builder.element('dimension', nest: () => dimension.buildXml(builder));
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
@@ -0,0 +1,37 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class UnqualifiedContact {
+  int? idAttr;
+  String? tierAttr;
+  String name;
+  String note;
+
+  UnqualifiedContact({this.idAttr, this.tierAttr, required this.name, required this.note});
+
+  factory UnqualifiedContact.fromXml(XmlElement element) => UnqualifiedContact(
+    idAttr: switch (element.getAttribute('id')) { final v? => int.parse(v), _ => null },
+    tierAttr: element.getAttribute('tier'),
+    name: element.getElement('name')!.innerText,
+    note: element.getElement('note')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (idAttr != null) builder.attribute('id', idAttr!);
+    if (tierAttr != null) builder.attribute('tier', tierAttr!);
+    builder.element('name', nest: name);
+    builder.element('note', nest: note);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
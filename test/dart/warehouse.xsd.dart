@@ -0,0 +1,78 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+enum StockLevel {
+  inStock('in-stock'),
+  backordered('backordered'),
+  discontinued('discontinued');
+
+  const StockLevel(this.value);
+  final String value;
+}
+
+class Location {
+  String street;
+  String city;
+  String? postalCode;
+
+  Location({required this.street, required this.city, this.postalCode});
+
+  factory Location.fromXml(XmlElement element) => Location(
+    street: element.getElement('street')!.innerText,
+    city: element.getElement('city')!.innerText,
+    postalCode: element.getElement('postalCode')?.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('street', nest: street);
+    builder.element('city', nest: city);
+    if (postalCode != null) builder.element('postalCode', nest: postalCode!);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Warehouse {
+  String codeAttr;
+  String name;
+  Location location;
+  List<String> sku;
+  int capacity;
+  String level;
+
+  Warehouse({required this.codeAttr, required this.name, required this.location, required this.sku, required this.capacity, required this.level});
+
+  factory Warehouse.fromXml(XmlElement element) => Warehouse(
+    codeAttr: element.getAttribute('code')!,
+    name: element.getElement('name')!.innerText,
+    location: Location.fromXml(element.getElement('location')!),
+    sku: element.findElements('sku').map((e) => e.innerText).toList(),
+    capacity: int.parse(element.getElement('capacity')!.innerText),
+    level: element.getElement('level')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.attribute('code', codeAttr);
+    builder.element('name', nest: name);
+    builder.element('location', nest: () => location.buildXml(builder));
+    for (final e in sku) builder.element('sku', nest: e);
+    builder.element('capacity', nest: capacity);
+    builder.element('level', nest: level);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
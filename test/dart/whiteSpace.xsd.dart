@@ -0,0 +1,49 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+typedef CollapsedCode = String;
+
+typedef ReplacedText = String;
+
+enum TokenSize {
+  small('small'),
+  large('large');
+
+  const TokenSize(this.value);
+  final String value;
+}
+
+class Caption {
+  String? langAttr;
+  String code;
+  String text;
+  String size;
+
+  Caption({this.langAttr, required this.code, required this.text, required this.size});
+
+  factory Caption.fromXml(XmlElement element) => Caption(
+    langAttr: element.getAttribute('lang'),
+    code: element.getElement('code')!.innerText,
+    text: element.getElement('text')!.innerText,
+    size: element.getElement('size')!.innerText,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (langAttr != null) builder.attribute('lang', langAttr!);
+    builder.element('code', nest: code);
+    builder.element('text', nest: text);
+    builder.element('size', nest: size);
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import 'package:xml/xml.dart';
+
+class Extensible {
+  String? versionAttr;
+  String id;
+  List<XmlElement>? any;
+
+  Extensible({this.versionAttr, required this.id, this.any});
+
+  factory Extensible.fromXml(XmlElement element) => Extensible(
+    versionAttr: element.getAttribute('version'),
+    id: element.getElement('id')!.innerText,
+    any: element.childElements.where((e) => !const {'id'}.contains(e.name.local)).toList(),
+  );
+
+  void buildXml(XmlBuilder builder) {
+    if (versionAttr != null) builder.attribute('version', versionAttr!);
+    builder.element('id', nest: id);
+    for (final e in any ?? const []) builder.xml(e.toXmlString());
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
+
+class Envelope {
+  String header;
+  XmlElement any;
+
+  Envelope({required this.header, required this.any});
+
+  factory Envelope.fromXml(XmlElement element) => Envelope(
+    header: element.getElement('header')!.innerText,
+    any: element.childElements.where((e) => !const {'header'}.contains(e.name.local)).first,
+  );
+
+  void buildXml(XmlBuilder builder) {
+    builder.element('header', nest: header);
+    builder.xml(any.toXmlString());
+  }
+
+  XmlElement toXml(String name) {
+    final builder = XmlBuilder();
+    builder.element(name, nest: () => buildXml(builder));
+    return builder.buildDocument().rootElement;
+  }
+}
@@ -0,0 +1,37 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+import (
+	"encoding/xml"
+)
+
+// Product ...
+type Product struct {
+	XMLName   xml.Name `xml:"product"`
+	PriceAttr float64  `xml:"price,attr,omitempty"`
+	SkuAttr   string   `xml:"sku,attr"`
+	IdAttr    string   `xml:"id,attr"`
+	LangAttr  string   `xml:"lang,attr,omitempty"`
+	Title     string   `xml:"title"`
+}
+
+// ProductAttrs ...
+type ProductAttrs struct {
+	XMLName  xml.Name `xml:"productAttrs"`
+	SkuAttr  string   `xml:"sku,attr"`
+	IdAttr   string   `xml:"id,attr"`
+	LangAttr string   `xml:"lang,attr,omitempty"`
+}
+
+// CommonAttrs ...
+type CommonAttrs struct {
+	XMLName  xml.Name `xml:"commonAttrs"`
+	IdAttr   string   `xml:"id,attr"`
+	LangAttr string   `xml:"lang,attr,omitempty"`
+}
@@ -9,6 +9,7 @@ package schema
 
 import (
 	"encoding/xml"
+	"strings"
 	"time"
 )
 
@@ -19,21 +20,118 @@ type MyType1 []byte
 type MyType2 struct {
 	XMLName    xml.Name `xml:"myType2"`
 	LengthAttr int      `xml:"length,attr,omitempty"`
+	Value      []byte   `xml:",chardata"`
 }
 
 // MyType3 ...
 type MyType3 struct {
 	XMLName    xml.Name `xml:"myType3"`
 	LengthAttr int      `xml:"length,attr,omitempty"`
+	Value      XSDDate  `xml:",chardata"`
 }
 
 // MyType4 ...
 type MyType4 struct {
-	XMLName   xml.Name  `xml:"myType4"`
-	Title     string    `xml:"title"`
-	Blob      []byte    `xml:"blob"`
-	Timestamp time.Time `xml:"timestamp"`
+	XMLName   xml.Name    `xml:"myType4"`
+	Title     string      `xml:"title"`
+	Blob      []byte      `xml:"blob"`
+	Timestamp XSDDateTime `xml:"timestamp"`
 }
 
 // MyType5 ...
-type MyType5 time.Time
+type MyType5 = XSDGDay
+
+// XSDDateTime is the dateTime data type in XML schema, the value is marshaled
+// with the layout "2006-01-02T15:04:05Z07:00", and unmarshaled with or without the timezone,
+// which is UTC if it's absent.
+type XSDDateTime time.Time
+
+// MarshalText encodes the XSDDateTime value into the lexical representation.
+func (t XSDDateTime) MarshalText() ([]byte, error) {
+	return []byte(time.Time(t).Format("2006-01-02T15:04:05Z07:00")), nil
+}
+
+// UnmarshalText decodes the lexical representation into the XSDDateTime value.
+func (t *XSDDateTime) UnmarshalText(text []byte) error {
+	value := strings.TrimSpace(string(text))
+	if value == "" {
+		*t = XSDDateTime{}
+		return nil
+	}
+	v, err := time.Parse("2006-01-02T15:04:05Z07:00", value)
+	if err != nil {
+		// the timezone is optional in the lexical representation.
+		var zoneErr error
+		if v, zoneErr = time.Parse("2006-01-02T15:04:05", value); zoneErr != nil {
+			return err
+		}
+	}
+	*t = XSDDateTime(v)
+	return nil
+}
+
+// XSDDate is the date data type in XML schema, the value is marshaled
+// with the layout "2006-01-02", and unmarshaled with or without the timezone,
+// which is UTC if it's absent.
+type XSDDate time.Time
+
+// MarshalText encodes the XSDDate value into the lexical representation.
+func (t XSDDate) MarshalText() ([]byte, error) {
+	if time.Time(t).Location() != time.UTC {
+		// keeps the timezone of the value.
+		return []byte(time.Time(t).Format("2006-01-02Z07:00")), nil
+	}
+	return []byte(time.Time(t).Format("2006-01-02")), nil
+}
+
+// UnmarshalText decodes the lexical representation into the XSDDate value.
+func (t *XSDDate) UnmarshalText(text []byte) error {
+	value := strings.TrimSpace(string(text))
+	if value == "" {
+		*t = XSDDate{}
+		return nil
+	}
+	v, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		// the timezone is optional in the lexical representation.
+		var zoneErr error
+		if v, zoneErr = time.Parse("2006-01-02Z07:00", value); zoneErr != nil {
+			return err
+		}
+	}
+	*t = XSDDate(v)
+	return nil
+}
+
+// XSDGDay is the gDay data type in XML schema, the value is marshaled
+// with the layout "---02", and unmarshaled with or without the timezone,
+// which is UTC if it's absent.
+type XSDGDay time.Time
+
+// MarshalText encodes the XSDGDay value into the lexical representation.
+func (t XSDGDay) MarshalText() ([]byte, error) {
+	if time.Time(t).Location() != time.UTC {
+		// keeps the timezone of the value.
+		return []byte(time.Time(t).Format("---02Z07:00")), nil
+	}
+	return []byte(time.Time(t).Format("---02")), nil
+}
+
+// UnmarshalText decodes the lexical representation into the XSDGDay value.
+func (t *XSDGDay) UnmarshalText(text []byte) error {
+	value := strings.TrimSpace(string(text))
+	if value == "" {
+		*t = XSDGDay{}
+		return nil
+	}
+	v, err := time.Parse("---02", value)
+	if err != nil {
+		// the timezone is optional in the lexical representation.
+		var zoneErr error
+		if v, zoneErr = time.Parse("---02Z07:00", value); zoneErr != nil {
+			return err
+		}
+	}
+	*t = XSDGDay(v)
+	return nil
+}
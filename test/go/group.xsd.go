@@ -0,0 +1,44 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+import (
+	"encoding/xml"
+)
+
+// Customer ...
+type Customer struct {
+	XMLName    xml.Name `xml:"customer"`
+	CustomerId string   `xml:"customerId"`
+	FirstName  string   `xml:"firstName"`
+	LastName   string   `xml:"lastName"`
+	Email      []string `xml:"email"`
+}
+
+// Supplier ...
+type Supplier struct {
+	XMLName   xml.Name `xml:"supplier"`
+	Company   string   `xml:"company"`
+	FirstName *string  `xml:"firstName,omitempty"`
+	LastName  *string  `xml:"lastName,omitempty"`
+	Email     []string `xml:"email"`
+}
+
+// PersonGroup ...
+type PersonGroup struct {
+	XMLName   xml.Name `xml:"personGroup"`
+	FirstName string
+	LastName  string
+	Email     []string
+}
+
+// ContactGroup ...
+type ContactGroup struct {
+	XMLName xml.Name `xml:"contactGroup"`
+	Email   string
+}
@@ -0,0 +1,20 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+import (
+	"encoding/xml"
+)
+
+// LetterBody ...
+type LetterBody struct {
+	XMLName xml.Name `xml:"letterBody"`
+	Name    string   `xml:"name"`
+	Orderid int      `xml:"orderid"`
+	Value   string   `xml:",chardata"`
+}
@@ -0,0 +1,65 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// Genre ...
+type Genre string
+
+// The enumerations of Genre.
+const (
+	GenreRock      Genre = "rock"
+	GenreHipHop    Genre = "hip-hop"
+	GenreClassical Genre = "classical"
+)
+
+// String returns the value of the Genre.
+func (v Genre) String() string {
+	return string(v)
+}
+
+// ParseGenre parses the Genre value, an error is returned if
+// the value is not one of the enumerations.
+func ParseGenre(s string) (Genre, error) {
+	switch v := Genre(s); v {
+	case GenreRock, GenreHipHop, GenreClassical:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Genre value %q", s)
+}
+
+// MarshalText encodes the Genre value into the text.
+func (v Genre) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText decodes the text into the Genre value, an error is
+// returned if the text is not one of the enumerations.
+func (v *Genre) UnmarshalText(text []byte) error {
+	value, err := ParseGenre(string(text))
+	if err != nil {
+		return err
+	}
+	*v = value
+	return nil
+}
+
+// Playlist ...
+type Playlist struct {
+	XMLName    xml.Name `xml:"playlist"`
+	IdAttr     int      `xml:"id,attr"`
+	SharedAttr bool     `xml:"shared,attr,omitempty"`
+	Title      string   `xml:"title"`
+	Genre      *Genre   `xml:"genre,omitempty"`
+	Track      []string `xml:"track"`
+	Rating     *float64 `xml:"rating,omitempty"`
+}
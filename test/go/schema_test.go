@@ -0,0 +1,524 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+
+package schema
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMixedContent(t *testing.T) {
+	var letter LetterBody
+	err := xml.Unmarshal([]byte(`<letterBody>Dear Mr. <name>John Smith</name>, your order <orderid>1032</orderid> will be shipped.</letterBody>`), &letter)
+	assert.NoError(t, err)
+	assert.Equal(t, "John Smith", letter.Name)
+	assert.Equal(t, 1032, letter.Orderid)
+	assert.Equal(t, "Dear Mr. , your order  will be shipped.", letter.Value)
+
+	output, err := xml.Marshal(&letter)
+	assert.NoError(t, err)
+	var roundTrip LetterBody
+	assert.NoError(t, xml.Unmarshal(output, &roundTrip))
+	assert.Equal(t, letter, roundTrip)
+}
+
+func TestTimeLayout(t *testing.T) {
+	var myType4 MyType4
+	err := xml.Unmarshal([]byte(`<myType4><title>title</title><blob>YmxvYg==</blob><timestamp>2020-01-02T03:04:05Z</timestamp></myType4>`), &myType4)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), time.Time(myType4.Timestamp))
+
+	output, err := xml.Marshal(&myType4)
+	assert.NoError(t, err)
+	assert.Contains(t, string(output), "<timestamp>2020-01-02T03:04:05Z</timestamp>")
+
+	var day MyType5
+	assert.NoError(t, day.UnmarshalText([]byte("---15")))
+	assert.Equal(t, 15, time.Time(day).Day())
+	assert.EqualError(t, day.UnmarshalText([]byte("15")), `parsing time "15" as "---02": cannot parse "15" as "---"`)
+}
+
+func TestTimezone(t *testing.T) {
+	var myType4 MyType4
+	err := xml.Unmarshal([]byte(`<myType4><title>title</title><blob>YmxvYg==</blob><timestamp> 2020-01-02T03:04:05.5 </timestamp></myType4>`), &myType4)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 1, 2, 3, 4, 5, 5e8, time.UTC), time.Time(myType4.Timestamp))
+
+	var myType3 MyType3
+	assert.NoError(t, xml.Unmarshal([]byte(`<myType3 length="1">2020-01-02+08:00</myType3>`), &myType3))
+	_, offset := time.Time(myType3.Value).Zone()
+	assert.Equal(t, 8*60*60, offset)
+	output, err := xml.Marshal(&myType3)
+	assert.NoError(t, err)
+	assert.Equal(t, `<myType3 length="1">2020-01-02+08:00</myType3>`, string(output))
+
+	assert.NoError(t, xml.Unmarshal([]byte(`<myType3 length="1">2020-01-02Z</myType3>`), &myType3))
+	assert.Equal(t, time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), time.Time(myType3.Value))
+	output, err = xml.Marshal(&myType3)
+	assert.NoError(t, err)
+	assert.Equal(t, `<myType3 length="1">2020-01-02</myType3>`, string(output))
+
+	var day MyType5
+	assert.NoError(t, day.UnmarshalText([]byte("---15Z")))
+	assert.Equal(t, 15, time.Time(day).Day())
+}
+
+func TestDuration(t *testing.T) {
+	var reminder Reminder
+	err := xml.Unmarshal([]byte(`<reminder lead="-P1DT12H"><subject>standup</subject><interval>P1Y2M3DT4H5M6.5S</interval><snooze>PT10M</snooze></reminder>`), &reminder)
+	assert.NoError(t, err)
+	assert.Equal(t, XSDDuration{Negative: true, Days: 1, Hours: 12}, reminder.LeadAttr)
+	assert.Equal(t, XSDDuration{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6.5}, reminder.Interval)
+	_, ok := reminder.Interval.Duration()
+	assert.False(t, ok)
+	lead, ok := reminder.LeadAttr.Duration()
+	assert.True(t, ok)
+	assert.Equal(t, -36*time.Hour, lead)
+	snooze, ok := reminder.Snooze.Duration()
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Minute, snooze)
+
+	output, err := xml.Marshal(&reminder)
+	assert.NoError(t, err)
+	assert.Equal(t, `<reminder lead="-P1DT12H"><subject>standup</subject><interval>P1Y2M3DT4H5M6.5S</interval><snooze>PT10M</snooze></reminder>`, string(output))
+
+	text, err := XSDDuration{}.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "PT0S", string(text))
+	for _, value := range []string{"P", "PT", "P1H", "1D", "P-1D"} {
+		assert.EqualError(t, reminder.Interval.UnmarshalText([]byte(value)), fmt.Sprintf("invalid duration %q", value))
+	}
+}
+
+func TestExtension(t *testing.T) {
+	var sportsCar SportsCar
+	err := xml.Unmarshal([]byte(`<sportsCar vin="1M8GDM9A"><make>Acme</make><year>2020</year><doors>2</doors><topSpeed>300</topSpeed></sportsCar>`), &sportsCar)
+	assert.NoError(t, err)
+	assert.Equal(t, "1M8GDM9A", sportsCar.Car.Vehicle.VinAttr)
+	assert.Equal(t, "Acme", sportsCar.Make)
+	assert.Equal(t, 2020, sportsCar.Year)
+	assert.Equal(t, 2, sportsCar.Doors)
+	assert.Equal(t, 300, sportsCar.TopSpeed)
+	assert.Nil(t, sportsCar.Model)
+
+	output, err := xml.Marshal(&sportsCar)
+	assert.NoError(t, err)
+	assert.Equal(t, `<sportsCar vin="1M8GDM9A"><make>Acme</make><year>2020</year><doors>2</doors><topSpeed>300</topSpeed></sportsCar>`, string(output))
+}
+
+func TestSimpleContent(t *testing.T) {
+	var price Price
+	err := xml.Unmarshal([]byte(`<price currency="USD">9.99</price>`), &price)
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", price.CurrencyAttr)
+	assert.Equal(t, 9.99, price.Value)
+
+	output, err := xml.Marshal(&price)
+	assert.NoError(t, err)
+	assert.Equal(t, `<price currency="USD">9.99</price>`, string(output))
+
+	var discountPrice DiscountPrice
+	err = xml.Unmarshal([]byte(`<discountPrice currency="USD" discount="10">8.99</discountPrice>`), &discountPrice)
+	assert.NoError(t, err)
+	assert.Equal(t, 8.99, discountPrice.Price.Value)
+	assert.Equal(t, 10, discountPrice.DiscountAttr)
+}
+
+func TestXSIType(t *testing.T) {
+	var garage Garage
+	err := xml.Unmarshal([]byte(`<garage xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">`+
+		`<vehicle vin="1"><make>Acme</make><year>2018</year></vehicle>`+
+		`<vehicle xsi:type="car" vin="2"><make>Acme</make><year>2019</year><doors>4</doors></vehicle>`+
+		`<vehicle xsi:type="sportsCar" vin="3"><make>Acme</make><year>2020</year><doors>2</doors><topSpeed>300</topSpeed></vehicle>`+
+		`</garage>`), &garage)
+	assert.NoError(t, err)
+	assert.Len(t, garage.Vehicle, 3)
+	vehicle, ok := garage.Vehicle[0].Value.(*Vehicle)
+	assert.True(t, ok)
+	assert.Equal(t, "1", vehicle.VinAttr)
+	car, ok := garage.Vehicle[1].Value.(*Car)
+	assert.True(t, ok)
+	assert.Equal(t, "2", car.VinAttr)
+	assert.Equal(t, 4, car.Doors)
+	sportsCar, ok := garage.Vehicle[2].Value.(*SportsCar)
+	assert.True(t, ok)
+	assert.Equal(t, "3", sportsCar.VinAttr)
+	assert.Equal(t, 300, sportsCar.TopSpeed)
+
+	output, err := xml.Marshal(&garage)
+	assert.NoError(t, err)
+	var roundTrip Garage
+	assert.NoError(t, xml.Unmarshal(output, &roundTrip))
+	assert.Equal(t, garage, roundTrip)
+}
+
+// ElectricCar is derived from the car type in another schema, it's
+// registered to the VehicleTypes to be selected by xsi:type.
+type ElectricCar struct {
+	XMLName xml.Name `xml:"car"`
+	Car
+	Range int `xml:"range"`
+}
+
+func TestXSITypeRegistry(t *testing.T) {
+	VehicleTypes[xml.Name{Space: "http://example.com/electric", Local: "car"}] = func() VehicleInterface { return &ElectricCar{} }
+	defer delete(VehicleTypes, xml.Name{Space: "http://example.com/electric", Local: "car"})
+
+	var garage Garage
+	err := xml.Unmarshal([]byte(`<garage xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">`+
+		`<vehicle xsi:type="car" vin="1"><make>Acme</make><year>2019</year><doors>4</doors></vehicle>`+
+		`<vehicle xmlns:e="http://example.com/electric" xsi:type="e:car" vin="2"><make>Acme</make><year>2021</year><doors>4</doors><range>500</range></vehicle>`+
+		`<vehicle xsi:type="truck" vin="3"><make>Acme</make><year>2017</year></vehicle>`+
+		`</garage>`), &garage)
+	assert.NoError(t, err)
+	assert.Len(t, garage.Vehicle, 3)
+	car, ok := garage.Vehicle[0].Value.(*Car)
+	assert.True(t, ok)
+	assert.Equal(t, 4, car.Doors)
+	electricCar, ok := garage.Vehicle[1].Value.(*ElectricCar)
+	assert.True(t, ok)
+	assert.Equal(t, "2", electricCar.VinAttr)
+	assert.Equal(t, 500, electricCar.Range)
+	vehicle, ok := garage.Vehicle[2].Value.(*Vehicle)
+	assert.True(t, ok)
+	assert.Equal(t, "3", vehicle.VinAttr)
+}
+
+func TestLocalizedString(t *testing.T) {
+	var book Book
+	err := xml.Unmarshal([]byte(`<book><title xml:lang="en">The Little Prince</title><title xml:lang="fr">Le Petit Prince</title><isbn>9780156012195</isbn></book>`), &book)
+	assert.NoError(t, err)
+	assert.Len(t, book.Title, 2)
+	assert.Equal(t, "en", book.Title[0].Lang)
+	assert.Equal(t, "The Little Prince", book.Title[0].Value)
+	assert.Equal(t, "fr", book.Title[1].Lang)
+	assert.Equal(t, "Le Petit Prince", book.Title[1].Value)
+
+	output, err := xml.Marshal(&book)
+	assert.NoError(t, err)
+	var roundTrip Book
+	assert.NoError(t, xml.Unmarshal(output, &roundTrip))
+	assert.Equal(t, book, roundTrip)
+}
+
+func TestEnumText(t *testing.T) {
+	status, err := ParseOrderStatus("in-transit")
+	assert.NoError(t, err)
+	assert.Equal(t, OrderStatusInTransit, status)
+	assert.Equal(t, "in-transit", status.String())
+
+	_, err = ParseOrderStatus("lost")
+	assert.EqualError(t, err, `invalid OrderStatus value "lost"`)
+
+	output, err := json.Marshal(map[string]OrderStatus{"status": OrderStatusDelivered})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"status":"delivered"}`, string(output))
+	var roundTrip map[string]OrderStatus
+	assert.NoError(t, json.Unmarshal(output, &roundTrip))
+	assert.Equal(t, OrderStatusDelivered, roundTrip["status"])
+	assert.Error(t, json.Unmarshal([]byte(`{"status":"lost"}`), &roundTrip))
+
+	var shipOrder ShipOrder
+	assert.NoError(t, xml.Unmarshal([]byte(`<shipOrder orderid="1"><status>pending</status></shipOrder>`), &shipOrder))
+	assert.Equal(t, OrderStatusPending, shipOrder.Status)
+	output, err = xml.Marshal(&shipOrder)
+	assert.NoError(t, err)
+	assert.Contains(t, string(output), "<status>pending</status>")
+	assert.EqualError(t, xml.Unmarshal([]byte(`<shipOrder orderid="1"><status>lost</status></shipOrder>`), &shipOrder), `invalid OrderStatus value "lost"`)
+}
+
+func TestWildcard(t *testing.T) {
+	sample := `<extensible xmlns:ext="urn:ext"><id>1</id><ext:note xml:lang="en">see <b>below</b></ext:note><tag xmlns:x="urn:x" x:name="a"><x:value/></tag></extensible>`
+	var extensible Extensible
+	assert.NoError(t, xml.Unmarshal([]byte(sample), &extensible))
+	assert.Equal(t, "1", extensible.Id)
+	assert.Len(t, extensible.Any, 2)
+	assert.Equal(t, xml.Name{Space: "urn:ext", Local: "note"}, extensible.Any[0].XMLName)
+	assert.Equal(t, "see <b>below</b>", extensible.Any[0].InnerXML)
+	output, err := xml.Marshal(&extensible)
+	assert.NoError(t, err)
+	assert.Equal(t, `<extensible><id>1</id><note xmlns="urn:ext" xml:lang="en">see <b>below</b></note><tag xmlns:x="urn:x" x:name="a"><x:value/></tag></extensible>`, string(output))
+
+	var attributes Extensible
+	assert.NoError(t, xml.Unmarshal([]byte(`<extensible xmlns:ext="http://example.org/ext" version="2" ext:flag="on"><id>1</id></extensible>`), &attributes))
+	assert.Equal(t, "2", attributes.VersionAttr)
+	assert.Contains(t, attributes.AnyAttrs, XSDAnyAttr{Name: xml.Name{Space: "http://example.org/ext", Local: "flag"}, Value: "on"})
+	output, err = xml.Marshal(&attributes)
+	assert.NoError(t, err)
+	assert.Equal(t, `<extensible version="2" xmlns:ext="http://example.org/ext" ext:flag="on"><id>1</id></extensible>`, string(output))
+
+	var envelope Envelope
+	assert.NoError(t, xml.Unmarshal([]byte(`<envelope><header>h</header><body xmlns="urn:body">text</body></envelope>`), &envelope))
+	assert.NotNil(t, envelope.Any)
+	output, err = xml.Marshal(&envelope)
+	assert.NoError(t, err)
+	assert.Equal(t, `<envelope><header>h</header><body xmlns="urn:body">text</body></envelope>`, string(output))
+
+	output, err = xml.Marshal(&Envelope{Header: "h"})
+	assert.NoError(t, err)
+	assert.Equal(t, `<envelope><header>h</header></envelope>`, string(output))
+}
+
+func TestWhiteSpace(t *testing.T) {
+	sample := "<caption lang=\" en\tGB \"><code>  a\tb  </code><text>a\tb\nc</text><size>\n  small\n</size></caption>"
+	var caption Caption
+	assert.NoError(t, xml.Unmarshal([]byte(sample), &caption))
+	assert.Equal(t, CollapsedCode("a b"), caption.Code)
+	assert.Equal(t, CollapsedCode("en GB"), caption.LangAttr)
+	assert.Equal(t, ReplacedText("a b c"), caption.Text)
+	assert.Equal(t, TokenSizeSmall, caption.Size)
+	output, err := xml.Marshal(&caption)
+	assert.NoError(t, err)
+	assert.Equal(t, `<caption lang="en GB"><code>a b</code><text>a b c</text><size>small</size></caption>`, string(output))
+}
+
+func TestFormDefault(t *testing.T) {
+	sample := `<qualifiedContact xmlns="http://example.org/qualified" xmlns:q="http://example.org/qualified" q:id="1" tier="gold"><name>Ann</name><note xmlns="">vip</note></qualifiedContact>`
+	var qualified QualifiedContact
+	assert.NoError(t, xml.Unmarshal([]byte(sample), &qualified))
+	assert.Equal(t, QualifiedContact{XMLName: xml.Name{Space: "http://example.org/qualified", Local: "qualifiedContact"}, IdAttr: 1, TierAttr: "gold", Name: "Ann", Note: "vip"}, qualified)
+	qualified = QualifiedContact{}
+	assert.NoError(t, xml.Unmarshal([]byte(`<qualifiedContact id="1"><name>Ann</name></qualifiedContact>`), &qualified))
+	assert.Equal(t, 0, qualified.IdAttr)
+	assert.Equal(t, "", qualified.Name)
+
+	sample = `<unqualifiedContact xmlns:u="http://example.org/unqualified" id="2" u:tier="gold"><name>Bob</name><u:note>vip</u:note></unqualifiedContact>`
+	var unqualified UnqualifiedContact
+	assert.NoError(t, xml.Unmarshal([]byte(sample), &unqualified))
+	assert.Equal(t, UnqualifiedContact{XMLName: xml.Name{Local: "unqualifiedContact"}, IdAttr: 2, TierAttr: "gold", Name: "Bob", Note: "vip"}, unqualified)
+}
+
+func TestTargetNamespace(t *testing.T) {
+	output, err := xml.Marshal(&QualifiedCard{Holder: "Ann"})
+	assert.NoError(t, err)
+	assert.Equal(t, `<qualifiedCard xmlns="http://example.org/qualified"><holder xmlns="http://example.org/qualified">Ann</holder></qualifiedCard>`, string(output))
+	var card QualifiedCard
+	assert.NoError(t, xml.Unmarshal(output, &card))
+	assert.Equal(t, "Ann", card.Holder)
+	assert.Error(t, xml.Unmarshal([]byte(`<qualifiedCard><holder>Ann</holder></qualifiedCard>`), &card))
+}
+
+func TestValidateIdentity(t *testing.T) {
+	var inventory Inventory
+	assert.NoError(t, xml.Unmarshal([]byte(`<inventory><part sku="a"><serial>1</serial></part><part sku="b"><serial>2</serial></part><bin code="x" sku="a"/><bin code="x" sku="b"/></inventory>`), &inventory))
+	assert.NoError(t, inventory.ValidateIdentity())
+	inventory.Bin[1].SkuAttr = "c"
+	assert.EqualError(t, inventory.ValidateIdentity(), "inventory: value c of bin/@sku in keyref binPart doesn't match key partKey")
+	inventory.Bin[1].SkuAttr = "b"
+	inventory.Part[1].SkuAttr = "a"
+	assert.EqualError(t, inventory.ValidateIdentity(), "inventory: duplicate value a of part/@sku in key partKey")
+	inventory.Part[1].SkuAttr, inventory.Part[1].Serial = "b", "1"
+	assert.EqualError(t, inventory.ValidateIdentity(), "inventory: duplicate value 1 of ./part/serial in unique serialUnique")
+	assert.NoError(t, (*Inventory)(nil).ValidateIdentity())
+}
+
+func TestSimpleList(t *testing.T) {
+	sample := "<swatch years=\" 2019\t2020 \"><measures>1.5\n 2 </measures><shades>light dark</shades></swatch>"
+	var swatch Swatch
+	assert.NoError(t, xml.Unmarshal([]byte(sample), &swatch))
+	assert.Equal(t, MeasureList{1.5, 2}, *swatch.Measures)
+	assert.Equal(t, ShadeList{"light", "dark"}, *swatch.Shades)
+	assert.Len(t, *swatch.YearsAttr, 2)
+	assert.Equal(t, 2020, time.Time((*swatch.YearsAttr)[1]).Year())
+	output, err := xml.Marshal(&swatch)
+	assert.NoError(t, err)
+	assert.Equal(t, `<swatch years="2019 2020"><measures>1.5 2</measures><shades>light dark</shades></swatch>`, string(output))
+	assert.Error(t, xml.Unmarshal([]byte(`<swatch><measures>1.5 wide</measures></swatch>`), &swatch))
+}
+
+func TestUnion(t *testing.T) {
+	var crate Crate
+	assert.NoError(t, xml.Unmarshal([]byte(`<crate tag=" true "><dimension> 12 </dimension></crate>`), &crate))
+	assert.Equal(t, 12, *crate.Dimension.Int)
+	assert.Equal(t, true, *crate.TagAttr.Boolean)
+	output, err := xml.Marshal(&crate)
+	assert.NoError(t, err)
+	assert.Equal(t, `<crate tag="true"><dimension>12</dimension></crate>`, string(output))
+
+	assert.NoError(t, xml.Unmarshal([]byte(`<crate tag="12 kg"><dimension>large</dimension></crate>`), &crate))
+	assert.Nil(t, crate.Dimension.Int)
+	assert.Equal(t, DimensionTokenLarge, *crate.Dimension.DimensionToken)
+	assert.Equal(t, "12 kg", *crate.TagAttr.String)
+	assert.NoError(t, xml.Unmarshal([]byte(`<crate><dimension>--03</dimension></crate>`), &crate))
+	assert.Equal(t, time.March, time.Time(*crate.Dimension.GMonth).Month())
+	assert.EqualError(t, xml.Unmarshal([]byte(`<crate><dimension>12px</dimension></crate>`), &crate), `dimension: the value "12px" matches none of the member types`)
+}
+
+func TestChoice(t *testing.T) {
+	for sample, expected := range map[string][2]string{
+		"<shape><circle/></shape>":                          {"circle", "<nil>"},
+		"<shape><path>M0</path><fill>red</fill></shape>":    {"path", "<nil>"},
+		"<shape><fill>red</fill></shape>":                   {"", "shape: exactly one of the elements circle, square, path must be present"},
+		"<shape><circle/><square/></shape>":                 {"circle", "shape: exactly one of the elements circle, square, path must be present"},
+		"<shape><square/><fill/><stroke>1</stroke></shape>": {"square", "shape: at most one of the elements fill, stroke can be present"},
+	} {
+		var shape Shape
+		assert.NoError(t, xml.Unmarshal([]byte(sample), &shape))
+		assert.Equal(t, expected[0], shape.Choice(), sample)
+		assert.Equal(t, expected[1], fmt.Sprint(shape.Validate()), sample)
+	}
+	output, err := xml.Marshal(NewShape("l", WithShapePath("M0")))
+	assert.NoError(t, err)
+	assert.Equal(t, "<shape><label>l</label><path>M0</path></shape>", string(output))
+}
+
+func TestFacets(t *testing.T) {
+	for sample, expected := range map[string]string{
+		"<catalogItem><code>ABC-1234</code></catalogItem>":                                        "<nil>",
+		"<catalogItem discount=\"1\"><code>ABC-1234</code><quantity>999</quantity></catalogItem>": "<nil>",
+		"<catalogItem><code>ABC-12345</code></catalogItem>":                                       "productCode: length 9 must be 8",
+		"<catalogItem><code>abc-1234</code></catalogItem>":                                        "productCode: value \"abc-1234\" doesn't match the pattern [A-Z]{3}-\\d{4}",
+		"<catalogItem><code>ABC-1234</code><quantity>0</quantity></catalogItem>":                  "quantity: value 0 must be at least 1",
+		"<catalogItem><code>ABC-1234</code><quantity>1000</quantity></catalogItem>":               "quantity: value 1000 must be less than 1000",
+		"<catalogItem discount=\"0\"><code>ABC-1234</code></catalogItem>":                         "ratio: value 0 must be greater than 0",
+		"<catalogItem discount=\"1.5\"><code>ABC-1234</code></catalogItem>":                       "ratio: value 1.5 must be at most 1",
+	} {
+		var item CatalogItem
+		assert.NoError(t, xml.Unmarshal([]byte(sample), &item))
+		assert.Equal(t, expected, fmt.Sprint(item.Validate()), sample)
+	}
+}
+
+func TestSubstitutionGroup(t *testing.T) {
+	var zoo ZooType
+	assert.NoError(t, xml.Unmarshal([]byte(`<zooType><name>z</name><cat lives="9"/><puppy breed="pug"/><bird/></zooType>`), &zoo))
+	if assert.Len(t, zoo.TnsAnimal, 3) {
+		cat, ok := zoo.TnsAnimal[0].Value.(*CatType)
+		if assert.True(t, ok) {
+			assert.Equal(t, 9, cat.LivesAttr)
+		}
+		dog, ok := zoo.TnsAnimal[1].Value.(*DogType)
+		if assert.True(t, ok) {
+			assert.Equal(t, "pug", dog.BreedAttr)
+		}
+		assert.Equal(t, "bird", zoo.TnsAnimal[2].XMLName.Local)
+		assert.Nil(t, zoo.TnsAnimal[2].Value)
+	}
+	zoo.TnsAnimal = append(zoo.TnsAnimal, AnimalSubstitution{Value: &DogType{}})
+	output, err := xml.Marshal(zoo)
+	assert.NoError(t, err)
+	assert.Equal(t, `<zooType><name>z</name><cat lives="9"></cat><puppy breed="pug"></puppy><dog></dog></zooType>`, string(output))
+}
+
+func TestAbstractType(t *testing.T) {
+	var invoice Invoice
+	assert.NoError(t, xml.Unmarshal([]byte(`<invoice xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><payment xsi:type="cardPayment"><amount>1.5</amount><cardNumber>4111</cardNumber></payment></invoice>`), &invoice))
+	if assert.Len(t, invoice.Payment, 1) {
+		card, ok := invoice.Payment[0].Value.(*CardPayment)
+		if assert.True(t, ok) {
+			assert.Equal(t, 1.5, card.Amount)
+			assert.Equal(t, "4111", card.CardNumber)
+		}
+	}
+	assert.EqualError(t, xml.Unmarshal([]byte(`<invoice><payment><amount>1</amount></payment></invoice>`), &invoice), "payment: the concrete type of the abstract type payment must be selected by xsi:type")
+}
+
+func TestNillable(t *testing.T) {
+	var reviewer Reviewer
+	assert.NoError(t, xml.Unmarshal([]byte(`<reviewer xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><nickname xsi:nil="true"/><mailAddress xsi:nil="true"/></reviewer>`), &reviewer))
+	if assert.NotNil(t, reviewer.Nickname) && assert.NotNil(t, reviewer.MailAddress) {
+		assert.True(t, reviewer.Nickname.Nil)
+		assert.True(t, reviewer.MailAddress.Nil)
+	}
+	assert.Nil(t, reviewer.Rating)
+
+	output, err := xml.Marshal(reviewer)
+	assert.NoError(t, err)
+	reviewer = Reviewer{}
+	assert.NoError(t, xml.Unmarshal(output, &reviewer))
+	if assert.NotNil(t, reviewer.Nickname, string(output)) && assert.NotNil(t, reviewer.MailAddress, string(output)) {
+		assert.True(t, reviewer.Nickname.Nil, string(output))
+		assert.True(t, reviewer.MailAddress.Nil, string(output))
+	}
+
+	reviewer = Reviewer{}
+	assert.NoError(t, xml.Unmarshal([]byte(`<reviewer><nickname></nickname><rating>9</rating></reviewer>`), &reviewer))
+	if assert.NotNil(t, reviewer.Nickname) && assert.NotNil(t, reviewer.Rating) {
+		assert.False(t, reviewer.Nickname.Nil)
+		assert.Equal(t, 9, reviewer.Rating.Value)
+		assert.Error(t, reviewer.Validate())
+		reviewer.Rating.Nil = true
+		assert.NoError(t, reviewer.Validate())
+	}
+}
+
+func TestAssertions(t *testing.T) {
+	defer func() { AssertTemperatureRange, AssertEvenNumber = nil, nil }()
+	temperatureRange := &TemperatureRange{Low: 10, High: 5}
+	assert.NoError(t, temperatureRange.Validate())
+	AssertTemperatureRange = func(v *TemperatureRange) error {
+		if v.Low > v.High {
+			return errors.New("low must not be greater than high")
+		}
+		return nil
+	}
+	assert.EqualError(t, temperatureRange.Validate(), "low must not be greater than high")
+	AssertEvenNumber = func(v EvenNumber) error {
+		if v%2 != 0 {
+			return errors.New("odd number")
+		}
+		return nil
+	}
+	assert.NoError(t, EvenNumber(2).Validate())
+	assert.EqualError(t, EvenNumber(3).Validate(), "odd number")
+}
+
+func TestValidate(t *testing.T) {
+	for sample, expected := range map[string]string{
+		`<bank code="1"><account id=""/></bank>`:                         "<nil>",
+		`<bank code="0"><account id="a" note="n"/></bank>`:               "<nil>",
+		`<bank><account id="a"/></bank>`:                                 "bank: required attribute code is absent",
+		`<bank code="1"><account id="a"/><account/></bank>`:              "bankAccount: required attribute id is absent",
+		`<bank code="1"><account id="a" legacy=""/></bank>`:              "bankAccount: prohibited attribute legacy is present",
+		`<bank code="1"><account id="a" unit="EUR"/><iso>1</iso></bank>`: "<nil>",
+		`<bank code="1"><account id="a" unit="USD"/></bank>`:             "bankAccount: attribute unit must have the fixed value EUR",
+		`<bank code="1"><account id="a"/><iso>2</iso></bank>`:            "bank: element iso must have the fixed value 1",
+		`<bank code="1" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><account xsi:type="premiumAccount" id="a" tier="gold"/></bank>`: "<nil>",
+		`<bank code="1" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><account xsi:type="premiumAccount" id="a"/></bank>`:             "premiumAccount: required attribute tier is absent",
+		`<bank code="1" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><account xsi:type="premiumAccount" tier="gold"/></bank>`:        "bankAccount: required attribute id is absent",
+	} {
+		var bank Bank
+		assert.NoError(t, xml.Unmarshal([]byte(sample), &bank))
+		assert.Equal(t, expected, fmt.Sprint(bank.Validate()), sample)
+	}
+}
+
+func TestConstructors(t *testing.T) {
+	setting := NewSetting(1, "timeout", WithSettingNoteAttr("seconds"), WithSettingRetries(5))
+	assert.Equal(t, 1, setting.IdAttr)
+	assert.Equal(t, "timeout", setting.Key)
+	assert.True(t, setting.EnabledAttr)
+	if assert.NotNil(t, setting.Level) && assert.NotNil(t, setting.Retries) {
+		assert.Equal(t, Level("low"), *setting.Level)
+		assert.Equal(t, 5, *setting.Retries)
+	}
+	assert.Equal(t, "seconds", setting.NoteAttr)
+
+	override := NewOverride(2, "timeout", "global")
+	assert.Equal(t, 2, override.IdAttr)
+	assert.Equal(t, "timeout", override.Key)
+	assert.Equal(t, "global", override.Scope)
+	if assert.NotNil(t, override.Retries) {
+		assert.Equal(t, 3, *override.Retries)
+	}
+}
+
+func TestDocument(t *testing.T) {
+	root, err := Unmarshal([]byte(`<order xmlns="http://example.com/order" id="7"><item>pen</item><item>ink</item></order>`))
+	assert.NoError(t, err)
+	assert.Equal(t, 7, root.IdAttr)
+	assert.Equal(t, []string{"pen", "ink"}, root.Item)
+	data, err := root.Marshal()
+	assert.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="ISO-8859-1"?>`+"\n"+`<order xmlns="http://example.com/order" id="7"><item xmlns="http://example.com/order">pen</item><item xmlns="http://example.com/order">ink</item></order>`, string(data))
+	_, err = Unmarshal([]byte(`<invoice xmlns="http://example.com/order"/>`))
+	assert.Error(t, err)
+}
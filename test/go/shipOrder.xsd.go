@@ -0,0 +1,65 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// OrderStatus ...
+type OrderStatus string
+
+// The enumerations of OrderStatus.
+const (
+	OrderStatusPending   OrderStatus = "pending"
+	OrderStatusInTransit OrderStatus = "in-transit"
+	OrderStatusDelivered OrderStatus = "delivered"
+)
+
+// String returns the value of the OrderStatus.
+func (v OrderStatus) String() string {
+	return string(v)
+}
+
+// ParseOrderStatus parses the OrderStatus value, an error is returned if
+// the value is not one of the enumerations.
+func ParseOrderStatus(s string) (OrderStatus, error) {
+	switch v := OrderStatus(s); v {
+	case OrderStatusPending, OrderStatusInTransit, OrderStatusDelivered:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid OrderStatus value %q", s)
+}
+
+// MarshalText encodes the OrderStatus value into the text.
+func (v OrderStatus) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText decodes the text into the OrderStatus value, an error is
+// returned if the text is not one of the enumerations.
+func (v *OrderStatus) UnmarshalText(text []byte) error {
+	value, err := ParseOrderStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*v = value
+	return nil
+}
+
+// ShipOrder ...
+type ShipOrder struct {
+	XMLName      xml.Name    `xml:"shipOrder"`
+	OrderidAttr  string      `xml:"orderid,attr"`
+	PriorityAttr int         `xml:"priority,attr,omitempty"`
+	OrderPerson  string      `xml:"orderPerson"`
+	Note         *string     `xml:"note,omitempty"`
+	Item         []string    `xml:"item"`
+	Status       OrderStatus `xml:"status"`
+}
@@ -0,0 +1,35 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+import (
+	"encoding/xml"
+)
+
+// TickerSymbol ...
+type TickerSymbol string
+
+// Money ...
+type Money struct {
+	XMLName  xml.Name `xml:"money"`
+	Amount   float64  `xml:"amount"`
+	Currency string   `xml:"currency"`
+}
+
+// TradePriceRequest ...
+type TradePriceRequest struct {
+	XMLName      xml.Name `xml:"tradePriceRequest"`
+	TickerSymbol string   `xml:"tickerSymbol"`
+}
+
+// TradePrice ...
+type TradePrice struct {
+	XMLName      xml.Name `xml:"tradePrice"`
+	TickerSymbol string   `xml:"tickerSymbol"`
+	Price        *Money   `xml:"price"`
+}
@@ -0,0 +1,73 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// StockLevel ...
+type StockLevel string
+
+// The enumerations of StockLevel.
+const (
+	StockLevelInStock      StockLevel = "in-stock"
+	StockLevelBackordered  StockLevel = "backordered"
+	StockLevelDiscontinued StockLevel = "discontinued"
+)
+
+// String returns the value of the StockLevel.
+func (v StockLevel) String() string {
+	return string(v)
+}
+
+// ParseStockLevel parses the StockLevel value, an error is returned if
+// the value is not one of the enumerations.
+func ParseStockLevel(s string) (StockLevel, error) {
+	switch v := StockLevel(s); v {
+	case StockLevelInStock, StockLevelBackordered, StockLevelDiscontinued:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid StockLevel value %q", s)
+}
+
+// MarshalText encodes the StockLevel value into the text.
+func (v StockLevel) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText decodes the text into the StockLevel value, an error is
+// returned if the text is not one of the enumerations.
+func (v *StockLevel) UnmarshalText(text []byte) error {
+	value, err := ParseStockLevel(string(text))
+	if err != nil {
+		return err
+	}
+	*v = value
+	return nil
+}
+
+// Location ...
+type Location struct {
+	XMLName    xml.Name `xml:"location"`
+	Street     string   `xml:"street"`
+	City       string   `xml:"city"`
+	PostalCode *string  `xml:"postalCode,omitempty"`
+}
+
+// Warehouse ...
+type Warehouse struct {
+	XMLName  xml.Name   `xml:"warehouse"`
+	CodeAttr string     `xml:"code,attr"`
+	Name     string     `xml:"name"`
+	Location *Location  `xml:"location"`
+	Sku      []string   `xml:"sku"`
+	Capacity int64      `xml:"capacity"`
+	Level    StockLevel `xml:"level"`
+}
@@ -0,0 +1,24 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+data class TemperatureRange(
+    val low: Int,
+    val high: Int
+)
+
+typealias EvenNumber = Int
+
+data class Reading(
+    val unitAttr: String? = null,
+    val value: Double
+)
+
+typealias Sensor = Reading
+
+typealias Measurement = TemperatureRange
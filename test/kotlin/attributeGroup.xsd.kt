@@ -0,0 +1,27 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+data class Product(
+    val priceAttr: Double? = null,
+    val skuAttr: String,
+    val idAttr: String,
+    val langAttr: String? = null,
+    val title: String
+)
+
+data class ProductAttrs(
+    val skuAttr: String,
+    val idAttr: String,
+    val langAttr: String? = null
+)
+
+data class CommonAttrs(
+    val idAttr: String,
+    val langAttr: String? = null
+)
@@ -0,0 +1,29 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+data class BankAccount(
+    val idAttr: String,
+    val legacyAttr: String? = null,
+    val noteAttr: String? = null,
+    val unitAttr: String? = null
+)
+
+data class PremiumAccount(
+    val idAttr: String,
+    val legacyAttr: String? = null,
+    val noteAttr: String? = null,
+    val unitAttr: String? = null,
+    val tierAttr: String
+)
+
+data class Bank(
+    val codeAttr: Int,
+    val account: List<BankAccount> = emptyList(),
+    val iso: Int? = null
+)
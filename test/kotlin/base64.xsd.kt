@@ -0,0 +1,26 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+typealias MyType1 = ByteArray
+
+data class MyType2(
+    val lengthAttr: Int? = null
+)
+
+data class MyType3(
+    val lengthAttr: Int? = null
+)
+
+data class MyType4(
+    val title: String,
+    val blob: ByteArray,
+    val timestamp: java.time.LocalDateTime
+)
+
+typealias MyType5 = String
@@ -0,0 +1,30 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+data class Circle(
+    val radiusAttr: Double? = null
+)
+
+data class Square(
+    val sideAttr: Double? = null
+)
+
+data class Shape(
+    val label: String,
+    val circle: Circle? = null,
+    val square: Square? = null,
+    val path: String? = null,
+    val fill: String? = null,
+    val stroke: Int? = null
+)
+
+data class Drawing(
+    val shape: List<Shape> = emptyList(),
+    val text: List<String> = emptyList()
+)
@@ -0,0 +1,43 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+data class Vehicle(
+    val vinAttr: String,
+    val make: String,
+    val year: Int
+)
+
+data class Car(
+    val vinAttr: String,
+    val make: String,
+    val year: Int,
+    val doors: Int,
+    val model: String? = null
+)
+
+data class SportsCar(
+    val vinAttr: String,
+    val make: String,
+    val year: Int,
+    val doors: Int,
+    val model: String? = null,
+    val topSpeed: Int
+)
+
+data class CompactCar(
+    val vinAttr: String,
+    val make: String,
+    val year: Int,
+    val doors: Int,
+    val model: String
+)
+
+data class Garage(
+    val vehicle: List<Vehicle> = emptyList()
+)
@@ -0,0 +1,27 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+enum class Color(val value: String) {
+    RED("red"),
+    GREEN("green"),
+    BLUE("blue");
+}
+
+typealias ProductCode = String
+
+typealias Quantity = Int
+
+typealias Ratio = Double
+
+data class CatalogItem(
+    val discountAttr: Double? = null,
+    val code: String,
+    val color: List<Color> = emptyList(),
+    val quantity: Int? = null
+)
@@ -0,0 +1,32 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+data class Customer(
+    val customerId: String,
+    val firstName: String,
+    val lastName: String,
+    val email: List<String> = emptyList()
+)
+
+data class Supplier(
+    val company: String,
+    val firstName: String? = null,
+    val lastName: String? = null,
+    val email: List<String> = emptyList()
+)
+
+data class PersonGroup(
+    val firstName: String,
+    val lastName: String,
+    val email: List<String> = emptyList()
+)
+
+data class ContactGroup(
+    val email: String
+)
@@ -0,0 +1,20 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+typealias MeasureList = List<Double>
+
+typealias YearList = List<String>
+
+typealias ShadeList = List<String>
+
+data class Swatch(
+    val yearsAttr: YearList? = null,
+    val measures: MeasureList,
+    val shades: ShadeList? = null
+)
@@ -0,0 +1,14 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+data class LetterBody(
+    val name: String,
+    val orderid: Int,
+    val text: List<String> = emptyList()
+)
@@ -0,0 +1,23 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+enum class Genre(val value: String) {
+    ROCK("rock"),
+    HIP_HOP("hip-hop"),
+    CLASSICAL("classical");
+}
+
+data class Playlist(
+    val idAttr: Int,
+    val sharedAttr: Boolean? = null,
+    val title: String,
+    val genre: Genre? = null,
+    val track: List<String> = emptyList(),
+    val rating: Double? = null
+)
@@ -0,0 +1,32 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+enum class Level(val value: String) {
+    LOW("low"),
+    HIGH("high");
+}
+
+data class Setting(
+    val idAttr: Int,
+    val enabledAttr: Boolean? = null,
+    val noteAttr: String? = null,
+    val key: String,
+    val retries: Int? = null,
+    val level: Level? = null
+)
+
+data class Override(
+    val idAttr: Int,
+    val enabledAttr: Boolean? = null,
+    val noteAttr: String? = null,
+    val key: String,
+    val retries: Int? = null,
+    val level: Level? = null,
+    val scope: String
+)
@@ -0,0 +1,23 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+enum class OrderStatus(val value: String) {
+    PENDING("pending"),
+    IN_TRANSIT("in-transit"),
+    DELIVERED("delivered");
+}
+
+data class ShipOrder(
+    val orderidAttr: String,
+    val priorityAttr: Int? = null,
+    val orderPerson: String,
+    val note: String? = null,
+    val item: List<String> = emptyList(),
+    val status: OrderStatus
+)
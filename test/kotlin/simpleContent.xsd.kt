@@ -0,0 +1,23 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+typealias AmountType = Double
+
+data class Price(
+    val currencyAttr: String
+)
+
+data class DiscountPrice(
+    val currencyAttr: String,
+    val discountAttr: Int? = null
+)
+
+data class LocalPrice(
+    val currencyAttr: String? = null
+)
@@ -0,0 +1,24 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+typealias TickerSymbol = String
+
+data class Money(
+    val amount: Double,
+    val currency: String
+)
+
+data class TradePriceRequest(
+    val tickerSymbol: String
+)
+
+data class TradePrice(
+    val tickerSymbol: String,
+    val price: Money
+)
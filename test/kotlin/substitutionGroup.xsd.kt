@@ -0,0 +1,37 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+data class AnimalType(
+    val idAttr: String? = null
+)
+
+data class CatType(
+    val idAttr: String? = null,
+    val livesAttr: Int? = null
+)
+
+data class DogType(
+    val idAttr: String? = null,
+    val breedAttr: String? = null
+)
+
+typealias Animal = AnimalType
+
+typealias Cat = CatType
+
+typealias Dog = DogType
+
+typealias Puppy = DogType
+
+data class ZooType(
+    val name: String,
+    val tnsAnimal: List<AnimalType> = emptyList()
+)
+
+typealias Zoo = ZooType
@@ -0,0 +1,30 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+enum class DimensionToken(val value: String) {
+    SMALL("small"),
+    LARGE("large");
+}
+
+data class Dimension(
+    val int: Int? = null,
+    val dimensionToken: DimensionToken? = null,
+    val gMonth: String? = null
+)
+
+data class TagValue(
+    val boolean: Boolean? = null,
+    val string: String? = null,
+    val decimal: Double? = null
+)
+
+data class Crate(
+    val tagAttr: TagValue? = null,
+    val dimension: Dimension
+)
@@ -0,0 +1,29 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+enum class StockLevel(val value: String) {
+    IN_STOCK("in-stock"),
+    BACKORDERED("backordered"),
+    DISCONTINUED("discontinued");
+}
+
+data class Location(
+    val street: String,
+    val city: String,
+    val postalCode: String? = null
+)
+
+data class Warehouse(
+    val codeAttr: String,
+    val name: String,
+    val location: Location,
+    val sku: List<String> = emptyList(),
+    val capacity: Long,
+    val level: StockLevel
+)
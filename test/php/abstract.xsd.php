@@ -0,0 +1,49 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class Payment
+{
+    public function __construct(
+        public readonly float $amount,
+    ) {
+    }
+}
+
+class CardPayment
+{
+    public function __construct(
+        public readonly float $amount,
+        public readonly string $cardNumber,
+    ) {
+    }
+}
+
+class CashPayment
+{
+    public function __construct(
+        public readonly float $amount,
+        public readonly ?string $currencyAttr = null,
+    ) {
+    }
+}
+
+class Invoice
+{
+    /**
+     * @param list<Payment> $payment
+     */
+    public function __construct(
+        public readonly array $payment = [],
+    ) {
+    }
+}
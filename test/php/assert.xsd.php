@@ -0,0 +1,30 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class TemperatureRange
+{
+    public function __construct(
+        public readonly int $low,
+        public readonly int $high,
+    ) {
+    }
+}
+
+class Reading
+{
+    public function __construct(
+        public readonly float $value,
+        public readonly ?string $unitAttr = null,
+    ) {
+    }
+}
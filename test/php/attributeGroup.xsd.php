@@ -0,0 +1,43 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class Product
+{
+    public function __construct(
+        public readonly string $skuAttr,
+        public readonly string $idAttr,
+        public readonly string $title,
+        public readonly ?float $priceAttr = null,
+        public readonly ?string $langAttr = null,
+    ) {
+    }
+}
+
+class ProductAttrs
+{
+    public function __construct(
+        public readonly string $skuAttr,
+        public readonly string $idAttr,
+        public readonly ?string $langAttr = null,
+    ) {
+    }
+}
+
+class CommonAttrs
+{
+    public function __construct(
+        public readonly string $idAttr,
+        public readonly ?string $langAttr = null,
+    ) {
+    }
+}
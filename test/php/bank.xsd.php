@@ -0,0 +1,48 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class BankAccount
+{
+    public function __construct(
+        public readonly string $idAttr,
+        public readonly ?string $legacyAttr = null,
+        public readonly ?string $noteAttr = null,
+        public readonly ?string $unitAttr = null,
+    ) {
+    }
+}
+
+class PremiumAccount
+{
+    public function __construct(
+        public readonly string $idAttr,
+        public readonly string $tierAttr,
+        public readonly ?string $legacyAttr = null,
+        public readonly ?string $noteAttr = null,
+        public readonly ?string $unitAttr = null,
+    ) {
+    }
+}
+
+class Bank
+{
+    /**
+     * @param list<BankAccount> $account
+     */
+    public function __construct(
+        public readonly int $codeAttr,
+        public readonly array $account = [],
+        public readonly ?int $iso = null,
+    ) {
+    }
+}
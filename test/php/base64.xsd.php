@@ -0,0 +1,40 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class MyType2
+{
+    public function __construct(
+        public readonly string $value,
+        public readonly ?int $lengthAttr = null,
+    ) {
+    }
+}
+
+class MyType3
+{
+    public function __construct(
+        public readonly \DateTimeImmutable $value,
+        public readonly ?int $lengthAttr = null,
+    ) {
+    }
+}
+
+class MyType4
+{
+    public function __construct(
+        public readonly string $title,
+        public readonly string $blob,
+        public readonly \DateTimeImmutable $timestamp,
+    ) {
+    }
+}
@@ -0,0 +1,54 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class Circle
+{
+    public function __construct(
+        public readonly ?float $radiusAttr = null,
+    ) {
+    }
+}
+
+class Square
+{
+    public function __construct(
+        public readonly ?float $sideAttr = null,
+    ) {
+    }
+}
+
+class Shape
+{
+    public function __construct(
+        public readonly string $label,
+        public readonly ?Circle $circle = null,
+        public readonly ?Square $square = null,
+        public readonly ?string $path = null,
+        public readonly ?string $fill = null,
+        public readonly ?int $stroke = null,
+    ) {
+    }
+}
+
+class Drawing
+{
+    /**
+     * @param list<Shape> $shape
+     * @param list<string> $text
+     */
+    public function __construct(
+        public readonly array $shape = [],
+        public readonly array $text = [],
+    ) {
+    }
+}
@@ -0,0 +1,23 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class Reminder
+{
+    public function __construct(
+        public readonly string $subject,
+        public readonly \DateInterval $interval,
+        public readonly ?\DateInterval $leadAttr = null,
+        public readonly ?\DateInterval $snooze = null,
+    ) {
+    }
+}
@@ -0,0 +1,70 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class Vehicle
+{
+    public function __construct(
+        public readonly string $vinAttr,
+        public readonly string $make,
+        public readonly int $year,
+    ) {
+    }
+}
+
+class Car
+{
+    public function __construct(
+        public readonly string $vinAttr,
+        public readonly string $make,
+        public readonly int $year,
+        public readonly int $doors,
+        public readonly ?string $model = null,
+    ) {
+    }
+}
+
+class SportsCar
+{
+    public function __construct(
+        public readonly string $vinAttr,
+        public readonly string $make,
+        public readonly int $year,
+        public readonly int $doors,
+        public readonly int $topSpeed,
+        public readonly ?string $model = null,
+    ) {
+    }
+}
+
+class CompactCar
+{
+    public function __construct(
+        public readonly string $vinAttr,
+        public readonly string $make,
+        public readonly int $year,
+        public readonly int $doors,
+        public readonly string $model,
+    ) {
+    }
+}
+
+class Garage
+{
+    /**
+     * @param list<Vehicle> $vehicle
+     */
+    public function __construct(
+        public readonly array $vehicle = [],
+    ) {
+    }
+}
@@ -0,0 +1,33 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+enum Color: string
+{
+    case Red = 'red';
+    case Green = 'green';
+    case Blue = 'blue';
+}
+
+class CatalogItem
+{
+    /**
+     * @param list<Color> $color
+     */
+    public function __construct(
+        public readonly string $code,
+        public readonly ?float $discountAttr = null,
+        public readonly array $color = [],
+        public readonly ?int $quantity = null,
+    ) {
+    }
+}
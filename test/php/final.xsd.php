@@ -0,0 +1,33 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+// final="restriction": derivation by restriction is prohibited
+// block="extension": substitution by extension is blocked
+class Account
+{
+    public function __construct(
+        public readonly string $number,
+        public readonly float $balance,
+    ) {
+    }
+}
+
+class SavingsAccount
+{
+    public function __construct(
+        public readonly string $number,
+        public readonly float $balance,
+        public readonly float $rate,
+    ) {
+    }
+}
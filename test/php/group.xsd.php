@@ -0,0 +1,61 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class Customer
+{
+    /**
+     * @param list<string> $email
+     */
+    public function __construct(
+        public readonly string $customerId,
+        public readonly string $firstName,
+        public readonly string $lastName,
+        public readonly array $email = [],
+    ) {
+    }
+}
+
+class Supplier
+{
+    /**
+     * @param list<string> $email
+     */
+    public function __construct(
+        public readonly string $company,
+        public readonly ?string $firstName = null,
+        public readonly ?string $lastName = null,
+        public readonly array $email = [],
+    ) {
+    }
+}
+
+class PersonGroup
+{
+    /**
+     * @param list<string> $email
+     */
+    public function __construct(
+        public readonly string $firstName,
+        public readonly string $lastName,
+        public readonly array $email = [],
+    ) {
+    }
+}
+
+class ContactGroup
+{
+    public function __construct(
+        public readonly string $email,
+    ) {
+    }
+}
@@ -0,0 +1,43 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class Part
+{
+    public function __construct(
+        public readonly string $skuAttr,
+        public readonly string $serial,
+    ) {
+    }
+}
+
+class InventoryBin
+{
+    public function __construct(
+        public readonly ?string $codeAttr = null,
+        public readonly ?string $skuAttr = null,
+    ) {
+    }
+}
+
+class Inventory
+{
+    /**
+     * @param list<Part> $part
+     * @param list<InventoryBin> $bin
+     */
+    public function __construct(
+        public readonly array $part = [],
+        public readonly array $bin = [],
+    ) {
+    }
+}
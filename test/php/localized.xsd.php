@@ -0,0 +1,33 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class BookTitle
+{
+    public function __construct(
+        public readonly string $value,
+        public readonly ?string $xmlLangAttr = null,
+    ) {
+    }
+}
+
+class Book
+{
+    /**
+     * @param list<BookTitle> $title
+     */
+    public function __construct(
+        public readonly string $isbn,
+        public readonly array $title = [],
+    ) {
+    }
+}
@@ -0,0 +1,34 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class MailAddress
+{
+    public function __construct(
+        public readonly string $city,
+    ) {
+    }
+}
+
+class Reviewer
+{
+    /**
+     * @param list<string> $tags
+     */
+    public function __construct(
+        public readonly string $nickname,
+        public readonly ?int $rating = null,
+        public readonly ?MailAddress $mailAddress = null,
+        public readonly array $tags = [],
+    ) {
+    }
+}
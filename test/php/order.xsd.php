@@ -0,0 +1,24 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class OrderType
+{
+    /**
+     * @param list<string> $item
+     */
+    public function __construct(
+        public readonly ?int $idAttr = null,
+        public readonly array $item = [],
+    ) {
+    }
+}
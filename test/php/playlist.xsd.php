@@ -0,0 +1,35 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+enum Genre: string
+{
+    case Rock = 'rock';
+    case HipHop = 'hip-hop';
+    case Classical = 'classical';
+}
+
+class Playlist
+{
+    /**
+     * @param list<string> $track
+     */
+    public function __construct(
+        public readonly int $idAttr,
+        public readonly string $title,
+        public readonly ?bool $sharedAttr = null,
+        public readonly ?Genre $genre = null,
+        public readonly array $track = [],
+        public readonly ?float $rating = null,
+    ) {
+    }
+}
@@ -0,0 +1,45 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+enum Level: string
+{
+    case Low = 'low';
+    case High = 'high';
+}
+
+class Setting
+{
+    public function __construct(
+        public readonly int $idAttr,
+        public readonly string $key,
+        public readonly ?bool $enabledAttr = null,
+        public readonly ?string $noteAttr = null,
+        public readonly ?int $retries = null,
+        public readonly ?Level $level = null,
+    ) {
+    }
+}
+
+class Override
+{
+    public function __construct(
+        public readonly int $idAttr,
+        public readonly string $key,
+        public readonly string $scope,
+        public readonly ?bool $enabledAttr = null,
+        public readonly ?string $noteAttr = null,
+        public readonly ?int $retries = null,
+        public readonly ?Level $level = null,
+    ) {
+    }
+}
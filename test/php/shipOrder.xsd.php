@@ -0,0 +1,35 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+enum OrderStatus: string
+{
+    case Pending = 'pending';
+    case InTransit = 'in-transit';
+    case Delivered = 'delivered';
+}
+
+class ShipOrder
+{
+    /**
+     * @param list<string> $item
+     */
+    public function __construct(
+        public readonly string $orderidAttr,
+        public readonly string $orderPerson,
+        public readonly OrderStatus $status,
+        public readonly ?int $priorityAttr = null,
+        public readonly ?string $note = null,
+        public readonly array $item = [],
+    ) {
+    }
+}
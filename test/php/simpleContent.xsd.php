@@ -0,0 +1,38 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class Price
+{
+    public function __construct(
+        public readonly string $currencyAttr,
+        public readonly float $value,
+    ) {
+    }
+}
+
+class DiscountPrice
+{
+    public function __construct(
+        public readonly string $currencyAttr,
+        public readonly ?int $discountAttr = null,
+    ) {
+    }
+}
+
+class LocalPrice
+{
+    public function __construct(
+        public readonly ?string $currencyAttr = null,
+    ) {
+    }
+}
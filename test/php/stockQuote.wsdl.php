@@ -0,0 +1,38 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class Money
+{
+    public function __construct(
+        public readonly float $amount,
+        public readonly string $currency,
+    ) {
+    }
+}
+
+class TradePriceRequest
+{
+    public function __construct(
+        public readonly string $tickerSymbol,
+    ) {
+    }
+}
+
+class TradePrice
+{
+    public function __construct(
+        public readonly string $tickerSymbol,
+        public readonly Money $price,
+    ) {
+    }
+}
@@ -0,0 +1,25 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class Subscription
+{
+    /**
+     * @param list<string> $topic
+     */
+    public function __construct(
+        public readonly string $email,
+        public readonly bool $active,
+        public readonly array $topic = [],
+    ) {
+    }
+}
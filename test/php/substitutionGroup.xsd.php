@@ -0,0 +1,50 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class AnimalType
+{
+    public function __construct(
+        public readonly ?string $idAttr = null,
+    ) {
+    }
+}
+
+class CatType
+{
+    public function __construct(
+        public readonly ?string $idAttr = null,
+        public readonly ?int $livesAttr = null,
+    ) {
+    }
+}
+
+class DogType
+{
+    public function __construct(
+        public readonly ?string $idAttr = null,
+        public readonly ?string $breedAttr = null,
+    ) {
+    }
+}
+
+class ZooType
+{
+    /**
+     * @param list<AnimalType> $tnsAnimal
+     */
+    public function __construct(
+        public readonly string $name,
+        public readonly array $tnsAnimal = [],
+    ) {
+    }
+}
@@ -0,0 +1,45 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+enum StockLevel: string
+{
+    case InStock = 'in-stock';
+    case Backordered = 'backordered';
+    case Discontinued = 'discontinued';
+}
+
+class Location
+{
+    public function __construct(
+        public readonly string $street,
+        public readonly string $city,
+        public readonly ?string $postalCode = null,
+    ) {
+    }
+}
+
+class Warehouse
+{
+    /**
+     * @param list<string> $sku
+     */
+    public function __construct(
+        public readonly string $codeAttr,
+        public readonly string $name,
+        public readonly Location $location,
+        public readonly int $capacity,
+        public readonly StockLevel $level,
+        public readonly array $sku = [],
+    ) {
+    }
+}
@@ -0,0 +1,29 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+enum TokenSize: string
+{
+    case Small = 'small';
+    case Large = 'large';
+}
+
+class Caption
+{
+    public function __construct(
+        public readonly string $code,
+        public readonly string $text,
+        public readonly TokenSize $size,
+        public readonly ?string $langAttr = null,
+    ) {
+    }
+}
@@ -0,0 +1,34 @@
+<?php
+
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+declare(strict_types=1);
+
+namespace Schema;
+
+class Extensible
+{
+    /**
+     * @param list<string> $any
+     */
+    public function __construct(
+        public readonly string $id,
+        public readonly ?string $versionAttr = null,
+        public readonly array $any = [],
+    ) {
+    }
+}
+
+class Envelope
+{
+    public function __construct(
+        public readonly string $header,
+        public readonly string $any,
+    ) {
+    }
+}
@@ -0,0 +1,30 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+
+
+@dataclasses.dataclass(kw_only=True)
+class Payment:
+    amount: float
+
+
+@dataclasses.dataclass(kw_only=True)
+class CardPayment(Payment):
+    card_number: str
+
+
+@dataclasses.dataclass(kw_only=True)
+class CashPayment(Payment):
+    currency_attr: str | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class Invoice:
+    payment: list[Payment] = dataclasses.field(default_factory=list)
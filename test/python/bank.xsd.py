@@ -0,0 +1,30 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+
+
+@dataclasses.dataclass(kw_only=True)
+class BankAccount:
+    id_attr: str
+    legacy_attr: str | None = None
+    note_attr: str | None = None
+    unit_attr: str | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class PremiumAccount(BankAccount):
+    tier_attr: str
+
+
+@dataclasses.dataclass(kw_only=True)
+class Bank:
+    code_attr: int
+    account: list[BankAccount] = dataclasses.field(default_factory=list)
+    iso: int | None = None
@@ -0,0 +1,36 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+
+
+@dataclasses.dataclass(kw_only=True)
+class Circle:
+    radius_attr: float | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class Square:
+    side_attr: float | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class Shape:
+    label: str
+    circle: Circle | None = None
+    square: Square | None = None
+    path: str | None = None
+    fill: str | None = None
+    stroke: int | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class Drawing:
+    shape: list[Shape] = dataclasses.field(default_factory=list)
+    text: list[str] = dataclasses.field(default_factory=list)
@@ -0,0 +1,22 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+import datetime
+
+
+LeadTime = datetime.timedelta
+
+
+@dataclasses.dataclass(kw_only=True)
+class Reminder:
+    lead_attr: datetime.timedelta | None = None
+    subject: str
+    interval: datetime.timedelta
+    snooze: datetime.timedelta | None = None
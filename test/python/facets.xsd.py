@@ -0,0 +1,34 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+import enum
+
+
+class Color(str, enum.Enum):
+    RED = "red"
+    GREEN = "green"
+    BLUE = "blue"
+
+
+ProductCode = str
+
+
+Quantity = int
+
+
+Ratio = float
+
+
+@dataclasses.dataclass(kw_only=True)
+class CatalogItem:
+    discount_attr: float | None = None
+    code: str
+    color: list[Color] = dataclasses.field(default_factory=list)
+    quantity: int | None = None
@@ -0,0 +1,28 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+
+
+@dataclasses.dataclass(kw_only=True)
+class Part:
+    sku_attr: str
+    serial: str
+
+
+@dataclasses.dataclass(kw_only=True)
+class InventoryBin:
+    code_attr: str | None = None
+    sku_attr: str | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class Inventory:
+    part: list[Part] = dataclasses.field(default_factory=list)
+    bin: list[InventoryBin] = dataclasses.field(default_factory=list)
@@ -0,0 +1,26 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+
+
+MeasureList = list[float]
+
+
+YearList = list[str]
+
+
+ShadeList = list[str]
+
+
+@dataclasses.dataclass(kw_only=True)
+class Swatch:
+    years_attr: YearList | None = None
+    measures: MeasureList
+    shades: ShadeList | None = None
@@ -0,0 +1,26 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+
+
+RatingType = int
+
+
+@dataclasses.dataclass(kw_only=True)
+class MailAddress:
+    city: str
+
+
+@dataclasses.dataclass(kw_only=True)
+class Reviewer:
+    nickname: str
+    rating: int | None = None
+    mail_address: MailAddress | None = None
+    tags: list[str] = dataclasses.field(default_factory=list)
@@ -0,0 +1,27 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+import enum
+
+
+class Genre(str, enum.Enum):
+    ROCK = "rock"
+    HIP_HOP = "hip-hop"
+    CLASSICAL = "classical"
+
+
+@dataclasses.dataclass(kw_only=True)
+class Playlist:
+    id_attr: int
+    shared_attr: bool | None = None
+    title: str
+    genre: Genre | None = None
+    track: list[str] = dataclasses.field(default_factory=list)
+    rating: float | None = None
@@ -0,0 +1,31 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+import enum
+
+
+class Level(str, enum.Enum):
+    LOW = "low"
+    HIGH = "high"
+
+
+@dataclasses.dataclass(kw_only=True)
+class Setting:
+    id_attr: int
+    enabled_attr: bool | None = None
+    note_attr: str | None = None
+    key: str
+    retries: int | None = None
+    level: Level | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class Override(Setting):
+    scope: str
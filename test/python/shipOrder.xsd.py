@@ -0,0 +1,27 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+import enum
+
+
+class OrderStatus(str, enum.Enum):
+    PENDING = "pending"
+    IN_TRANSIT = "in-transit"
+    DELIVERED = "delivered"
+
+
+@dataclasses.dataclass(kw_only=True)
+class ShipOrder:
+    orderid_attr: str
+    priority_attr: int | None = None
+    order_person: str
+    note: str | None = None
+    item: list[str] = dataclasses.field(default_factory=list)
+    status: OrderStatus
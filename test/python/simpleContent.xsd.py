@@ -0,0 +1,29 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+
+
+AmountType = float
+
+
+@dataclasses.dataclass(kw_only=True)
+class Price:
+    currency_attr: str
+    value: float
+
+
+@dataclasses.dataclass(kw_only=True)
+class DiscountPrice(Price):
+    discount_attr: int | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class LocalPrice:
+    currency_attr: str | None = None
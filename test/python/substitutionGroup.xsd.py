@@ -0,0 +1,31 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+
+
+@dataclasses.dataclass(kw_only=True)
+class AnimalType:
+    id_attr: str | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class CatType(AnimalType):
+    lives_attr: int | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class DogType(AnimalType):
+    breed_attr: str | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class ZooType:
+    name: str
+    tns_animal: list[AnimalType] = dataclasses.field(default_factory=list)
@@ -0,0 +1,36 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+import enum
+
+
+class DimensionToken(str, enum.Enum):
+    SMALL = "small"
+    LARGE = "large"
+
+
+@dataclasses.dataclass(kw_only=True)
+class Dimension:
+    dimension_token: DimensionToken | None = None
+    g_month: str | None = None
+    int: int | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class TagValue:
+    boolean: bool | None = None
+    decimal: float | None = None
+    string: str | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class Crate:
+    tag_attr: TagValue | None = None
+    dimension: Dimension
@@ -0,0 +1,34 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+from __future__ import annotations
+
+import dataclasses
+import enum
+
+
+class StockLevel(str, enum.Enum):
+    IN_STOCK = "in-stock"
+    BACKORDERED = "backordered"
+    DISCONTINUED = "discontinued"
+
+
+@dataclasses.dataclass(kw_only=True)
+class Location:
+    street: str
+    city: str
+    postal_code: str | None = None
+
+
+@dataclasses.dataclass(kw_only=True)
+class Warehouse:
+    code_attr: str
+    name: str
+    location: Location
+    sku: list[str] = dataclasses.field(default_factory=list)
+    capacity: int
+    level: StockLevel
@@ -0,0 +1,32 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Payment {
+    #[serde(rename = "amount")]
+    pub Amount: f64,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct CardPayment {
+    #[serde(rename = "cardNumber")]
+    pub CardNumber: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct CashPayment {
+    #[serde(rename = "currency", default, skip_serializing_if = "Option::is_none")]
+    pub Currency: Option<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Invoice {
+    #[serde(rename = "payment")]
+    pub Payment: Vec<Payment>,
+}
@@ -0,0 +1,44 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+// assert: low le high
+#[derive(Debug, Serialize, Deserialize)]
+struct TemperatureRange {
+    #[serde(rename = "low")]
+    pub Low: isize,
+    #[serde(rename = "high")]
+    pub High: isize,
+}
+
+// assert: $value mod 2 = 0
+#[derive(Debug, Serialize, Deserialize)]
+struct EvenNumber {
+    #[serde(rename = "evenNumber")]
+    pub EvenNumber: isize,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Reading {
+    #[serde(rename = "unit", default, skip_serializing_if = "Option::is_none")]
+    pub Unit: Option<char>,
+    #[serde(rename = "value")]
+    pub Value: f64,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Sensor {
+    #[serde(rename = "sensor")]
+    pub Sensor: Reading,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Measurement {
+    #[serde(rename = "measurement")]
+    pub Measurement: TemperatureRange,
+}
@@ -0,0 +1,40 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Product {
+    #[serde(rename = "price", default, skip_serializing_if = "Option::is_none")]
+    pub Price: Option<f64>,
+    #[serde(rename = "sku")]
+    pub Sku: char,
+    #[serde(rename = "id")]
+    pub Id: char,
+    #[serde(rename = "lang", default, skip_serializing_if = "Option::is_none")]
+    pub Lang: Option<char>,
+    #[serde(rename = "title")]
+    pub Title: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct ProductAttrs {
+    #[serde(rename = "sku")]
+    pub Sku: char,
+    #[serde(rename = "id")]
+    pub Id: char,
+    #[serde(rename = "lang", default, skip_serializing_if = "Option::is_none")]
+    pub Lang: Option<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct CommonAttrs {
+    #[serde(rename = "id")]
+    pub Id: char,
+    #[serde(rename = "lang", default, skip_serializing_if = "Option::is_none")]
+    pub Lang: Option<char>,
+}
@@ -0,0 +1,36 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct BankAccount {
+    #[serde(rename = "id")]
+    pub Id: char,
+    #[serde(rename = "legacy", default, skip_serializing_if = "Option::is_none")]
+    pub Legacy: Option<char>,
+    #[serde(rename = "note", default, skip_serializing_if = "Option::is_none")]
+    pub Note: Option<char>,
+    #[serde(rename = "unit", default, skip_serializing_if = "Option::is_none")]
+    pub Unit: Option<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct PremiumAccount {
+    #[serde(rename = "tier")]
+    pub Tier: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Bank {
+    #[serde(rename = "code")]
+    pub Code: isize,
+    #[serde(rename = "account")]
+    pub Account: Vec<BankAccount>,
+    #[serde(rename = "iso", default, skip_serializing_if = "Option::is_none")]
+    pub Iso: Option<isize>,
+}
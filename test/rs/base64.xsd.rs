@@ -0,0 +1,42 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct MyType1 {
+    #[serde(rename = "myType1")]
+    pub MyType1: Vec<u8>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct MyType2 {
+    #[serde(rename = "length", default, skip_serializing_if = "Option::is_none")]
+    pub Length: Option<isize>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct MyType3 {
+    #[serde(rename = "length", default, skip_serializing_if = "Option::is_none")]
+    pub Length: Option<isize>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct MyType4 {
+    #[serde(rename = "title")]
+    pub Title: char,
+    #[serde(rename = "blob")]
+    pub Blob: Vec<u8>,
+    #[serde(rename = "timestamp")]
+    pub Timestamp: &[u8],
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct MyType5 {
+    #[serde(rename = "myType5")]
+    pub MyType5: char,
+}
@@ -0,0 +1,44 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Circle {
+    #[serde(rename = "radius", default, skip_serializing_if = "Option::is_none")]
+    pub Radius: Option<f64>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Square {
+    #[serde(rename = "side", default, skip_serializing_if = "Option::is_none")]
+    pub Side: Option<f64>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Shape {
+    #[serde(rename = "label")]
+    pub Label: char,
+    #[serde(rename = "circle", default, skip_serializing_if = "Option::is_none")]
+    pub Circle: Option<Circle>,
+    #[serde(rename = "square", default, skip_serializing_if = "Option::is_none")]
+    pub Square: Option<Square>,
+    #[serde(rename = "path", default, skip_serializing_if = "Option::is_none")]
+    pub Path: Option<char>,
+    #[serde(rename = "fill", default, skip_serializing_if = "Option::is_none")]
+    pub Fill: Option<char>,
+    #[serde(rename = "stroke", default, skip_serializing_if = "Option::is_none")]
+    pub Stroke: Option<isize>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Drawing {
+    #[serde(rename = "shape", default, skip_serializing_if = "Vec::is_empty")]
+    pub Shape: Vec<Shape>,
+    #[serde(rename = "text", default, skip_serializing_if = "Vec::is_empty")]
+    pub Text: Vec<char>,
+}
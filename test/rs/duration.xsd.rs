@@ -0,0 +1,26 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct LeadTime {
+    #[serde(rename = "leadTime")]
+    pub LeadTime: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Reminder {
+    #[serde(rename = "lead", default, skip_serializing_if = "Option::is_none")]
+    pub Lead: Option<char>,
+    #[serde(rename = "subject")]
+    pub Subject: char,
+    #[serde(rename = "interval")]
+    pub Interval: char,
+    #[serde(rename = "snooze", default, skip_serializing_if = "Option::is_none")]
+    pub Snooze: Option<char>,
+}
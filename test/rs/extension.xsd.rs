@@ -0,0 +1,52 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Vehicle {
+    #[serde(rename = "vin")]
+    pub Vin: char,
+    #[serde(rename = "make")]
+    pub Make: char,
+    #[serde(rename = "year")]
+    pub Year: isize,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Car {
+    #[serde(rename = "doors")]
+    pub Doors: isize,
+    #[serde(rename = "model", default, skip_serializing_if = "Option::is_none")]
+    pub Model: Option<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct SportsCar {
+    #[serde(rename = "topSpeed")]
+    pub TopSpeed: isize,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct CompactCar {
+    #[serde(rename = "vin")]
+    pub Vin: char,
+    #[serde(rename = "make")]
+    pub Make: char,
+    #[serde(rename = "year")]
+    pub Year: isize,
+    #[serde(rename = "doors")]
+    pub Doors: isize,
+    #[serde(rename = "model")]
+    pub Model: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Garage {
+    #[serde(rename = "vehicle")]
+    pub Vehicle: Vec<Vehicle>,
+}
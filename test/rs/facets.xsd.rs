@@ -0,0 +1,48 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Serialize, Deserialize)]
+enum Color {
+    #[serde(rename = "red")]
+    Red,
+    #[serde(rename = "green")]
+    Green,
+    #[serde(rename = "blue")]
+    Blue,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct ProductCode {
+    #[serde(rename = "productCode")]
+    pub ProductCode: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Quantity {
+    #[serde(rename = "quantity")]
+    pub Quantity: isize,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Ratio {
+    #[serde(rename = "ratio")]
+    pub Ratio: f64,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct CatalogItem {
+    #[serde(rename = "discount", default, skip_serializing_if = "Option::is_none")]
+    pub Discount: Option<f64>,
+    #[serde(rename = "code")]
+    pub Code: char,
+    #[serde(rename = "color")]
+    pub Color: Vec<Color>,
+    #[serde(rename = "quantity", default, skip_serializing_if = "Option::is_none")]
+    pub Quantity: Option<isize>,
+}
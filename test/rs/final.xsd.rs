@@ -0,0 +1,39 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+// final="#all": derivation is prohibited
+#[derive(Debug, Serialize, Deserialize)]
+struct AccountNumber {
+    #[serde(rename = "accountNumber")]
+    pub AccountNumber: char,
+}
+
+// final="restriction": derivation by restriction is prohibited
+// block="extension": substitution by extension is blocked
+#[derive(Debug, Serialize, Deserialize)]
+struct Account {
+    #[serde(rename = "number")]
+    pub Number: char,
+    #[serde(rename = "balance")]
+    pub Balance: f64,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct SavingsAccount {
+    #[serde(rename = "rate")]
+    pub Rate: f64,
+}
+
+// final="extension": derivation by extension is prohibited
+// block="restriction substitution": substitution by restriction or substitution group members is blocked
+#[derive(Debug, Serialize, Deserialize)]
+struct PrimaryAccount {
+    #[serde(rename = "primaryAccount")]
+    pub PrimaryAccount: Account,
+}
@@ -0,0 +1,48 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Customer {
+    #[serde(rename = "customerId")]
+    pub CustomerId: char,
+    #[serde(rename = "firstName")]
+    pub FirstName: char,
+    #[serde(rename = "lastName")]
+    pub LastName: char,
+    #[serde(rename = "email")]
+    pub Email: Vec<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Supplier {
+    #[serde(rename = "company")]
+    pub Company: char,
+    #[serde(rename = "firstName", default, skip_serializing_if = "Option::is_none")]
+    pub FirstName: Option<char>,
+    #[serde(rename = "lastName", default, skip_serializing_if = "Option::is_none")]
+    pub LastName: Option<char>,
+    #[serde(rename = "email", default, skip_serializing_if = "Vec::is_empty")]
+    pub Email: Vec<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct PersonGroup {
+    #[serde(rename = "firstName")]
+    pub FirstName: char,
+    #[serde(rename = "lastName")]
+    pub LastName: char,
+    #[serde(rename = "email")]
+    pub Email: Vec<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct ContactGroup {
+    #[serde(rename = "email")]
+    pub Email: char,
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Part {
+    #[serde(rename = "sku")]
+    pub Sku: char,
+    #[serde(rename = "serial")]
+    pub Serial: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct InventoryBin {
+    #[serde(rename = "code", default, skip_serializing_if = "Option::is_none")]
+    pub Code: Option<char>,
+    #[serde(rename = "sku", default, skip_serializing_if = "Option::is_none")]
+    pub Sku: Option<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Inventory {
+    #[serde(rename = "part")]
+    pub Part: Vec<Part>,
+    #[serde(rename = "bin")]
+    pub Bin: Vec<InventoryBin>,
+}
@@ -0,0 +1,36 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct MeasureList {
+    #[serde(rename = "measureList")]
+    pub MeasureList: Vec<f64>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct YearList {
+    #[serde(rename = "yearList")]
+    pub YearList: Vec<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct ShadeList {
+    #[serde(rename = "shadeList")]
+    pub ShadeList: Vec<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Swatch {
+    #[serde(rename = "years", default, skip_serializing_if = "Option::is_none")]
+    pub Years: Option<YearList>,
+    #[serde(rename = "measures")]
+    pub Measures: MeasureList,
+    #[serde(rename = "shades", default, skip_serializing_if = "Option::is_none")]
+    pub Shades: Option<ShadeList>,
+}
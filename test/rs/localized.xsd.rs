@@ -0,0 +1,22 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct BookTitle {
+    #[serde(rename = "xml:lang", default, skip_serializing_if = "Option::is_none")]
+    pub XmlLang: Option<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Book {
+    #[serde(rename = "title")]
+    pub Title: Vec<BookTitle>,
+    #[serde(rename = "isbn")]
+    pub Isbn: char,
+}
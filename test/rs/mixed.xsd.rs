@@ -0,0 +1,18 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct LetterBody {
+    #[serde(rename = "name")]
+    pub Name: char,
+    #[serde(rename = "orderid")]
+    pub Orderid: isize,
+    #[serde(rename = "$text", default)]
+    pub Value: Option<String>,
+}
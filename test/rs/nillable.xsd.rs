@@ -0,0 +1,32 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct RatingType {
+    #[serde(rename = "ratingType")]
+    pub RatingType: isize,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct MailAddress {
+    #[serde(rename = "city")]
+    pub City: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Reviewer {
+    #[serde(rename = "nickname", default, skip_serializing_if = "Option::is_none")]
+    pub Nickname: Option<char>,
+    #[serde(rename = "rating", default, skip_serializing_if = "Option::is_none")]
+    pub Rating: Option<isize>,
+    #[serde(rename = "mailAddress", default, skip_serializing_if = "Option::is_none")]
+    pub MailAddress: Option<MailAddress>,
+    #[serde(rename = "tags", default, skip_serializing_if = "Vec::is_empty")]
+    pub Tags: Vec<char>,
+}
@@ -0,0 +1,22 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct OrderType {
+    #[serde(rename = "id", default, skip_serializing_if = "Option::is_none")]
+    pub Id: Option<isize>,
+    #[serde(rename = "item")]
+    pub Item: Vec<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Order {
+    #[serde(rename = "order")]
+    pub Order: OrderType,
+}
@@ -0,0 +1,34 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Serialize, Deserialize)]
+enum Genre {
+    #[serde(rename = "rock")]
+    Rock,
+    #[serde(rename = "hip-hop")]
+    HipHop,
+    #[serde(rename = "classical")]
+    Classical,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Playlist {
+    #[serde(rename = "id")]
+    pub Id: isize,
+    #[serde(rename = "shared", default, skip_serializing_if = "Option::is_none")]
+    pub Shared: Option<bool>,
+    #[serde(rename = "title")]
+    pub Title: char,
+    #[serde(rename = "genre", default, skip_serializing_if = "Option::is_none")]
+    pub Genre: Option<Genre>,
+    #[serde(rename = "track", default, skip_serializing_if = "Vec::is_empty")]
+    pub Track: Vec<char>,
+    #[serde(rename = "rating", default, skip_serializing_if = "Option::is_none")]
+    pub Rating: Option<f64>,
+}
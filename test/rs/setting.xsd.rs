@@ -0,0 +1,38 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Serialize, Deserialize)]
+enum Level {
+    #[serde(rename = "low")]
+    Low,
+    #[serde(rename = "high")]
+    High,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Setting {
+    #[serde(rename = "id")]
+    pub Id: isize,
+    #[serde(rename = "enabled", default, skip_serializing_if = "Option::is_none")]
+    pub Enabled: Option<bool>,
+    #[serde(rename = "note", default, skip_serializing_if = "Option::is_none")]
+    pub Note: Option<char>,
+    #[serde(rename = "key")]
+    pub Key: char,
+    #[serde(rename = "retries", default, skip_serializing_if = "Option::is_none")]
+    pub Retries: Option<isize>,
+    #[serde(rename = "level", default, skip_serializing_if = "Option::is_none")]
+    pub Level: Option<Level>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Override {
+    #[serde(rename = "scope")]
+    pub Scope: char,
+}
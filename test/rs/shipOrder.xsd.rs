@@ -0,0 +1,34 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Serialize, Deserialize)]
+enum OrderStatus {
+    #[serde(rename = "pending")]
+    Pending,
+    #[serde(rename = "in-transit")]
+    InTransit,
+    #[serde(rename = "delivered")]
+    Delivered,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct ShipOrder {
+    #[serde(rename = "orderid")]
+    pub Orderid: char,
+    #[serde(rename = "priority", default, skip_serializing_if = "Option::is_none")]
+    pub Priority: Option<isize>,
+    #[serde(rename = "orderPerson")]
+    pub OrderPerson: char,
+    #[serde(rename = "note", default, skip_serializing_if = "Option::is_none")]
+    pub Note: Option<char>,
+    #[serde(rename = "item")]
+    pub Item: Vec<char>,
+    #[serde(rename = "status")]
+    pub Status: OrderStatus,
+}
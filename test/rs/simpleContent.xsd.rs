@@ -0,0 +1,32 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct AmountType {
+    #[serde(rename = "amountType")]
+    pub AmountType: f64,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Price {
+    #[serde(rename = "currency")]
+    pub Currency: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct DiscountPrice {
+    #[serde(rename = "discount", default, skip_serializing_if = "Option::is_none")]
+    pub Discount: Option<isize>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct LocalPrice {
+    #[serde(rename = "currency", default, skip_serializing_if = "Option::is_none")]
+    pub Currency: Option<char>,
+}
@@ -0,0 +1,36 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct TickerSymbol {
+    #[serde(rename = "tickerSymbol")]
+    pub TickerSymbol: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Money {
+    #[serde(rename = "amount")]
+    pub Amount: f64,
+    #[serde(rename = "currency")]
+    pub Currency: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct TradePriceRequest {
+    #[serde(rename = "tickerSymbol")]
+    pub TickerSymbol: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct TradePrice {
+    #[serde(rename = "tickerSymbol")]
+    pub TickerSymbol: char,
+    #[serde(rename = "price")]
+    pub Price: Money,
+}
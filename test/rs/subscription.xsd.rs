@@ -0,0 +1,18 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Subscription {
+    #[serde(rename = "email")]
+    pub Email: char,
+    #[serde(rename = "active")]
+    pub Active: bool,
+    #[serde(rename = "topic")]
+    pub Topic: Vec<char>,
+}
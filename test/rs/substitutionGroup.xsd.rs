@@ -0,0 +1,82 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct AnimalType {
+    #[serde(rename = "id", default, skip_serializing_if = "Option::is_none")]
+    pub Id: Option<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct CatType {
+    #[serde(rename = "lives", default, skip_serializing_if = "Option::is_none")]
+    pub Lives: Option<isize>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct DogType {
+    #[serde(rename = "breed", default, skip_serializing_if = "Option::is_none")]
+    pub Breed: Option<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Animal {
+    #[serde(rename = "animal")]
+    pub Animal: AnimalType,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+enum AnimalSubstitution {
+    #[serde(rename = "cat")]
+    Cat(CatType),
+    #[serde(rename = "dog")]
+    Dog(DogType),
+    #[serde(rename = "puppy")]
+    Puppy(DogType),
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Cat {
+    #[serde(rename = "cat")]
+    pub Cat: CatType,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Dog {
+    #[serde(rename = "dog")]
+    pub Dog: DogType,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+enum DogSubstitution {
+    #[serde(rename = "dog")]
+    Dog(DogType),
+    #[serde(rename = "puppy")]
+    Puppy(DogType),
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Puppy {
+    #[serde(rename = "puppy")]
+    pub Puppy: DogType,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct ZooType {
+    #[serde(rename = "name")]
+    pub Name: char,
+    #[serde(rename = "$value")]
+    pub TnsAnimal: Vec<AnimalSubstitution>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Zoo {
+    #[serde(rename = "zoo")]
+    pub Zoo: ZooType,
+}
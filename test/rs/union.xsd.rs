@@ -0,0 +1,40 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Serialize, Deserialize)]
+enum DimensionToken {
+    #[serde(rename = "small")]
+    Small,
+    #[serde(rename = "large")]
+    Large,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+#[serde(untagged)]
+enum Dimension {
+    Int(isize),
+    DimensionToken(DimensionToken),
+    GMonth(char),
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+#[serde(untagged)]
+enum TagValue {
+    Boolean(bool),
+    String(char),
+    Decimal(f64),
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Crate {
+    #[serde(rename = "tag", default, skip_serializing_if = "Option::is_none")]
+    pub Tag: Option<TagValue>,
+    #[serde(rename = "dimension")]
+    pub Dimension: Dimension,
+}
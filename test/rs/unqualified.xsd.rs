@@ -0,0 +1,20 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct UnqualifiedContact {
+    #[serde(rename = "id", default, skip_serializing_if = "Option::is_none")]
+    pub Id: Option<isize>,
+    #[serde(rename = "tier", default, skip_serializing_if = "Option::is_none")]
+    pub Tier: Option<char>,
+    #[serde(rename = "name")]
+    pub Name: char,
+    #[serde(rename = "note")]
+    pub Note: char,
+}
@@ -0,0 +1,44 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Serialize, Deserialize)]
+enum StockLevel {
+    #[serde(rename = "in-stock")]
+    InStock,
+    #[serde(rename = "backordered")]
+    Backordered,
+    #[serde(rename = "discontinued")]
+    Discontinued,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Location {
+    #[serde(rename = "street")]
+    pub Street: char,
+    #[serde(rename = "city")]
+    pub City: char,
+    #[serde(rename = "postalCode", default, skip_serializing_if = "Option::is_none")]
+    pub PostalCode: Option<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Warehouse {
+    #[serde(rename = "code")]
+    pub Code: char,
+    #[serde(rename = "name")]
+    pub Name: char,
+    #[serde(rename = "location")]
+    pub Location: Location,
+    #[serde(rename = "sku")]
+    pub Sku: Vec<char>,
+    #[serde(rename = "capacity")]
+    pub Capacity: i64,
+    #[serde(rename = "level")]
+    pub Level: StockLevel,
+}
@@ -0,0 +1,40 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct CollapsedCode {
+    #[serde(rename = "collapsedCode")]
+    pub CollapsedCode: char,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct ReplacedText {
+    #[serde(rename = "replacedText")]
+    pub ReplacedText: char,
+}
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Serialize, Deserialize)]
+enum TokenSize {
+    #[serde(rename = "small")]
+    Small,
+    #[serde(rename = "large")]
+    Large,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Caption {
+    #[serde(rename = "lang", default, skip_serializing_if = "Option::is_none")]
+    pub Lang: Option<char>,
+    #[serde(rename = "code")]
+    pub Code: char,
+    #[serde(rename = "text")]
+    pub Text: char,
+    #[serde(rename = "size")]
+    pub Size: TokenSize,
+}
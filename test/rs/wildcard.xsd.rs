@@ -0,0 +1,26 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Extensible {
+    #[serde(rename = "version", default, skip_serializing_if = "Option::is_none")]
+    pub Version: Option<char>,
+    #[serde(rename = "id")]
+    pub Id: char,
+    #[serde(rename = "any", default, skip_serializing_if = "Vec::is_empty")]
+    pub Any: Vec<char>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Envelope {
+    #[serde(rename = "header")]
+    pub Header: char,
+    #[serde(rename = "any")]
+    pub Any: char,
+}
@@ -0,0 +1,46 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  class Payment
+    # @return [Float]
+    attr_accessor :amount
+
+    def initialize(amount:)
+      @amount = amount
+    end
+  end
+
+  class CardPayment < Payment
+    # @return [String]
+    attr_accessor :card_number
+
+    def initialize(card_number:, **kwargs)
+      super(**kwargs)
+      @card_number = card_number
+    end
+  end
+
+  class CashPayment < Payment
+    # @return [String, nil]
+    attr_accessor :currency_attr
+
+    def initialize(currency_attr: nil, **kwargs)
+      super(**kwargs)
+      @currency_attr = currency_attr
+    end
+  end
+
+  class Invoice
+    # @return [Array<Payment>]
+    attr_accessor :payment
+
+    def initialize(payment: [])
+      @payment = payment
+    end
+  end
+end
@@ -0,0 +1,51 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  class BankAccount
+    # @return [String]
+    attr_accessor :id_attr
+    # @return [String, nil]
+    attr_accessor :legacy_attr
+    # @return [String, nil]
+    attr_accessor :note_attr
+    # @return [String, nil]
+    attr_accessor :unit_attr
+
+    def initialize(id_attr:, legacy_attr: nil, note_attr: nil, unit_attr: nil)
+      @id_attr = id_attr
+      @legacy_attr = legacy_attr
+      @note_attr = note_attr
+      @unit_attr = unit_attr
+    end
+  end
+
+  class PremiumAccount < BankAccount
+    # @return [String]
+    attr_accessor :tier_attr
+
+    def initialize(tier_attr:, **kwargs)
+      super(**kwargs)
+      @tier_attr = tier_attr
+    end
+  end
+
+  class Bank
+    # @return [Integer]
+    attr_accessor :code_attr
+    # @return [Array<BankAccount>]
+    attr_accessor :account
+    # @return [Integer, nil]
+    attr_accessor :iso
+
+    def initialize(code_attr:, account: [], iso: nil)
+      @code_attr = code_attr
+      @account = account
+      @iso = iso
+    end
+  end
+end
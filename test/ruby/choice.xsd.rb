@@ -0,0 +1,62 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  class Circle
+    # @return [Float, nil]
+    attr_accessor :radius_attr
+
+    def initialize(radius_attr: nil)
+      @radius_attr = radius_attr
+    end
+  end
+
+  class Square
+    # @return [Float, nil]
+    attr_accessor :side_attr
+
+    def initialize(side_attr: nil)
+      @side_attr = side_attr
+    end
+  end
+
+  class Shape
+    # @return [String]
+    attr_accessor :label
+    # @return [Circle, nil]
+    attr_accessor :circle
+    # @return [Square, nil]
+    attr_accessor :square
+    # @return [String, nil]
+    attr_accessor :path
+    # @return [String, nil]
+    attr_accessor :fill
+    # @return [Integer, nil]
+    attr_accessor :stroke
+
+    def initialize(label:, circle: nil, square: nil, path: nil, fill: nil, stroke: nil)
+      @label = label
+      @circle = circle
+      @square = square
+      @path = path
+      @fill = fill
+      @stroke = stroke
+    end
+  end
+
+  class Drawing
+    # @return [Array<Shape>]
+    attr_accessor :shape
+    # @return [Array<String>]
+    attr_accessor :text
+
+    def initialize(shape: [], text: [])
+      @shape = shape
+      @text = text
+    end
+  end
+end
@@ -0,0 +1,76 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  class Vehicle
+    # @return [String]
+    attr_accessor :vin_attr
+    # @return [String]
+    attr_accessor :make
+    # @return [Integer]
+    attr_accessor :year
+
+    def initialize(vin_attr:, make:, year:)
+      @vin_attr = vin_attr
+      @make = make
+      @year = year
+    end
+  end
+
+  class Car < Vehicle
+    # @return [Integer]
+    attr_accessor :doors
+    # @return [String, nil]
+    attr_accessor :model
+
+    def initialize(doors:, model: nil, **kwargs)
+      super(**kwargs)
+      @doors = doors
+      @model = model
+    end
+  end
+
+  class SportsCar < Car
+    # @return [Integer]
+    attr_accessor :top_speed
+
+    def initialize(top_speed:, **kwargs)
+      super(**kwargs)
+      @top_speed = top_speed
+    end
+  end
+
+  class CompactCar
+    # @return [String]
+    attr_accessor :vin_attr
+    # @return [String]
+    attr_accessor :make
+    # @return [Integer]
+    attr_accessor :year
+    # @return [Integer]
+    attr_accessor :doors
+    # @return [String]
+    attr_accessor :model
+
+    def initialize(vin_attr:, make:, year:, doors:, model:)
+      @vin_attr = vin_attr
+      @make = make
+      @year = year
+      @doors = doors
+      @model = model
+    end
+  end
+
+  class Garage
+    # @return [Array<Vehicle>]
+    attr_accessor :vehicle
+
+    def initialize(vehicle: [])
+      @vehicle = vehicle
+    end
+  end
+end
@@ -0,0 +1,29 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  # The allowed values of color.
+  COLOR = ['red', 'green', 'blue'].freeze
+
+  class CatalogItem
+    # @return [Float, nil]
+    attr_accessor :discount_attr
+    # @return [String]
+    attr_accessor :code
+    # @return [Array<String>]
+    attr_accessor :color
+    # @return [Integer, nil]
+    attr_accessor :quantity
+
+    def initialize(discount_attr: nil, code:, color: [], quantity: nil)
+      @discount_attr = discount_attr
+      @code = code
+      @color = color
+      @quantity = quantity
+    end
+  end
+end
@@ -0,0 +1,68 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  class Customer
+    # @return [String]
+    attr_accessor :customer_id
+    # @return [String]
+    attr_accessor :first_name
+    # @return [String]
+    attr_accessor :last_name
+    # @return [Array<String>]
+    attr_accessor :email
+
+    def initialize(customer_id:, first_name:, last_name:, email: [])
+      @customer_id = customer_id
+      @first_name = first_name
+      @last_name = last_name
+      @email = email
+    end
+  end
+
+  class Supplier
+    # @return [String]
+    attr_accessor :company
+    # @return [String, nil]
+    attr_accessor :first_name
+    # @return [String, nil]
+    attr_accessor :last_name
+    # @return [Array<String>]
+    attr_accessor :email
+
+    def initialize(company:, first_name: nil, last_name: nil, email: [])
+      @company = company
+      @first_name = first_name
+      @last_name = last_name
+      @email = email
+    end
+  end
+
+  class PersonGroup
+    # @return [String]
+    attr_accessor :first_name
+    # @return [String]
+    attr_accessor :last_name
+    # @return [Array<String>]
+    attr_accessor :email
+
+    def initialize(first_name:, last_name:, email: [])
+      @first_name = first_name
+      @last_name = last_name
+      @email = email
+    end
+  end
+
+  class ContactGroup
+    # @return [String]
+    attr_accessor :email
+
+    def initialize(email:)
+      @email = email
+    end
+  end
+end
@@ -0,0 +1,32 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  class BookTitle
+    # @return [String, nil]
+    attr_accessor :xml_lang_attr
+    # @return [String]
+    attr_accessor :value
+
+    def initialize(xml_lang_attr: nil, value:)
+      @xml_lang_attr = xml_lang_attr
+      @value = value
+    end
+  end
+
+  class Book
+    # @return [Array<BookTitle>]
+    attr_accessor :title
+    # @return [String]
+    attr_accessor :isbn
+
+    def initialize(title: [], isbn:)
+      @title = title
+      @isbn = isbn
+    end
+  end
+end
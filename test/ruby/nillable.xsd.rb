@@ -0,0 +1,35 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  class MailAddress
+    # @return [String]
+    attr_accessor :city
+
+    def initialize(city:)
+      @city = city
+    end
+  end
+
+  class Reviewer
+    # @return [String]
+    attr_accessor :nickname
+    # @return [Integer, nil]
+    attr_accessor :rating
+    # @return [MailAddress, nil]
+    attr_accessor :mail_address
+    # @return [Array<String>]
+    attr_accessor :tags
+
+    def initialize(nickname:, rating: nil, mail_address: nil, tags: [])
+      @nickname = nickname
+      @rating = rating
+      @mail_address = mail_address
+      @tags = tags
+    end
+  end
+end
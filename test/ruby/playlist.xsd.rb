@@ -0,0 +1,35 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  # The allowed values of genre.
+  GENRE = ['rock', 'hip-hop', 'classical'].freeze
+
+  class Playlist
+    # @return [Integer]
+    attr_accessor :id_attr
+    # @return [Boolean, nil]
+    attr_accessor :shared_attr
+    # @return [String]
+    attr_accessor :title
+    # @return [String, nil]
+    attr_accessor :genre
+    # @return [Array<String>]
+    attr_accessor :track
+    # @return [Float, nil]
+    attr_accessor :rating
+
+    def initialize(id_attr:, shared_attr: nil, title:, genre: nil, track: [], rating: nil)
+      @id_attr = id_attr
+      @shared_attr = shared_attr
+      @title = title
+      @genre = genre
+      @track = track
+      @rating = rating
+    end
+  end
+end
@@ -0,0 +1,45 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  # The allowed values of level.
+  LEVEL = ['low', 'high'].freeze
+
+  class Setting
+    # @return [Integer]
+    attr_accessor :id_attr
+    # @return [Boolean, nil]
+    attr_accessor :enabled_attr
+    # @return [String, nil]
+    attr_accessor :note_attr
+    # @return [String]
+    attr_accessor :key
+    # @return [Integer, nil]
+    attr_accessor :retries
+    # @return [String, nil]
+    attr_accessor :level
+
+    def initialize(id_attr:, enabled_attr: nil, note_attr: nil, key:, retries: nil, level: nil)
+      @id_attr = id_attr
+      @enabled_attr = enabled_attr
+      @note_attr = note_attr
+      @key = key
+      @retries = retries
+      @level = level
+    end
+  end
+
+  class Override < Setting
+    # @return [String]
+    attr_accessor :scope
+
+    def initialize(scope:, **kwargs)
+      super(**kwargs)
+      @scope = scope
+    end
+  end
+end
@@ -0,0 +1,35 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  # The allowed values of orderStatus.
+  ORDER_STATUS = ['pending', 'in-transit', 'delivered'].freeze
+
+  class ShipOrder
+    # @return [String]
+    attr_accessor :orderid_attr
+    # @return [Integer, nil]
+    attr_accessor :priority_attr
+    # @return [String]
+    attr_accessor :order_person
+    # @return [String, nil]
+    attr_accessor :note
+    # @return [Array<String>]
+    attr_accessor :item
+    # @return [String]
+    attr_accessor :status
+
+    def initialize(orderid_attr:, priority_attr: nil, order_person:, note: nil, item: [], status:)
+      @orderid_attr = orderid_attr
+      @priority_attr = priority_attr
+      @order_person = order_person
+      @note = note
+      @item = item
+      @status = status
+    end
+  end
+end
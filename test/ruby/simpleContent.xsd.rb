@@ -0,0 +1,39 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  class Price
+    # @return [String]
+    attr_accessor :currency_attr
+    # @return [Float]
+    attr_accessor :value
+
+    def initialize(currency_attr:, value:)
+      @currency_attr = currency_attr
+      @value = value
+    end
+  end
+
+  class DiscountPrice < Price
+    # @return [Integer, nil]
+    attr_accessor :discount_attr
+
+    def initialize(discount_attr: nil, **kwargs)
+      super(**kwargs)
+      @discount_attr = discount_attr
+    end
+  end
+
+  class LocalPrice
+    # @return [String, nil]
+    attr_accessor :currency_attr
+
+    def initialize(currency_attr: nil)
+      @currency_attr = currency_attr
+    end
+  end
+end
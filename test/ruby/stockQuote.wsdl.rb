@@ -0,0 +1,41 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  class Money
+    # @return [Float]
+    attr_accessor :amount
+    # @return [String]
+    attr_accessor :currency
+
+    def initialize(amount:, currency:)
+      @amount = amount
+      @currency = currency
+    end
+  end
+
+  class TradePriceRequest
+    # @return [String]
+    attr_accessor :ticker_symbol
+
+    def initialize(ticker_symbol:)
+      @ticker_symbol = ticker_symbol
+    end
+  end
+
+  class TradePrice
+    # @return [String]
+    attr_accessor :ticker_symbol
+    # @return [Money]
+    attr_accessor :price
+
+    def initialize(ticker_symbol:, price:)
+      @ticker_symbol = ticker_symbol
+      @price = price
+    end
+  end
+end
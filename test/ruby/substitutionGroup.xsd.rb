@@ -0,0 +1,49 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  class AnimalType
+    # @return [String, nil]
+    attr_accessor :id_attr
+
+    def initialize(id_attr: nil)
+      @id_attr = id_attr
+    end
+  end
+
+  class CatType < AnimalType
+    # @return [Integer, nil]
+    attr_accessor :lives_attr
+
+    def initialize(lives_attr: nil, **kwargs)
+      super(**kwargs)
+      @lives_attr = lives_attr
+    end
+  end
+
+  class DogType < AnimalType
+    # @return [String, nil]
+    attr_accessor :breed_attr
+
+    def initialize(breed_attr: nil, **kwargs)
+      super(**kwargs)
+      @breed_attr = breed_attr
+    end
+  end
+
+  class ZooType
+    # @return [String]
+    attr_accessor :name
+    # @return [Array<AnimalType>]
+    attr_accessor :tns_animal
+
+    def initialize(name:, tns_animal: [])
+      @name = name
+      @tns_animal = tns_animal
+    end
+  end
+end
@@ -0,0 +1,53 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  # The allowed values of dimensionToken.
+  DIMENSION_TOKEN = ['small', 'large'].freeze
+
+  class Dimension
+    # @return [String, nil]
+    attr_accessor :dimension_token
+    # @return [String, nil]
+    attr_accessor :g_month
+    # @return [Integer, nil]
+    attr_accessor :int
+
+    def initialize(dimension_token: nil, g_month: nil, int: nil)
+      @dimension_token = dimension_token
+      @g_month = g_month
+      @int = int
+    end
+  end
+
+  class TagValue
+    # @return [Boolean, nil]
+    attr_accessor :boolean
+    # @return [Float, nil]
+    attr_accessor :decimal
+    # @return [String, nil]
+    attr_accessor :string
+
+    def initialize(boolean: nil, decimal: nil, string: nil)
+      @boolean = boolean
+      @decimal = decimal
+      @string = string
+    end
+  end
+
+  class Crate
+    # @return [TagValue, nil]
+    attr_accessor :tag_attr
+    # @return [Dimension]
+    attr_accessor :dimension
+
+    def initialize(tag_attr: nil, dimension:)
+      @tag_attr = tag_attr
+      @dimension = dimension
+    end
+  end
+end
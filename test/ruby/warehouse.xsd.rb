@@ -0,0 +1,50 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  # The allowed values of stockLevel.
+  STOCK_LEVEL = ['in-stock', 'backordered', 'discontinued'].freeze
+
+  class Location
+    # @return [String]
+    attr_accessor :street
+    # @return [String]
+    attr_accessor :city
+    # @return [String, nil]
+    attr_accessor :postal_code
+
+    def initialize(street:, city:, postal_code: nil)
+      @street = street
+      @city = city
+      @postal_code = postal_code
+    end
+  end
+
+  class Warehouse
+    # @return [String]
+    attr_accessor :code_attr
+    # @return [String]
+    attr_accessor :name
+    # @return [Location]
+    attr_accessor :location
+    # @return [Array<String>]
+    attr_accessor :sku
+    # @return [Integer]
+    attr_accessor :capacity
+    # @return [String]
+    attr_accessor :level
+
+    def initialize(code_attr:, name:, location:, sku: [], capacity:, level:)
+      @code_attr = code_attr
+      @name = name
+      @location = location
+      @sku = sku
+      @capacity = capacity
+      @level = level
+    end
+  end
+end
@@ -0,0 +1,35 @@
+# Copyright 2020 The xgen Authors. All rights reserved.
+#
+# DO NOT EDIT: generated by xgen XSD generator
+#
+# Use of this source code is governed by a BSD-style license that can be
+# found in the LICENSE file.
+
+module Schema
+  class Extensible
+    # @return [String, nil]
+    attr_accessor :version_attr
+    # @return [String]
+    attr_accessor :id
+    # @return [Array<String>]
+    attr_accessor :any
+
+    def initialize(version_attr: nil, id:, any: [])
+      @version_attr = version_attr
+      @id = id
+      @any = any
+    end
+  end
+
+  class Envelope
+    # @return [String]
+    attr_accessor :header
+    # @return [String]
+    attr_accessor :any
+
+    def initialize(header:, any:)
+      @header = header
+      @any = any
+    end
+  end
+end
@@ -0,0 +1,29 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+case class BankAccount(
+  idAttr: String,
+  legacyAttr: Option[String] = None,
+  noteAttr: Option[String] = None,
+  unitAttr: Option[String] = None
+)
+
+case class PremiumAccount(
+  idAttr: String,
+  legacyAttr: Option[String] = None,
+  noteAttr: Option[String] = None,
+  unitAttr: Option[String] = None,
+  tierAttr: String
+)
+
+case class Bank(
+  codeAttr: Int,
+  account: Seq[BankAccount] = Seq.empty,
+  iso: Option[Int] = None
+)
@@ -0,0 +1,30 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+case class Circle(
+  radiusAttr: Option[Double] = None
+)
+
+case class Square(
+  sideAttr: Option[Double] = None
+)
+
+case class Shape(
+  label: String,
+  circle: Option[Circle] = None,
+  square: Option[Square] = None,
+  path: Option[String] = None,
+  fill: Option[String] = None,
+  stroke: Option[Int] = None
+)
+
+case class Drawing(
+  shape: Seq[Shape] = Seq.empty,
+  text: Seq[String] = Seq.empty
+)
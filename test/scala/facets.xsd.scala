@@ -0,0 +1,29 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+sealed trait Color { def value: String }
+
+object Color {
+  case object Red extends Color { val value = "red" }
+  case object Green extends Color { val value = "green" }
+  case object Blue extends Color { val value = "blue" }
+}
+
+type ProductCode = String
+
+type Quantity = Int
+
+type Ratio = Double
+
+case class CatalogItem(
+  discountAttr: Option[Double] = None,
+  code: String,
+  color: Seq[Color] = Seq.empty,
+  quantity: Option[Int] = None
+)
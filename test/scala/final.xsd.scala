@@ -0,0 +1,28 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+// final="#all": derivation is prohibited
+type AccountNumber = String
+
+// final="restriction": derivation by restriction is prohibited
+// block="extension": substitution by extension is blocked
+case class Account(
+  number: String,
+  balance: Double
+)
+
+case class SavingsAccount(
+  number: String,
+  balance: Double,
+  rate: Double
+)
+
+// final="extension": derivation by extension is prohibited
+// block="restriction substitution": substitution by restriction or substitution group members is blocked
+type PrimaryAccount = Account
@@ -0,0 +1,20 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+type MeasureList = Seq[Double]
+
+type YearList = Seq[String]
+
+type ShadeList = Seq[String]
+
+case class Swatch(
+  yearsAttr: Option[YearList] = None,
+  measures: MeasureList,
+  shades: Option[ShadeList] = None
+)
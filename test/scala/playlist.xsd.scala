@@ -0,0 +1,25 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+sealed trait Genre { def value: String }
+
+object Genre {
+  case object Rock extends Genre { val value = "rock" }
+  case object HipHop extends Genre { val value = "hip-hop" }
+  case object Classical extends Genre { val value = "classical" }
+}
+
+case class Playlist(
+  idAttr: Int,
+  sharedAttr: Option[Boolean] = None,
+  title: String,
+  genre: Option[Genre] = None,
+  track: Seq[String] = Seq.empty,
+  rating: Option[Double] = None
+)
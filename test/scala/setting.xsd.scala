@@ -0,0 +1,34 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+sealed trait Level { def value: String }
+
+object Level {
+  case object Low extends Level { val value = "low" }
+  case object High extends Level { val value = "high" }
+}
+
+case class Setting(
+  idAttr: Int,
+  enabledAttr: Option[Boolean] = None,
+  noteAttr: Option[String] = None,
+  key: String,
+  retries: Option[Int] = None,
+  level: Option[Level] = None
+)
+
+case class Override(
+  idAttr: Int,
+  enabledAttr: Option[Boolean] = None,
+  noteAttr: Option[String] = None,
+  key: String,
+  retries: Option[Int] = None,
+  level: Option[Level] = None,
+  scope: String
+)
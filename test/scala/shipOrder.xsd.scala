@@ -0,0 +1,25 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+sealed trait OrderStatus { def value: String }
+
+object OrderStatus {
+  case object Pending extends OrderStatus { val value = "pending" }
+  case object InTransit extends OrderStatus { val value = "in-transit" }
+  case object Delivered extends OrderStatus { val value = "delivered" }
+}
+
+case class ShipOrder(
+  orderidAttr: String,
+  priorityAttr: Option[Int] = None,
+  orderPerson: String,
+  note: Option[String] = None,
+  item: Seq[String] = Seq.empty,
+  status: OrderStatus
+)
@@ -0,0 +1,23 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+type AmountType = Double
+
+case class Price(
+  currencyAttr: String
+)
+
+case class DiscountPrice(
+  currencyAttr: String,
+  discountAttr: Option[Int] = None
+)
+
+case class LocalPrice(
+  currencyAttr: Option[String] = None
+)
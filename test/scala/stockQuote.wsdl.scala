@@ -0,0 +1,24 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+type TickerSymbol = String
+
+case class Money(
+  amount: Double,
+  currency: String
+)
+
+case class TradePriceRequest(
+  tickerSymbol: String
+)
+
+case class TradePrice(
+  tickerSymbol: String,
+  price: Money
+)
@@ -0,0 +1,37 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+case class AnimalType(
+  idAttr: Option[String] = None
+)
+
+case class CatType(
+  idAttr: Option[String] = None,
+  livesAttr: Option[Int] = None
+)
+
+case class DogType(
+  idAttr: Option[String] = None,
+  breedAttr: Option[String] = None
+)
+
+type Animal = AnimalType
+
+type Cat = CatType
+
+type Dog = DogType
+
+type Puppy = DogType
+
+case class ZooType(
+  name: String,
+  tnsAnimal: Seq[AnimalType] = Seq.empty
+)
+
+type Zoo = ZooType
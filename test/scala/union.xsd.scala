@@ -0,0 +1,32 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+sealed trait DimensionToken { def value: String }
+
+object DimensionToken {
+  case object Small extends DimensionToken { val value = "small" }
+  case object Large extends DimensionToken { val value = "large" }
+}
+
+case class Dimension(
+  int: Option[Int] = None,
+  dimensionToken: Option[DimensionToken] = None,
+  gMonth: Option[String] = None
+)
+
+case class TagValue(
+  boolean: Option[Boolean] = None,
+  string: Option[String] = None,
+  decimal: Option[Double] = None
+)
+
+case class Crate(
+  tagAttr: Option[TagValue] = None,
+  dimension: Dimension
+)
@@ -0,0 +1,31 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+sealed trait StockLevel { def value: String }
+
+object StockLevel {
+  case object InStock extends StockLevel { val value = "in-stock" }
+  case object Backordered extends StockLevel { val value = "backordered" }
+  case object Discontinued extends StockLevel { val value = "discontinued" }
+}
+
+case class Location(
+  street: String,
+  city: String,
+  postalCode: Option[String] = None
+)
+
+case class Warehouse(
+  codeAttr: String,
+  name: String,
+  location: Location,
+  sku: Seq[String] = Seq.empty,
+  capacity: Long,
+  level: StockLevel
+)
@@ -0,0 +1,26 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package schema
+
+type CollapsedCode = String
+
+type ReplacedText = String
+
+sealed trait TokenSize { def value: String }
+
+object TokenSize {
+  case object Small extends TokenSize { val value = "small" }
+  case object Large extends TokenSize { val value = "large" }
+}
+
+case class Caption(
+  langAttr: Option[String] = None,
+  code: String,
+  text: String,
+  size: TokenSize
+)
@@ -0,0 +1,44 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+struct Payment: Codable {
+    let amount: Double
+
+    enum CodingKeys: String, CodingKey {
+        case amount
+    }
+}
+
+struct CardPayment: Codable {
+    let amount: Double
+    let cardNumber: String
+
+    enum CodingKeys: String, CodingKey {
+        case amount
+        case cardNumber
+    }
+}
+
+struct CashPayment: Codable {
+    let currencyAttr: String?
+    let amount: Double
+
+    enum CodingKeys: String, CodingKey {
+        case currencyAttr = "currency"
+        case amount
+    }
+}
+
+struct Invoice: Codable {
+    let payment: [Payment]
+
+    enum CodingKeys: String, CodingKey {
+        case payment
+    }
+}
@@ -0,0 +1,34 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+struct TemperatureRange: Codable {
+    let low: Int
+    let high: Int
+
+    enum CodingKeys: String, CodingKey {
+        case low
+        case high
+    }
+}
+
+typealias EvenNumber = Int
+
+struct Reading: Codable {
+    let unitAttr: String?
+    let value: Double
+
+    enum CodingKeys: String, CodingKey {
+        case unitAttr = "unit"
+        case value
+    }
+}
+
+typealias Sensor = Reading
+
+typealias Measurement = TemperatureRange
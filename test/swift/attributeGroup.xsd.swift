@@ -0,0 +1,46 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+struct Product: Codable {
+    let priceAttr: Double?
+    let skuAttr: String
+    let idAttr: String
+    let langAttr: String?
+    let title: String
+
+    enum CodingKeys: String, CodingKey {
+        case priceAttr = "price"
+        case skuAttr = "sku"
+        case idAttr = "id"
+        case langAttr = "lang"
+        case title
+    }
+}
+
+struct ProductAttrs: Codable {
+    let skuAttr: String
+    let idAttr: String
+    let langAttr: String?
+
+    enum CodingKeys: String, CodingKey {
+        case skuAttr = "sku"
+        case idAttr = "id"
+        case langAttr = "lang"
+    }
+}
+
+struct CommonAttrs: Codable {
+    let idAttr: String
+    let langAttr: String?
+
+    enum CodingKeys: String, CodingKey {
+        case idAttr = "id"
+        case langAttr = "lang"
+    }
+}
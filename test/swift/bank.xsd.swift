@@ -0,0 +1,50 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+struct BankAccount: Codable {
+    let idAttr: String
+    let legacyAttr: String?
+    let noteAttr: String?
+    let unitAttr: String?
+
+    enum CodingKeys: String, CodingKey {
+        case idAttr = "id"
+        case legacyAttr = "legacy"
+        case noteAttr = "note"
+        case unitAttr = "unit"
+    }
+}
+
+struct PremiumAccount: Codable {
+    let idAttr: String
+    let legacyAttr: String?
+    let noteAttr: String?
+    let unitAttr: String?
+    let tierAttr: String
+
+    enum CodingKeys: String, CodingKey {
+        case idAttr = "id"
+        case legacyAttr = "legacy"
+        case noteAttr = "note"
+        case unitAttr = "unit"
+        case tierAttr = "tier"
+    }
+}
+
+struct Bank: Codable {
+    let codeAttr: Int
+    let account: [BankAccount]
+    let iso: Int?
+
+    enum CodingKeys: String, CodingKey {
+        case codeAttr = "code"
+        case account
+        case iso
+    }
+}
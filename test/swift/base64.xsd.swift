@@ -0,0 +1,40 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+typealias MyType1 = Data
+
+struct MyType2: Codable {
+    let lengthAttr: Int?
+
+    enum CodingKeys: String, CodingKey {
+        case lengthAttr = "length"
+    }
+}
+
+struct MyType3: Codable {
+    let lengthAttr: Int?
+
+    enum CodingKeys: String, CodingKey {
+        case lengthAttr = "length"
+    }
+}
+
+struct MyType4: Codable {
+    let title: String
+    let blob: Data
+    let timestamp: Date
+
+    enum CodingKeys: String, CodingKey {
+        case title
+        case blob
+        case timestamp
+    }
+}
+
+typealias MyType5 = String
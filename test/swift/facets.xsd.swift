@@ -0,0 +1,34 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+enum Color: String, Codable {
+    case red
+    case green
+    case blue
+}
+
+typealias ProductCode = String
+
+typealias Quantity = Int
+
+typealias Ratio = Double
+
+struct CatalogItem: Codable {
+    let discountAttr: Double?
+    let code: String
+    let color: [Color]
+    let quantity: Int?
+
+    enum CodingKeys: String, CodingKey {
+        case discountAttr = "discount"
+        case code
+        case color
+        case quantity
+    }
+}
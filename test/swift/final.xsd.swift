@@ -0,0 +1,39 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+// final="#all": derivation is prohibited
+typealias AccountNumber = String
+
+// final="restriction": derivation by restriction is prohibited
+// block="extension": substitution by extension is blocked
+struct Account: Codable {
+    let number: String
+    let balance: Double
+
+    enum CodingKeys: String, CodingKey {
+        case number
+        case balance
+    }
+}
+
+struct SavingsAccount: Codable {
+    let number: String
+    let balance: Double
+    let rate: Double
+
+    enum CodingKeys: String, CodingKey {
+        case number
+        case balance
+        case rate
+    }
+}
+
+// final="extension": derivation by extension is prohibited
+// block="restriction substitution": substitution by restriction or substitution group members is blocked
+typealias PrimaryAccount = Account
@@ -0,0 +1,56 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+struct Customer: Codable {
+    let customerId: String
+    let firstName: String
+    let lastName: String
+    let email: [String]
+
+    enum CodingKeys: String, CodingKey {
+        case customerId
+        case firstName
+        case lastName
+        case email
+    }
+}
+
+struct Supplier: Codable {
+    let company: String
+    let firstName: String?
+    let lastName: String?
+    let email: [String]?
+
+    enum CodingKeys: String, CodingKey {
+        case company
+        case firstName
+        case lastName
+        case email
+    }
+}
+
+struct PersonGroup: Codable {
+    let firstName: String
+    let lastName: String
+    let email: [String]
+
+    enum CodingKeys: String, CodingKey {
+        case firstName
+        case lastName
+        case email
+    }
+}
+
+struct ContactGroup: Codable {
+    let email: String
+
+    enum CodingKeys: String, CodingKey {
+        case email
+    }
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+typealias MeasureList = [Double]
+
+typealias YearList = [String]
+
+typealias ShadeList = [String]
+
+struct Swatch: Codable {
+    let yearsAttr: YearList?
+    let measures: MeasureList
+    let shades: ShadeList?
+
+    enum CodingKeys: String, CodingKey {
+        case yearsAttr = "years"
+        case measures
+        case shades
+    }
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+typealias RatingType = Int
+
+struct MailAddress: Codable {
+    let city: String
+
+    enum CodingKeys: String, CodingKey {
+        case city
+    }
+}
+
+struct Reviewer: Codable {
+    let nickname: String
+    let rating: Int?
+    let mailAddress: MailAddress?
+    let tags: [String]
+
+    enum CodingKeys: String, CodingKey {
+        case nickname
+        case rating
+        case mailAddress
+        case tags
+    }
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+enum Genre: String, Codable {
+    case rock
+    case hipHop = "hip-hop"
+    case classical
+}
+
+struct Playlist: Codable {
+    let idAttr: Int
+    let sharedAttr: Bool?
+    let title: String
+    let genre: Genre?
+    let track: [String]?
+    let rating: Double?
+
+    enum CodingKeys: String, CodingKey {
+        case idAttr = "id"
+        case sharedAttr = "shared"
+        case title
+        case genre
+        case track
+        case rating
+    }
+}
@@ -0,0 +1,51 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+enum Level: String, Codable {
+    case low
+    case high
+}
+
+struct Setting: Codable {
+    let idAttr: Int
+    let enabledAttr: Bool?
+    let noteAttr: String?
+    let key: String
+    let retries: Int?
+    let level: Level?
+
+    enum CodingKeys: String, CodingKey {
+        case idAttr = "id"
+        case enabledAttr = "enabled"
+        case noteAttr = "note"
+        case key
+        case retries
+        case level
+    }
+}
+
+struct Override: Codable {
+    let idAttr: Int
+    let enabledAttr: Bool?
+    let noteAttr: String?
+    let key: String
+    let retries: Int?
+    let level: Level?
+    let scope: String
+
+    enum CodingKeys: String, CodingKey {
+        case idAttr = "id"
+        case enabledAttr = "enabled"
+        case noteAttr = "note"
+        case key
+        case retries
+        case level
+        case scope
+    }
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+enum OrderStatus: String, Codable {
+    case pending
+    case inTransit = "in-transit"
+    case delivered
+}
+
+struct ShipOrder: Codable {
+    let orderidAttr: String
+    let priorityAttr: Int?
+    let orderPerson: String
+    let note: String?
+    let item: [String]
+    let status: OrderStatus
+
+    enum CodingKeys: String, CodingKey {
+        case orderidAttr = "orderid"
+        case priorityAttr = "priority"
+        case orderPerson
+        case note
+        case item
+        case status
+    }
+}
@@ -0,0 +1,36 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+typealias AmountType = Double
+
+struct Price: Codable {
+    let currencyAttr: String
+
+    enum CodingKeys: String, CodingKey {
+        case currencyAttr = "currency"
+    }
+}
+
+struct DiscountPrice: Codable {
+    let currencyAttr: String
+    let discountAttr: Int?
+
+    enum CodingKeys: String, CodingKey {
+        case currencyAttr = "currency"
+        case discountAttr = "discount"
+    }
+}
+
+struct LocalPrice: Codable {
+    let currencyAttr: String?
+
+    enum CodingKeys: String, CodingKey {
+        case currencyAttr = "currency"
+    }
+}
@@ -0,0 +1,38 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+typealias TickerSymbol = String
+
+struct Money: Codable {
+    let amount: Double
+    let currency: String
+
+    enum CodingKeys: String, CodingKey {
+        case amount
+        case currency
+    }
+}
+
+struct TradePriceRequest: Codable {
+    let tickerSymbol: String
+
+    enum CodingKeys: String, CodingKey {
+        case tickerSymbol
+    }
+}
+
+struct TradePrice: Codable {
+    let tickerSymbol: String
+    let price: Money
+
+    enum CodingKeys: String, CodingKey {
+        case tickerSymbol
+        case price
+    }
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+struct AnimalType: Codable {
+    let idAttr: String?
+
+    enum CodingKeys: String, CodingKey {
+        case idAttr = "id"
+    }
+}
+
+struct CatType: Codable {
+    let idAttr: String?
+    let livesAttr: Int?
+
+    enum CodingKeys: String, CodingKey {
+        case idAttr = "id"
+        case livesAttr = "lives"
+    }
+}
+
+struct DogType: Codable {
+    let idAttr: String?
+    let breedAttr: String?
+
+    enum CodingKeys: String, CodingKey {
+        case idAttr = "id"
+        case breedAttr = "breed"
+    }
+}
+
+typealias Animal = AnimalType
+
+typealias Cat = CatType
+
+typealias Dog = DogType
+
+typealias Puppy = DogType
+
+struct ZooType: Codable {
+    let name: String
+    let tnsAnimal: [AnimalType]
+
+    enum CodingKeys: String, CodingKey {
+        case name
+        case tnsAnimal = "tns:animal"
+    }
+}
+
+typealias Zoo = ZooType
@@ -0,0 +1,47 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+enum DimensionToken: String, Codable {
+    case small
+    case large
+}
+
+struct Dimension: Codable {
+    let int: Int?
+    let dimensionToken: DimensionToken?
+    let gMonth: String?
+
+    enum CodingKeys: String, CodingKey {
+        case int
+        case dimensionToken
+        case gMonth
+    }
+}
+
+struct TagValue: Codable {
+    let boolean: Bool?
+    let string: String?
+    let decimal: Double?
+
+    enum CodingKeys: String, CodingKey {
+        case boolean
+        case string
+        case decimal
+    }
+}
+
+struct Crate: Codable {
+    let tagAttr: TagValue?
+    let dimension: Dimension
+
+    enum CodingKeys: String, CodingKey {
+        case tagAttr = "tag"
+        case dimension
+    }
+}
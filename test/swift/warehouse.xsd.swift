@@ -0,0 +1,44 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+enum StockLevel: String, Codable {
+    case inStock = "in-stock"
+    case backordered
+    case discontinued
+}
+
+struct Location: Codable {
+    let street: String
+    let city: String
+    let postalCode: String?
+
+    enum CodingKeys: String, CodingKey {
+        case street
+        case city
+        case postalCode
+    }
+}
+
+struct Warehouse: Codable {
+    let codeAttr: String
+    let name: String
+    let location: Location
+    let sku: [String]
+    let capacity: Int64
+    let level: StockLevel
+
+    enum CodingKeys: String, CodingKey {
+        case codeAttr = "code"
+        case name
+        case location
+        case sku
+        case capacity
+        case level
+    }
+}
@@ -0,0 +1,31 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+import Foundation
+
+typealias CollapsedCode = String
+
+typealias ReplacedText = String
+
+enum TokenSize: String, Codable {
+    case small
+    case large
+}
+
+struct Caption: Codable {
+    let langAttr: String?
+    let code: String
+    let text: String
+    let size: TokenSize
+
+    enum CodingKeys: String, CodingKey {
+        case langAttr = "lang"
+        case code
+        case text
+        case size
+    }
+}
@@ -0,0 +1,22 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+export abstract class Payment {
+  Amount: Array<number>;
+}
+
+export class CardPayment extends Payment {
+  CardNumber: Array<string>;
+}
+
+export class CashPayment extends Payment {
+  CurrencyAttr: string | null;
+}
+
+export class Invoice {
+  Payment: Array<Payment>;
+}
@@ -0,0 +1,24 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// assert: low le high
+export class TemperatureRange {
+  Low: Array<number>;
+  High: Array<number>;
+}
+
+// assert: $value mod 2 = 0
+export type EvenNumber = number;
+
+export class Reading {
+  UnitAttr: string | null;
+  Value: Array<number>;
+}
+
+export type Sensor = Reading;
+
+export type Measurement = TemperatureRange;
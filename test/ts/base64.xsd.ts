@@ -8,17 +8,17 @@
 export type MyType1 = Array<any>;
 
 export class MyType2 {
-	LengthAttr: number | null;
+  LengthAttr: number | null;
 }
 
 export class MyType3 {
-	LengthAttr: number | null;
+  LengthAttr: number | null;
 }
 
 export class MyType4 {
-	Title: Array<string>;
-	Blob: Array<Array<any>>;
-	Timestamp: Array<string>;
+  Title: Array<string>;
+  Blob: Array<Array<any>>;
+  Timestamp: Array<string>;
 }
 
 export type MyType5 = string;
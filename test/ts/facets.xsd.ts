@@ -0,0 +1,21 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+export type Color = 'red' | 'green' | 'blue';
+
+export type ProductCode = string;
+
+export type Quantity = number;
+
+export type Ratio = number;
+
+export class CatalogItem {
+  DiscountAttr: number | null;
+  Code: Array<string>;
+  Color: Array<Color>;
+  Quantity: Array<number>;
+}
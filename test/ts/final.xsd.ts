@@ -0,0 +1,24 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// final="#all": derivation is prohibited
+export type AccountNumber = string;
+
+// final="restriction": derivation by restriction is prohibited
+// block="extension": substitution by extension is blocked
+export class Account {
+  Number: Array<string>;
+  Balance: Array<number>;
+}
+
+export class SavingsAccount {
+  Rate: Array<number>;
+}
+
+// final="extension": derivation by extension is prohibited
+// block="restriction substitution": substitution by restriction or substitution group members is blocked
+export type PrimaryAccount = Account;
@@ -0,0 +1,30 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+export class Customer {
+  CustomerId: Array<string>;
+  FirstName: Array<string>;
+  LastName: Array<string>;
+  Email: Array<string>;
+}
+
+export class Supplier {
+  Company: Array<string>;
+  FirstName: Array<string>;
+  LastName: Array<string>;
+  Email: Array<string>;
+}
+
+export class PersonGroup {
+  FirstName: string;
+  LastName: string;
+  Email: Array<string>;
+}
+
+export class ContactGroup {
+  Email: string;
+}
@@ -0,0 +1,18 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+export type MeasureList = Array<number>;
+
+export type YearList = Array<string>;
+
+export type ShadeList = Array<string>;
+
+export class Swatch {
+  YearsAttr: YearList | null;
+  Measures: Array<MeasureList>;
+  Shades: Array<ShadeList>;
+}
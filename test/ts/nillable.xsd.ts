@@ -0,0 +1,19 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+export type RatingType = number;
+
+export class MailAddress {
+  City: Array<string>;
+}
+
+export class Reviewer {
+  Nickname: Array<string> | null;
+  Rating: Array<number> | null;
+  MailAddress: Array<MailAddress> | null;
+  Tags: Array<string>;
+}
@@ -0,0 +1,17 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+export type Genre = 'rock' | 'hip-hop' | 'classical';
+
+export class Playlist {
+  IdAttr: number;
+  SharedAttr: boolean | null;
+  Title: Array<string>;
+  Genre: Array<Genre>;
+  Track: Array<string>;
+  Rating: Array<number>;
+}
@@ -0,0 +1,20 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+export type AmountType = number;
+
+export class Price {
+  CurrencyAttr: string;
+}
+
+export class DiscountPrice {
+  DiscountAttr: number | null;
+}
+
+export class LocalPrice {
+  CurrencyAttr: string | null;
+}
@@ -0,0 +1,22 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+export type TickerSymbol = string;
+
+export class Money {
+  Amount: Array<number>;
+  Currency: Array<string>;
+}
+
+export class TradePriceRequest {
+  TickerSymbol: Array<string>;
+}
+
+export class TradePrice {
+  TickerSymbol: Array<string>;
+  Price: Array<Money>;
+}
@@ -0,0 +1,33 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+export class AnimalType {
+  IdAttr: string | null;
+}
+
+export class CatType {
+  LivesAttr: number | null;
+}
+
+export class DogType {
+  BreedAttr: string | null;
+}
+
+export type Animal = AnimalType;
+
+export type Cat = CatType;
+
+export type Dog = DogType;
+
+export type Puppy = DogType;
+
+export class ZooType {
+  Name: Array<string>;
+  TnsAnimal: Array<AnimalType>;
+}
+
+export type Zoo = ZooType;
@@ -0,0 +1,17 @@
+// Copyright 2020 The xgen Authors. All rights reserved.
+//
+// DO NOT EDIT: generated by xgen XSD generator
+//
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+export type DimensionToken = 'small' | 'large';
+
+export type Dimension = number | DimensionToken | string;
+
+export type TagValue = boolean | string | number;
+
+export class Crate {
+  TagAttr: TagValue | null;
+  Dimension: Array<Dimension>;
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+// Schema holds the top-level definitions of a parsed XML schema document in
+// document order. References to groups and attribute groups have been
+// resolved, so the Elements of a ComplexType or Group and the Attributes of
+// an AttributeGroup include the content of the referenced definitions, and
+// all types are converted to the build-in types of the language specified
+// by the parser options. The definitions are shared with the parser and
+// should be treated as read-only.
+type Schema struct {
+	FilePath        string
+	TargetNamespace string
+	SimpleTypes     []*SimpleType
+	ComplexTypes    []*ComplexType
+	Elements        []*Element
+	Attributes      []*Attribute
+	Groups          []*Group
+	AttributeGroups []*AttributeGroup
+}
+
+// Tree returns the definitions of the XML schema document by the last call of
+// Parse, which can be used for custom code generation without running the
+// code generators by setting the Extract option to true.
+func (opt *Options) Tree() *Schema {
+	schema := &Schema{
+		FilePath:        opt.FilePath,
+		TargetNamespace: opt.TargetNamespace,
+	}
+	for _, ele := range opt.ProtoTree {
+		switch v := ele.(type) {
+		case *SimpleType:
+			schema.SimpleTypes = append(schema.SimpleTypes, v)
+		case *ComplexType:
+			schema.ComplexTypes = append(schema.ComplexTypes, v)
+		case *Element:
+			schema.Elements = append(schema.Elements, v)
+		case *Attribute:
+			schema.Attributes = append(schema.Attributes, v)
+		case *Group:
+			schema.Groups = append(schema.Groups, v)
+		case *AttributeGroup:
+			schema.AttributeGroups = append(schema.AttributeGroups, v)
+		}
+	}
+	return schema
+}
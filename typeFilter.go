@@ -0,0 +1,119 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import "reflect"
+
+// filterTypes restricts the top-level definitions in the proto tree to the
+// ones named in the include list and the definitions they depend on, which
+// are resolved through the type graph transitively, so the generated code is
+// complete. The definitions named in the exclude list are removed, and the
+// definitions only referenced by them are not pulled in. All definitions
+// other than the excluded ones are kept if the include list is empty. The
+// proto tree is copied, since it's shared with the parsers of the dependent
+// schemas.
+func (gen *CodeGenerator) filterTypes(include, exclude []string) {
+	if len(include) == 0 && len(exclude) == 0 {
+		return
+	}
+	excluded := map[string]bool{}
+	for _, name := range exclude {
+		excluded[name] = true
+	}
+	definitions := map[string][]interface{}{}
+	for _, ele := range gen.ProtoTree {
+		if ele == nil {
+			continue
+		}
+		name := reflect.ValueOf(ele).Elem().FieldByName("Name").String()
+		definitions[name] = append(definitions[name], ele)
+	}
+	kept := map[string]bool{}
+	if len(include) == 0 {
+		for name := range definitions {
+			kept[name] = !excluded[name]
+		}
+	}
+	queue := append([]string{}, include...)
+	for len(queue) > 0 {
+		name := trimNSPrefix(queue[0])
+		queue = queue[1:]
+		if kept[name] || excluded[name] {
+			continue
+		}
+		kept[name] = true
+		for _, ele := range definitions[name] {
+			queue = append(queue, typeReferences(ele)...)
+		}
+	}
+	protoTree := make([]interface{}, 0, len(gen.ProtoTree))
+	for _, ele := range gen.ProtoTree {
+		if ele != nil && kept[reflect.ValueOf(ele).Elem().FieldByName("Name").String()] {
+			protoTree = append(protoTree, ele)
+		}
+	}
+	gen.ProtoTree = protoTree
+}
+
+// typeReferences returns the names of the definitions referenced by given
+// definition in the proto tree, including the base types, the member types
+// of the unions, the types of the elements and attributes, and the
+// referenced groups and attribute groups.
+func typeReferences(ele interface{}) (refs []string) {
+	elementTypes := func(elements []Element) {
+		for _, element := range elements {
+			refs = append(refs, element.Type)
+		}
+	}
+	attributeTypes := func(attributes []Attribute) {
+		for _, attribute := range attributes {
+			refs = append(refs, attribute.Type)
+		}
+	}
+	var groupRefs func(groups []Group)
+	groupRefs = func(groups []Group) {
+		for _, group := range groups {
+			refs = append(refs, group.Ref)
+			elementTypes(group.Elements)
+			groupRefs(group.Groups)
+		}
+	}
+	var attrGroupRefs func(attrGroups []AttributeGroup)
+	attrGroupRefs = func(attrGroups []AttributeGroup) {
+		for _, attrGroup := range attrGroups {
+			refs = append(refs, attrGroup.Ref)
+			attributeTypes(attrGroup.Attributes)
+			attrGroupRefs(attrGroup.AttributeGroup)
+		}
+	}
+	switch v := ele.(type) {
+	case *SimpleType:
+		refs = append(refs, v.Base)
+		for memberName, memberType := range v.MemberTypes {
+			refs = append(refs, memberName, memberType)
+		}
+	case *ComplexType:
+		refs = append(refs, v.Base)
+		elementTypes(v.Elements)
+		attributeTypes(v.Attributes)
+		groupRefs(v.Groups)
+		attrGroupRefs(v.AttributeGroup)
+	case *Element:
+		refs = append(refs, v.Type)
+	case *Attribute:
+		refs = append(refs, v.Type)
+	case *Group:
+		elementTypes(v.Elements)
+		groupRefs(v.Groups)
+	case *AttributeGroup:
+		attributeTypes(v.Attributes)
+		attrGroupRefs(v.AttributeGroup)
+	}
+	return
+}
@@ -0,0 +1,351 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/net/html/charset"
+)
+
+// Naming strategies of the anonymous types defined in the local element and
+// attribute declarations of the complex types. AnonymousTypeNamingPath is
+// the default strategy, which names the type by the name of the complex
+// type followed by the name of the declaration, so the names of the nested
+// anonymous types chain the path of the declarations.
+// AnonymousTypeNamingHash names the type by the name of the declaration
+// followed by the hash of the path, which keeps the names of the deeply
+// nested types short.
+const (
+	AnonymousTypeNamingPath = "path"
+	AnonymousTypeNamingHash = "hash"
+)
+
+// anonymousTypeName returns the name of the anonymous type defined in the
+// local declaration of the complex type by the naming strategy of the
+// options, the AnonymousTypeName function given by the options takes
+// precedence over the AnonymousTypeNaming. A number is appended if the name
+// is taken by the definitions parsed before, the top-level definitions of
+// the schemas used by the document or other anonymous types.
+func (opt *Options) anonymousTypeName(parent, name string) string {
+	var typeName string
+	switch {
+	case opt.AnonymousTypeName != nil:
+		typeName = opt.AnonymousTypeName(parent, name)
+	case opt.AnonymousTypeNaming == AnonymousTypeNamingHash:
+		h := fnv.New32a()
+		h.Write([]byte(parent + "/" + name))
+		typeName = fmt.Sprintf("%s%08x", name, h.Sum32())
+	default:
+		typeName = parent + MakeFirstUpperCase(name)
+	}
+	defined := map[string]bool{}
+	for _, ele := range opt.ProtoTree {
+		if kind, name := definitionKind(ele); kind != "" {
+			defined[name] = true
+		}
+	}
+	taken := func(name string) bool {
+		return defined[name] || opt.typeNamespaces[name] != nil || opt.anonymousNames[name]
+	}
+	name = typeName
+	for i := 2; taken(typeName); i++ {
+		typeName = fmt.Sprintf("%s%d", name, i)
+	}
+	if opt.anonymousNames == nil {
+		opt.anonymousNames = map[string]bool{}
+	}
+	opt.anonymousNames[typeName] = true
+	return typeName
+}
+
+// renameTypes renames all top-level definitions in the proto tree and the
+// references to them by given naming function of the schema, and applies the
+// TypeNamePrefix and TypeNameSuffix of the code generator to the names, so
+// the schemas generated into the same package don't collide. The proto tree
+// is copied, since it's shared with the parsers of the dependent schemas,
+// and the original names are kept for the XML names in the generated code.
+func (gen *CodeGenerator) renameTypes(typeName func(name string) string) {
+	gen.xmlNames = map[string]string{}
+	names := map[string]string{}
+	for _, ele := range gen.ProtoTree {
+		var name string
+		switch v := ele.(type) {
+		case *SimpleType:
+			name = v.Name
+		case *ComplexType:
+			name = v.Name
+		case *Element:
+			name = v.Name
+		case *Attribute:
+			name = v.Name
+		case *Group:
+			name = v.Name
+		case *AttributeGroup:
+			name = v.Name
+		default:
+			continue
+		}
+		newName := typeName(name)
+		if gen.TypeNamePrefix != "" || gen.TypeNameSuffix != "" {
+			newName = gen.TypeNamePrefix + MakeFirstUpperCase(newName) + gen.TypeNameSuffix
+		}
+		if newName != name {
+			names[name] = newName
+			gen.xmlNames[newName] = name
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	rename := func(ref string) string {
+		if name, ok := names[trimNSPrefix(ref)]; ok {
+			return name
+		}
+		return ref
+	}
+	gen.ProtoTree = renameTypeRefs(gen.ProtoTree, rename)
+}
+
+// renameTypeRefs returns the copy of the proto tree with the names of the
+// top-level definitions and the references to them renamed by given rename
+// function.
+func renameTypeRefs(protoTree []interface{}, rename func(ref string) string) []interface{} {
+	renameElements := func(elements []Element) []Element {
+		renamed := make([]Element, len(elements))
+		for i, element := range elements {
+			element.Type = rename(element.Type)
+			renamed[i] = element
+		}
+		return renamed
+	}
+	renameAttributes := func(attributes []Attribute) []Attribute {
+		renamed := make([]Attribute, len(attributes))
+		for i, attribute := range attributes {
+			attribute.Type = rename(attribute.Type)
+			renamed[i] = attribute
+		}
+		return renamed
+	}
+	renameGroups := func(groups []Group) []Group {
+		renamed := make([]Group, len(groups))
+		for i, group := range groups {
+			group.Ref = rename(group.Ref)
+			renamed[i] = group
+		}
+		return renamed
+	}
+	renameAttributeGroups := func(attrGroups []AttributeGroup) []AttributeGroup {
+		renamed := make([]AttributeGroup, len(attrGroups))
+		for i, attrGroup := range attrGroups {
+			attrGroup.Ref = rename(attrGroup.Ref)
+			renamed[i] = attrGroup
+		}
+		return renamed
+	}
+	renamed := make([]interface{}, 0, len(protoTree))
+	for _, ele := range protoTree {
+		switch v := ele.(type) {
+		case *SimpleType:
+			simpleType := *v
+			simpleType.Name, simpleType.Base = rename(v.Name), rename(v.Base)
+			if v.MemberTypes != nil {
+				simpleType.MemberTypes = map[string]string{}
+				for memberName, memberType := range v.MemberTypes {
+					simpleType.MemberTypes[rename(memberName)] = rename(memberType)
+				}
+				simpleType.Members = nil
+				for _, memberName := range v.Members {
+					simpleType.Members = append(simpleType.Members, rename(memberName))
+				}
+			}
+			ele = &simpleType
+		case *ComplexType:
+			complexType := *v
+			complexType.Name, complexType.Base = rename(v.Name), rename(v.Base)
+			complexType.Elements = renameElements(v.Elements)
+			complexType.Attributes = renameAttributes(v.Attributes)
+			complexType.Groups = renameGroups(v.Groups)
+			complexType.AttributeGroup = renameAttributeGroups(v.AttributeGroup)
+			ele = &complexType
+		case *Element:
+			element := *v
+			element.Name, element.Type = rename(v.Name), rename(v.Type)
+			ele = &element
+		case *Attribute:
+			attribute := *v
+			attribute.Name, attribute.Type = rename(v.Name), rename(v.Type)
+			ele = &attribute
+		case *Group:
+			group := *v
+			group.Name = rename(v.Name)
+			group.Elements = renameElements(v.Elements)
+			group.Groups = renameGroups(v.Groups)
+			ele = &group
+		case *AttributeGroup:
+			attrGroup := *v
+			attrGroup.Name = rename(v.Name)
+			attrGroup.Attributes = renameAttributes(v.Attributes)
+			attrGroup.AttributeGroup = renameAttributeGroups(v.AttributeGroup)
+			ele = &attrGroup
+		}
+		renamed = append(renamed, ele)
+	}
+	return renamed
+}
+
+// xmlName returns the name of the definition in the XML schema by given name
+// of the definition in the proto tree.
+func (gen *CodeGenerator) xmlName(name string) string {
+	if xmlName, ok := gen.xmlNames[name]; ok {
+		return xmlName
+	}
+	return name
+}
+
+// readSchema returns the content of the schema document by given file path
+// or URL, the remote documents are cached in the RemoteSchema of the options.
+func (opt *Options) readSchema(path string) (body []byte, err error) {
+	if !isValidURL(path) {
+		return ioutil.ReadFile(path)
+	}
+	body, ok := opt.RemoteSchema[path]
+	if ok {
+		return
+	}
+	if body, err = opt.fetchSchema(path); err != nil {
+		return
+	}
+	if opt.RemoteSchema != nil {
+		opt.RemoteSchema[path] = body
+	}
+	return
+}
+
+// collectTypeNamespaces records the namespaces of the top-level definitions
+// in the schema document and the schemas imported, included or redefined by
+// it recursively, the included schemas without target namespace are in the
+// namespace of the including schema. The chain holds the schemas being
+// loaded which lead to the document, an error with the full path of the
+// cycle is returned if the document is one of them. The schemas used by more
+// than one document are only loaded once. Other errors are ignored here,
+// since the documents will be parsed again and the errors will be reported
+// then.
+func (opt *Options) collectTypeNamespaces(path string, body []byte, ns string, visited map[string]bool, chain []string) error {
+	if !isValidURL(path) {
+		path = filepath.Clean(path)
+	}
+	for i, loading := range chain {
+		if loading == path {
+			return fmt.Errorf("circular import %s", strings.Join(append(chain[i:], path), " → "))
+		}
+	}
+	if visited[path] {
+		return nil
+	}
+	visited[path] = true
+	chain = append(chain[:len(chain):len(chain)], path)
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	decoder.CharsetReader = charset.NewReaderLabel
+	var depth, schemaDepth int
+	targetNamespace := ns
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			depth++
+			if element.Name.Space != xsdNamespace {
+				continue
+			}
+			attrs := map[string]string{}
+			for _, attr := range element.Attr {
+				attrs[attr.Name.Local] = attr.Value
+			}
+			if element.Name.Local == "schema" {
+				schemaDepth, targetNamespace = depth, ns
+				if tns, ok := attrs["targetNamespace"]; ok {
+					targetNamespace = tns
+				}
+				continue
+			}
+			if schemaDepth == 0 || depth != schemaDepth+1 {
+				continue
+			}
+			switch element.Name.Local {
+			case "import", "include", "redefine":
+				location := attrs["schemaLocation"]
+				if location == "" {
+					continue
+				}
+				location = opt.locateSchema(path, location)
+				body, err := opt.readSchema(location)
+				if err != nil {
+					continue
+				}
+				if element.Name.Local == "import" {
+					err = opt.collectTypeNamespaces(location, body, "", visited, chain)
+				} else {
+					err = opt.collectTypeNamespaces(location, body, targetNamespace, visited, chain)
+				}
+				if err != nil {
+					return err
+				}
+			case "simpleType", "complexType", "element", "attribute", "group", "attributeGroup":
+				if name := attrs["name"]; name != "" {
+					if opt.typeNamespaces[name] == nil {
+						opt.typeNamespaces[name] = map[string]bool{}
+					}
+					opt.typeNamespaces[name][targetNamespace] = true
+				}
+			}
+		case xml.EndElement:
+			if depth == schemaDepth {
+				schemaDepth = 0
+			}
+			depth--
+		}
+	}
+}
+
+// typeName returns the name of the definition in the namespace by given
+// local name. If definitions with the same name exist in more than one
+// namespace of the schemas being parsed, the name is prefixed with the
+// last segment of the namespace, or all segments of it if the last segments
+// of the namespaces are the same, for example "Item" in the namespace
+// "http://example.com/foo" to "FooItem". The names of the definitions
+// without namespace and the names don't collide are left untouched.
+func (opt *Options) typeName(ns, name string) string {
+	namespaces := opt.typeNamespaces[name]
+	if ns == "" || len(namespaces) < 2 || !namespaces[ns] {
+		return name
+	}
+	segments := map[string]int{}
+	for namespace := range namespaces {
+		parts := strings.Split(nsToName(namespace), ".")
+		segments[parts[len(parts)-1]]++
+	}
+	parts := strings.Split(nsToName(ns), ".")
+	if segments[parts[len(parts)-1]] == 1 {
+		parts = parts[len(parts)-1:]
+	}
+	var prefix string
+	for _, part := range parts {
+		prefix += MakeFirstUpperCase(part)
+	}
+	return prefix + MakeFirstUpperCase(name)
+}
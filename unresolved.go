@@ -0,0 +1,69 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"sort"
+)
+
+// anyTypes defines the types which can hold any value in the languages, the
+// unresolved type references are replaced by the build-in type of the
+// anyType in XML schema for the languages not listed.
+var anyTypes = map[string]string{
+	"Go":         "interface{}",
+	"TypeScript": "any",
+	"Java":       "Object",
+	"Dart":       "dynamic",
+	"Scala":      "Any",
+	"Kotlin":     "Any",
+	"Ruby":       "Object",
+	"Python":     "object",
+	"C#":         "object",
+	"PHP":        "mixed",
+}
+
+// anyUnresolvedTypes returns the copy of the proto tree with the references
+// to the types which aren't defined in the schema document or the schemas
+// used by it replaced by the any type of the language, a warning naming each
+// missing type is logged, so the rest of the generated code still compiles.
+func (opt *Options) anyUnresolvedTypes(protoTree []interface{}) []interface{} {
+	anyType, ok := anyTypes[opt.Lang]
+	if !ok {
+		anyType, _ = getBuildInTypeByLang("anyType", opt.Lang)
+	}
+	known := map[string]bool{anyType: true}
+	for _, buildInTypes := range BuildInTypes {
+		known[buildInTypes[supportLang[opt.Lang]]] = true
+	}
+	for name := range opt.typeNamespaces {
+		known[name] = true
+	}
+	for _, ele := range protoTree {
+		if kind, name := definitionKind(ele); kind != "" {
+			known[name] = true
+		}
+	}
+	missing := map[string]bool{}
+	protoTree = renameTypeRefs(protoTree, func(ref string) string {
+		if ref == "" || known[ref] || known[trimNSPrefix(ref)] {
+			return ref
+		}
+		missing[ref] = true
+		return anyType
+	})
+	var names []string
+	for name := range missing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		opt.warnf("xgen: %s: unresolved type %s is replaced by %s", opt.FilePath, name, anyType)
+	}
+	return protoTree
+}
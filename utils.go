@@ -9,7 +9,8 @@
 package xgen
 
 import (
-	"bytes"
+	"crypto/tls"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -17,25 +18,42 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
-// GetFileList get a list of file by given path.
-func GetFileList(path string) (files []string, err error) {
+// GetFileList get a list of file by given path. If the path is a directory,
+// the regular files in it and its subdirectories with one of the given
+// extensions are returned, the extensions are compared case-insensitively
+// and default to ".xsd". A path to a file is returned as is.
+func GetFileList(path string, exts ...string) (files []string, err error) {
 	var fi os.FileInfo
 	fi, err = os.Stat(path)
 	if err != nil {
 		return
 	}
-	if fi.IsDir() {
-		err = filepath.Walk(path, func(fp string, info os.FileInfo, err error) error {
-			files = append(files, fp)
-			return nil
-		})
+	if !fi.IsDir() {
+		files = append(files, path)
+		return
+	}
+	if len(exts) == 0 {
+		exts = []string{".xsd"}
+	}
+	err = filepath.Walk(path, func(fp string, info os.FileInfo, err error) error {
 		if err != nil {
-			return
+			return err
 		}
-	}
-	files = append(files, path)
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		for _, ext := range exts {
+			if strings.EqualFold(filepath.Ext(fp), ext) {
+				files = append(files, fp)
+				break
+			}
+		}
+		return nil
+	})
 	return
 }
 
@@ -55,68 +73,90 @@ func PrepareOutputDir(path string) error {
 }
 
 // BuildInTypes defines the correspondence between Go, TypeScript, C, Java,
-// Rust languages and data types in XSD.
+// Rust, Dart, Scala, GraphQL, OpenAPI, Kotlin, Swift, Protocol Buffers,
+// Ruby, C++, JSON Schema, Python, C#, PHP languages and data types in XSD.
+// The OpenAPI and JSON Schema types are declared as the type and format
+// separated by a slash, the C# and Dart types serialized as other XML
+// schema data types than the default ones are declared as the type and data
+// type separated by a slash, and the Protocol Buffers types of the lists are
+// declared with the repeated label.
 // https://www.w3.org/TR/xmlschema-2/#datatype
 var BuildInTypes = map[string][]string{
-	"anyType":            {"string", "string", "char", "String", "char"},
-	"ENTITIES":           {"[]string", "Array<string>", "char[]", "List<String>", "Vec<char>"},
-	"ENTITY":             {"string", "string", "char", "String", "char"},
-	"ID":                 {"string", "string", "char", "String", "char"},
-	"IDREF":              {"string", "string", "char", "String", "char"},
-	"IDREFS":             {"[]string", "Array<string>", "char[]", "List<String>", "Vec<char>"},
-	"NCName":             {"string", "string", "char", "String", "char"},
-	"NMTOKEN":            {"string", "string", "char", "String", "char"},
-	"NMTOKENS":           {"[]string", "Array<string>", "char[]", "List<String>", "Vec<char>"},
-	"NOTATION":           {"[]string", "Array<string>", "char[]", "List<String>", "Vec<char>"},
-	"Name":               {"string", "string", "char", "String", "char"},
-	"QName":              {"xml.Name", "any", "char", "String", "char"},
-	"anyURI":             {"string", "string", "char", "QName", "char"},
-	"base64Binary":       {"[]byte", "Array<any>", "char[]", "List<Byte>", "Vec<u8>"},
-	"boolean":            {"bool", "boolean", "bool", "Boolean", "bool"},
-	"byte":               {"byte", "any", "char[]", "Byte", "&[u8]"},
-	"date":               {"time.Time", "string", "char", "Byte", "&[u8]"},
-	"dateTime":           {"time.Time", "string", "char", "Byte", "&[u8]"},
-	"decimal":            {"float64", "number", "float", "Float", "f64"},
-	"double":             {"float64", "number", "float", "Float", "f64"},
-	"duration":           {"string", "string", "char", "String", "char"},
-	"float":              {"float", "number", "float", "Float", "usize"},
-	"gDay":               {"time.Time", "string", "char", "String", "char"},
-	"gMonth":             {"time.Time", "string", "char", "String", "char"},
-	"gMonthDay":          {"time.Time", "string", "char", "String", "char"},
-	"gYear":              {"time.Time", "string", "char", "String", "char"},
-	"gYearMonth":         {"time.Time", "string", "char", "String", "char"},
-	"hexBinary":          {"[]byte", "Array<any>", "char[]", "List<Byte>", "Vec<u8>"},
-	"int":                {"int", "number", "int", "Integer", "isize"},
-	"integer":            {"int", "number", "int", "Integer", "isize"},
-	"language":           {"string", "string", "char", "String", "char"},
-	"long":               {"int64", "number", "int", "Long", "i64"},
-	"negativeInteger":    {"int", "number", "int", "Integer", "isize"},
-	"nonNegativeInteger": {"int", "number", "int", "Integer", "isize"},
-	"normalizedString":   {"string", "string", "char", "String", "char"},
-	"nonPositiveInteger": {"int", "number", "int", "Integer", "isize"},
-	"positiveInteger":    {"int", "number", "int", "Integer", "isize"},
-	"short":              {"int16", "number", "int", "Integer", "i16"},
-	"string":             {"string", "string", "char", "String", "char"},
-	"time":               {"time.Time", "string", "char", "String", "char"},
-	"token":              {"string", "string", "char", "String", "char"},
-	"unsignedByte":       {"byte", "any", "char", "Byte", "&[u8]"},
-	"unsignedInt":        {"uint32", "number", "unsigned int", "Integer", "u32"},
-	"unsignedLong":       {"uint64", "number", "unsigned int", "Long", "u64"},
-	"unsignedShort":      {"uint16", "number", "unsigned int", "Short", "u16"},
-	"xml:lang":           {"string", "string", "char", "String", "char"},
-	"xml:space":          {"string", "string", "char", "String", "char"},
-	"xml:base":           {"string", "string", "char", "String", "char"},
-	"xml:id":             {"string", "string", "char", "String", "char"},
+	"anyType":            {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"ENTITIES":           {"[]string", "Array<string>", "char[]", "List<String>", "Vec<char>", "List<String>", "Seq[String]", "[String!]", "[]string", "List<String>", "[String]", "repeated string", "Array<String>", "std::vector<std::string>", "[]string", "list[str]", "string[]", "array"},
+	"ENTITY":             {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"ID":                 {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"IDREF":              {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"IDREFS":             {"[]string", "Array<string>", "char[]", "List<String>", "Vec<char>", "List<String>", "Seq[String]", "[String!]", "[]string", "List<String>", "[String]", "repeated string", "Array<String>", "std::vector<std::string>", "[]string", "list[str]", "string[]", "array"},
+	"NCName":             {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"NMTOKEN":            {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"NMTOKENS":           {"[]string", "Array<string>", "char[]", "List<String>", "Vec<char>", "List<String>", "Seq[String]", "[String!]", "[]string", "List<String>", "[String]", "repeated string", "Array<String>", "std::vector<std::string>", "[]string", "list[str]", "string[]", "array"},
+	"NOTATION":           {"[]string", "Array<string>", "char[]", "List<String>", "Vec<char>", "List<String>", "Seq[String]", "[String!]", "[]string", "List<String>", "[String]", "repeated string", "Array<String>", "std::vector<std::string>", "[]string", "list[str]", "string[]", "array"},
+	"Name":               {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"QName":              {"xml.Name", "any", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"anyURI":             {"string", "string", "char", "QName", "char", "String", "String", "String", "string/uri", "String", "String", "string", "String", "std::string", "string/uri", "str", "string", "string"},
+	"base64Binary":       {"[]byte", "Array<any>", "char[]", "List<Byte>", "Vec<u8>", "List<int>", "Array[Byte]", "String", "string/byte", "ByteArray", "Data", "bytes", "String", "std::vector<unsigned char>", "string", "bytes", "byte[]", "string"},
+	"boolean":            {"bool", "boolean", "bool", "Boolean", "bool", "bool", "Boolean", "Boolean", "boolean", "Boolean", "Bool", "bool", "Boolean", "bool", "boolean", "bool", "bool", "bool"},
+	"byte":               {"byte", "any", "char[]", "Byte", "&[u8]", "int", "Int", "Int", "integer/int32", "Int", "Int", "int32", "Integer", "signed char", "integer", "int", "sbyte", "int"},
+	"date":               {"XSDDate", "string", "char", "Byte", "&[u8]", "DateTime/date", "java.time.LocalDateTime", "Date", "string/date", "java.time.LocalDateTime", "Date", "string", "Time", "std::string", "string/date", "datetime.date", "DateTime/date", "\\DateTimeImmutable"},
+	"dateTime":           {"XSDDateTime", "string", "char", "Byte", "&[u8]", "DateTime", "java.time.LocalDateTime", "DateTime", "string/date-time", "java.time.LocalDateTime", "Date", "string", "Time", "std::string", "string/date-time", "datetime.datetime", "DateTime", "\\DateTimeImmutable"},
+	"decimal":            {"float64", "number", "float", "Float", "f64", "double", "Double", "Float", "number", "Double", "Double", "double", "Float", "double", "number", "float", "decimal", "float"},
+	"double":             {"float64", "number", "float", "Float", "f64", "double", "Double", "Float", "number/double", "Double", "Double", "double", "Float", "double", "number", "float", "double", "float"},
+	"duration":           {"XSDDuration", "string", "char", "javax.xml.datatype.Duration", "char", "Duration", "javax.xml.datatype.Duration", "Duration", "string/duration", "javax.xml.datatype.Duration", "String", "google.protobuf.Duration", "String", "std::string", "string/duration", "datetime.timedelta", "string", "\\DateInterval"},
+	"float":              {"float", "number", "float", "Float", "usize", "double", "Double", "Float", "number/float", "Double", "Double", "float", "Float", "float", "number", "float", "float", "float"},
+	"gDay":               {"XSDGDay", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"gMonth":             {"XSDGMonth", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"gMonthDay":          {"XSDGMonthDay", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"gYear":              {"XSDGYear", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"gYearMonth":         {"XSDGYearMonth", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"hexBinary":          {"[]byte", "Array<any>", "char[]", "List<Byte>", "Vec<u8>", "List<int>/hexBinary", "Array[Byte]", "String", "string", "ByteArray", "Data", "bytes", "String", "std::vector<unsigned char>", "string", "bytes", "byte[]/hexBinary", "string"},
+	"int":                {"int", "number", "int", "Integer", "isize", "int", "Int", "Int", "integer/int32", "Int", "Int", "int32", "Integer", "int", "integer", "int", "int", "int"},
+	"integer":            {"int", "number", "int", "Integer", "isize", "int", "Int", "Int", "integer", "Int", "Int", "int64", "Integer", "long long", "integer", "int", "long", "int"},
+	"language":           {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"long":               {"int64", "number", "int", "Long", "i64", "int", "Long", "Int", "integer/int64", "Long", "Int64", "int64", "Integer", "long long", "integer", "int", "long", "int"},
+	"negativeInteger":    {"int", "number", "int", "Integer", "isize", "int", "Int", "Int", "integer", "Int", "Int", "int64", "Integer", "long long", "integer", "int", "long", "int"},
+	"nonNegativeInteger": {"int", "number", "int", "Integer", "isize", "int", "Int", "Int", "integer", "Int", "Int", "int64", "Integer", "unsigned long long", "integer", "int", "ulong", "int"},
+	"normalizedString":   {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"nonPositiveInteger": {"int", "number", "int", "Integer", "isize", "int", "Int", "Int", "integer", "Int", "Int", "int64", "Integer", "long long", "integer", "int", "long", "int"},
+	"positiveInteger":    {"int", "number", "int", "Integer", "isize", "int", "Int", "Int", "integer", "Int", "Int", "int64", "Integer", "unsigned long long", "integer", "int", "ulong", "int"},
+	"short":              {"int16", "number", "int", "Integer", "i16", "int", "Int", "Int", "integer/int32", "Int", "Int", "int32", "Integer", "short", "integer", "int", "short", "int"},
+	"string":             {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"time":               {"XSDTime", "string", "char", "String", "char", "String", "String", "Time", "string", "String", "String", "string", "Time", "std::string", "string/time", "datetime.time", "DateTime/time", "\\DateTimeImmutable"},
+	"token":              {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"unsignedByte":       {"byte", "any", "char", "Byte", "&[u8]", "int", "Int", "Int", "integer/int32", "Int", "Int", "uint32", "Integer", "unsigned char", "integer", "int", "byte", "int"},
+	"unsignedInt":        {"uint32", "number", "unsigned int", "Integer", "u32", "int", "Int", "Int", "integer/int64", "Int", "Int", "uint32", "Integer", "unsigned int", "integer", "int", "uint", "int"},
+	"unsignedLong":       {"uint64", "number", "unsigned int", "Long", "u64", "int", "Long", "Int", "integer", "Long", "Int64", "uint64", "Integer", "unsigned long long", "integer", "int", "ulong", "int"},
+	"unsignedShort":      {"uint16", "number", "unsigned int", "Short", "u16", "int", "Int", "Int", "integer/int32", "Int", "Int", "uint32", "Integer", "unsigned short", "integer", "int", "ushort", "int"},
+	"xml:lang":           {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"xml:space":          {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"xml:base":           {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+	"xml:id":             {"string", "string", "char", "String", "char", "String", "String", "String", "string", "String", "String", "string", "String", "std::string", "string", "str", "string", "string"},
+}
+
+// supportLang maps the languages to the columns of the BuildInTypes, the
+// types in the Go column are used for the languages which are not built-in.
+var supportLang = map[string]int{
+	"Go":         0,
+	"TypeScript": 1,
+	"C":          2,
+	"Java":       3,
+	"Rust":       4,
+	"Dart":       5,
+	"Scala":      6,
+	"GraphQL":    7,
+	"OpenAPI":    8,
+	"Kotlin":     9,
+	"Swift":      10,
+	"Protobuf":   11,
+	"Ruby":       12,
+	"C++":        13,
+	"JSONSchema": 14,
+	"Python":     15,
+	"C#":         16,
+	"PHP":        17,
 }
 
 func getBuildInTypeByLang(value, lang string) (buildType string, ok bool) {
-	var supportLang = map[string]int{
-		"Go":         0,
-		"TypeScript": 1,
-		"C":          2,
-		"Java":       3,
-		"Rust":       4,
-	}
 	var buildInTypes []string
 	if buildInTypes, ok = BuildInTypes[value]; !ok {
 		return
@@ -144,38 +184,432 @@ func getBasefromSimpleType(name string, XSDSchema []interface{}) string {
 	return name
 }
 
-func getNSPrefix(str string) (ns string) {
-	split := strings.Split(str, ":")
-	if len(split) == 2 {
-		ns = split[0]
+// typeIndex indexes the named definitions which are looked up by
+// getBasefromSimpleType, the first definition with the name in the proto tree
+// is kept, so the same base is returned in constant time.
+type typeIndex map[string]interface{}
+
+// newTypeIndex creates the index of the definitions in the proto tree.
+func newTypeIndex(XSDSchema []interface{}) typeIndex {
+	index := typeIndex{}
+	for _, ele := range XSDSchema {
+		var name string
+		switch v := ele.(type) {
+		case *SimpleType:
+			if v.List || v.Union {
+				continue
+			}
+			name = v.Name
+		case *Attribute:
+			name = v.Name
+		case *Element:
+			name = v.Name
+		default:
+			continue
+		}
+		if _, ok := index[name]; !ok {
+			index[name] = ele
+		}
+	}
+	return index
+}
+
+// base returns the base type of the definition with the name, or the name if
+// it isn't defined.
+func (index typeIndex) base(name string) string {
+	switch v := index[name].(type) {
+	case *SimpleType:
+		return v.Base
+	case *Attribute:
+		return v.Type
+	case *Element:
+		return v.Type
+	}
+	return name
+}
+
+// getBasefromSimpleType returns the same base type as the
+// getBasefromSimpleType function by the index of the proto tree, which is
+// created when the proto tree is walked by the code generator.
+func (gen *CodeGenerator) getBasefromSimpleType(name string) string {
+	if gen.types == nil {
+		gen.types = newTypeIndex(gen.ProtoTree)
+	}
+	return gen.types.base(name)
+}
+
+// getEnumOrBaseType returns the type of the definition by given type name in
+// the languages which declare the enumerations as their own types. The
+// enumerations are referenced by name since they are declared by the code
+// generator, and other simple types are replaced by their base types.
+func (gen *CodeGenerator) getEnumOrBaseType(name string) string {
+	if name = trimNSPrefix(name); isEnumSimpleType(name, gen.ProtoTree) {
+		return name
+	}
+	return gen.getBasefromSimpleType(name)
+}
+
+// isComplexType reports whether the type with the name is a complex
+// type in the proto tree.
+func (gen *CodeGenerator) isComplexType(name string) bool {
+	for _, ele := range gen.ProtoTree {
+		if v, ok := ele.(*ComplexType); ok && v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// complexTypeContent returns the elements and attributes of the complex type
+// including the content inherited from its base types, for the languages in
+// which the declared types can't be derived from each other.
+func (gen *CodeGenerator) complexTypeContent(v *ComplexType) ([]Element, []Attribute) {
+	if !v.Extension {
+		return v.Elements, v.Attributes
+	}
+	complexTypes := map[string]*ComplexType{}
+	for _, ele := range gen.ProtoTree {
+		if complexType, ok := ele.(*ComplexType); ok {
+			complexTypes[complexType.Name] = complexType
+		}
+	}
+	return complexTypeContent(v.Name, complexTypes, map[string]bool{})
+}
+
+// xsdWhiteSpace defines the white space normalization of the build-in types
+// derived from the string type in XML schema.
+var xsdWhiteSpace = map[string]string{
+	"normalizedString": "replace",
+	"token":            "collapse",
+	"language":         "collapse",
+	"NMTOKEN":          "collapse",
+	"Name":             "collapse",
+	"NCName":           "collapse",
+	"ID":               "collapse",
+	"IDREF":            "collapse",
+	"ENTITY":           "collapse",
+}
+
+// getWhiteSpace returns the white space normalization of the type by given
+// QName, which is defined by the build-in types in XML schema or the
+// whiteSpace facet of the simple types in the proto tree.
+func (opt *Options) getWhiteSpace(name string, XSDSchema []interface{}) string {
+	if ns := opt.parseNS(name); ns == xsdNamespace || ns == "" {
+		if whiteSpace, ok := xsdWhiteSpace[trimNSPrefix(name)]; ok {
+			return whiteSpace
+		}
+	}
+	for _, ele := range XSDSchema {
+		if v, ok := ele.(*SimpleType); ok && v.Name == trimNSPrefix(name) {
+			return v.Restriction.WhiteSpace
+		}
+	}
+	return ""
+}
+
+// isNormalizedSimpleType reports whether the value of the simple type with
+// the name is normalized by replacing or collapsing the white space.
+func isNormalizedSimpleType(name string, XSDSchema []interface{}) bool {
+	for _, ele := range XSDSchema {
+		if v, ok := ele.(*SimpleType); ok && v.Name == name {
+			return !v.List && !v.Union && (v.Restriction.WhiteSpace == "replace" || v.Restriction.WhiteSpace == "collapse")
+		}
+	}
+	return false
+}
+
+// isFacetSimpleType reports whether the simple type with the name, or one of
+// the simple types it's derived from, is restricted by the range, length or
+// pattern facets, which aren't the enumerations, lists or unions.
+func isFacetSimpleType(name string, XSDSchema []interface{}) bool {
+	for visited := map[string]bool{}; !visited[name]; {
+		visited[name] = true
+		var simpleType *SimpleType
+		for _, ele := range XSDSchema {
+			if v, ok := ele.(*SimpleType); ok && v.Name == name {
+				simpleType = v
+				break
+			}
+		}
+		if simpleType == nil || simpleType.List || simpleType.Union || len(simpleType.Restriction.Enum) > 0 {
+			return false
+		}
+		restriction := simpleType.Restriction
+		if restriction.HasMin || restriction.HasMax || restriction.MinLength > 0 || restriction.HasMaxLength || restriction.Pattern != nil {
+			return true
+		}
+		name = trimNSPrefix(simpleType.Base)
+	}
+	return false
+}
+
+// isSimpleType reports whether the type with the name is a simple type in
+// the proto tree.
+func isSimpleType(name string, XSDSchema []interface{}) bool {
+	for _, ele := range XSDSchema {
+		if v, ok := ele.(*SimpleType); ok && v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isEnumSimpleType reports whether the simple type with the name is
+// restricted by enumerations.
+func isEnumSimpleType(name string, XSDSchema []interface{}) bool {
+	for _, ele := range XSDSchema {
+		if v, ok := ele.(*SimpleType); ok && v.Name == name {
+			return len(v.Restriction.Enum) > 0
+		}
+	}
+	return false
+}
+
+// getDerivedTypes returns the names of the complex types derived from each
+// complex type in the proto tree by extension or restriction, including the
+// types derived from them indirectly. Types derived by a method which is
+// prohibited by the final attribute of a base type are not included.
+func getDerivedTypes(XSDSchema []interface{}) map[string][]string {
+	complexTypes := map[string]*ComplexType{}
+	for _, ele := range XSDSchema {
+		if v, ok := ele.(*ComplexType); ok {
+			complexTypes[v.Name] = v
+		}
+	}
+	derivedTypes := map[string][]string{}
+	for _, ele := range XSDSchema {
+		v, ok := ele.(*ComplexType)
+		if !ok {
+			continue
+		}
+		visited := map[string]bool{v.Name: true}
+		for derived := v; ; {
+			base, ok := complexTypes[trimNSPrefix(derived.Base)]
+			if !ok || visited[base.Name] || isDerivationProhibited(derived, base) {
+				break
+			}
+			visited[base.Name] = true
+			derivedTypes[base.Name] = append(derivedTypes[base.Name], v.Name)
+			derived = base
+		}
+	}
+	return derivedTypes
+}
+
+// getAbstractBase returns the name of the abstract complex type which the
+// complex type is derived from by extension, or an empty string if its base
+// type isn't abstract. The classes of such types extend the abstract classes
+// in the languages which flatten other derived types.
+func (gen *CodeGenerator) getAbstractBase(v *ComplexType) string {
+	if !v.Extension {
+		return ""
+	}
+	for _, ele := range gen.ProtoTree {
+		if base, ok := ele.(*ComplexType); ok && base.Name == trimNSPrefix(v.Base) && base.Abstract {
+			return base.Name
+		}
+	}
+	return ""
+}
+
+// getSubstitutionGroups returns the members of the substitution groups
+// headed by the global elements in the proto tree, including the members of
+// the groups headed by the members. The abstract elements are not members
+// since they can't appear in instance documents, and the head element which
+// blocks substitution has no members.
+func getSubstitutionGroups(XSDSchema []interface{}) map[string][]*Element {
+	elements := map[string]*Element{}
+	for _, ele := range XSDSchema {
+		if v, ok := ele.(*Element); ok {
+			elements[v.Name] = v
+		}
+	}
+	substitutionGroups := map[string][]*Element{}
+	for _, ele := range XSDSchema {
+		v, ok := ele.(*Element)
+		if !ok || v.Abstract {
+			continue
+		}
+		visited := map[string]bool{v.Name: true}
+		for member := v; member.SubstitutionGroup != ""; {
+			head, ok := elements[trimNSPrefix(member.SubstitutionGroup)]
+			if !ok || visited[head.Name] || isSubstitutionBlocked(head) {
+				break
+			}
+			visited[head.Name] = true
+			substitutionGroups[head.Name] = append(substitutionGroups[head.Name], v)
+			member = head
+		}
+	}
+	return substitutionGroups
+}
+
+// substitutionGroup returns the head element of the substitution group
+// referenced by the child element of the complex type, and the elements
+// which can appear in place of it, including the head element unless it's
+// abstract, or nil if the element doesn't reference a head element with a
+// member.
+func (gen *CodeGenerator) substitutionGroup(element Element) (head *Element, members []*Element) {
+	if element.Wildcard {
 		return
 	}
+	members = getSubstitutionGroups(gen.ProtoTree)[trimNSPrefix(element.Name)]
+	if len(members) == 0 {
+		return nil, nil
+	}
+	for _, ele := range gen.ProtoTree {
+		if v, ok := ele.(*Element); ok && v.Name == trimNSPrefix(element.Name) && v.Type == element.Type {
+			head = v
+			break
+		}
+	}
+	if head == nil {
+		return nil, nil
+	}
+	if !head.Abstract {
+		members = append([]*Element{head}, members...)
+	}
 	return
 }
 
-func trimNSPrefix(str string) (name string) {
-	split := strings.Split(str, ":")
-	if len(split) == 2 {
-		name = split[1]
+// isSubstitutionBlocked reports whether the block attribute of the head
+// element prohibits the substitution by the members of its substitution
+// group.
+func isSubstitutionBlocked(head *Element) bool {
+	for _, block := range strings.Fields(head.Block) {
+		if block == "#all" || block == "substitution" {
+			return true
+		}
+	}
+	return false
+}
+
+// isDerivationProhibited reports whether the final attribute of the base
+// type prohibits the derivation method of the complex type derived from it.
+func isDerivationProhibited(derived, base *ComplexType) bool {
+	method := "restriction"
+	if derived.Extension {
+		method = "extension"
+	}
+	for _, final := range strings.Fields(base.Final) {
+		if final == "#all" || final == method {
+			return true
+		}
+	}
+	return false
+}
+
+// genDerivationComment generates the line comments which describe the block
+// and final constraints of a declaration, the constraints are not enforced
+// by the generated code.
+func genDerivationComment(block, final string) (comment string) {
+	if final != "" {
+		description := "derivation is prohibited"
+		if methods := derivationMethods(final); methods != "" {
+			description = fmt.Sprintf("derivation by %s is prohibited", methods)
+		}
+		comment += fmt.Sprintf("// final=%q: %s\n", final, description)
+	}
+	if block != "" {
+		description := "substitution is blocked"
+		if methods := derivationMethods(block); methods != "" {
+			description = fmt.Sprintf("substitution by %s is blocked", strings.Replace(methods, "substitution", "substitution group members", 1))
+		}
+		comment += fmt.Sprintf("// block=%q: %s\n", block, description)
+	}
+	return
+}
+
+// withDerivationComment inserts the comments of the block and final
+// constraints into the declaration source after the leading line breaks.
+func withDerivationComment(source, block, final string) string {
+	decl := strings.TrimLeft(source, "\n")
+	return source[:len(source)-len(decl)] + genDerivationComment(block, final) + decl
+}
+
+// genAssertionComment generates the line comments which describe the XML
+// schema 1.1 assertions of a declaration, the assertions are not evaluated
+// by the generated code.
+func genAssertionComment(assertions []string) (comment string) {
+	for _, assertion := range assertions {
+		comment += fmt.Sprintf("// assert: %s\n", assertion)
+	}
+	return
+}
+
+// withAssertionComment inserts the comments of the assertions into the
+// declaration source after the leading line breaks.
+func withAssertionComment(source string, assertions []string) string {
+	decl := strings.TrimLeft(source, "\n")
+	return source[:len(source)-len(decl)] + genAssertionComment(assertions) + decl
+}
+
+// genDocComment generates the comment lines of the documentation of a
+// schema component, each line of the documentation is preceded by the
+// prefix, such as "// " for the line comments.
+func genDocComment(doc, prefix string) (comment string) {
+	if doc == "" {
 		return
 	}
-	name = str
+	for _, line := range strings.Split(doc, "\n") {
+		comment += strings.TrimRight(prefix+line, " ") + "\n"
+	}
 	return
 }
 
-// MakeFirstUpperCase make the first letter of a string uppercase.
-func MakeFirstUpperCase(s string) string {
+// genBlockDocComment generates the documentation comment of a schema
+// component in the Javadoc and TSDoc style, indented by given indent.
+func genBlockDocComment(doc, indent string) string {
+	if doc == "" {
+		return ""
+	}
+	return indent + "/**\n" + genDocComment(strings.Replace(doc, "*/", "*&#47;", -1), indent+" * ") + indent + " */\n"
+}
 
-	if len(s) < 2 {
-		return strings.ToUpper(s)
+// withDocComment inserts the documentation comment into the declaration
+// source after the leading line breaks.
+func withDocComment(source, comment string) string {
+	decl := strings.TrimLeft(source, "\n")
+	return source[:len(source)-len(decl)] + comment + decl
+}
+
+// derivationMethods returns the readable list of the derivation methods in
+// the value of the block or final attribute, or an empty string for "#all".
+func derivationMethods(value string) string {
+	methods := strings.Fields(value)
+	for _, method := range methods {
+		if method == "#all" {
+			return ""
+		}
+	}
+	if len(methods) > 1 {
+		return strings.Join(methods[:len(methods)-1], ", ") + " or " + methods[len(methods)-1]
 	}
+	return strings.Join(methods, "")
+}
 
-	bts := []byte(s)
+// getNSPrefix returns the namespace prefix of the QName, or an empty string
+// if the QName is unprefixed.
+func getNSPrefix(str string) (ns string) {
+	if idx := strings.IndexByte(str, ':'); idx != -1 {
+		ns = str[:idx]
+	}
+	return
+}
 
-	lc := bytes.ToUpper([]byte{bts[0]})
-	rest := bts[1:]
+// trimNSPrefix returns the local part of the QName without the namespace
+// prefix.
+func trimNSPrefix(str string) (name string) {
+	return str[strings.IndexByte(str, ':')+1:]
+}
 
-	return string(bytes.Join([][]byte{lc, rest}, nil))
+// MakeFirstUpperCase make the first letter of a string uppercase.
+func MakeFirstUpperCase(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + s[size:]
 }
 
 // callFuncByName calls the no error or only error return function with
@@ -211,21 +645,109 @@ func isValidURL(toTest string) bool {
 	return true
 }
 
-func fetchSchema(URL string) ([]byte, error) {
+// httpClient returns the HTTP client for fetching the remote schema documents
+// by given options. The proxy URL given by the Proxy option is used for all
+// requests, otherwise the proxy is determined by the environment variables.
+// The certificate verification of the server can be disabled by the
+// InsecureSkipVerify option to fetch schema documents from the registries
+// with self-signed certificates.
+func (opt *Options) httpClient() (*http.Client, error) {
+	proxy := http.ProxyFromEnvironment
+	if opt.Proxy != "" {
+		proxyURL, err := url.Parse(opt.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %s: %v", opt.Proxy, err)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:           proxy,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: opt.InsecureSkipVerify},
+		},
+	}, nil
+}
+
+func (opt *Options) fetchSchema(URL string) ([]byte, error) {
 	var body []byte
-	var client http.Client
-	var err error
+	client, err := opt.httpClient()
+	if err != nil {
+		return body, err
+	}
 	resp, err := client.Get(URL)
 	if err != nil {
 		return body, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusOK {
-		body, err = ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return body, fmt.Errorf("fetch %s: %s", URL, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// genSnakeCaseName generates the lower snake case name by given name,
+// characters which are not allowed in the identifier will be replaced with
+// underscores.
+func genSnakeCaseName(name string) string {
+	var fieldName []rune
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				fieldName = append(fieldName, '_')
+			}
+			fieldName = append(fieldName, unicode.ToLower(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			fieldName = append(fieldName, r)
+		default:
+			if len(fieldName) > 0 && fieldName[len(fieldName)-1] != '_' {
+				fieldName = append(fieldName, '_')
+			}
+		}
+	}
+	return strings.Trim(string(fieldName), "_")
+}
+
+// locateSchema returns the path or URL of the schema location referenced by
+// the schema document at the given path or URL, the location is rewritten by
+// the SchemaLocationMap option like an XML catalog before it's loaded. The
+// location is looked up in the map as it's written in the document, and then
+// as it's resolved, the unmapped locations are resolved normally.
+func (opt *Options) locateSchema(base, location string) string {
+	if mapped, ok := opt.SchemaLocationMap[location]; ok {
+		return mapped
+	}
+	resolved := resolveSchemaLocation(base, location)
+	if mapped, ok := opt.SchemaLocationMap[resolved]; ok {
+		return mapped
+	}
+	return resolved
+}
+
+// resolveSchemaLocation returns the path or URL of the schema location which
+// is referenced by the schema document at the given path or URL. Relative
+// locations are resolved against the URL of the remote document, or the
+// directory of the local document.
+func resolveSchemaLocation(base, location string) string {
+	if isValidURL(location) {
+		return location
+	}
+	if isValidURL(base) {
+		baseURL, err := url.Parse(base)
 		if err != nil {
-			return body, err
+			return location
 		}
+		ref, err := url.Parse(location)
+		if err != nil {
+			return location
+		}
+		return baseURL.ResolveReference(ref).String()
+	}
+	if filepath.IsAbs(location) {
+		return location
 	}
-	return body, err
+	return filepath.Join(filepath.Dir(base), location)
 }
@@ -0,0 +1,20 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import "encoding/xml"
+
+// OnAlternative handles parsing event on the alternative start elements. The
+// alternative element defined by XML schema 1.1 selects the type of an
+// element by an XPath expression, which isn't supported, so the element is
+// skipped with a warning and the declared type of the element is used.
+func (opt *Options) OnAlternative(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.skipUnsupported(ele)
+	return
+}
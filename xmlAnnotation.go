@@ -0,0 +1,178 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// OnAnnotation handles parsing event on the annotation start elements. The
+// annotation element specifies the documentation and the application
+// information of the schema component containing it, which is its parent
+// element.
+func (opt *Options) OnAnnotation(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.docTarget, opt.hintTarget = nil, nil
+	if len(opt.ancestors) > 1 {
+		opt.docTarget, opt.hintTarget = opt.annotated(opt.ancestors[len(opt.ancestors)-2])
+	}
+	return
+}
+
+// EndAnnotation handles parsing event on the annotation end elements.
+func (opt *Options) EndAnnotation(ele xml.EndElement, protoTree []interface{}) (err error) {
+	opt.docTarget, opt.hintTarget = nil, nil
+	return
+}
+
+// OnDocumentation handles parsing event on the documentation start elements.
+// The text of the documentation is kept as the documentation of the
+// annotated component, and the markup in it is ignored.
+func (opt *Options) OnDocumentation(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.skipDepth = 1
+	if opt.docTarget != nil {
+		opt.text = &strings.Builder{}
+	}
+	return
+}
+
+// OnAppinfo handles parsing event on the appinfo start elements. The content
+// of the appinfo is intended for applications rather than the schema
+// processor, so it is never parsed as schema components, and each child
+// element of it is kept as a customization hint of the annotated element or
+// attribute declaration.
+func (opt *Options) OnAppinfo(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.skipDepth = 1
+	opt.inAppinfo = opt.hintTarget != nil
+	return
+}
+
+// startHint starts the customization hint by given child element of the
+// appinfo element which is being parsed.
+func (opt *Options) startHint(ele xml.StartElement) {
+	if !opt.inAppinfo || opt.skipDepth != 2 {
+		return
+	}
+	opt.hint = &Hint{Space: ele.Name.Space, Name: ele.Name.Local}
+	opt.text = &strings.Builder{}
+}
+
+// endHint adds the customization hint which has been parsed to the hints of
+// the annotated declaration, the value of the hint is its trimmed text.
+func (opt *Options) endHint() {
+	if opt.hint == nil {
+		return
+	}
+	opt.hint.Value = strings.TrimSpace(opt.text.String())
+	*opt.hintTarget = append(*opt.hintTarget, *opt.hint)
+	opt.hint, opt.text = nil, nil
+}
+
+// endDocumentation adds the text of the documentation element which has
+// been parsed to the documentation of the annotated component, several
+// documentation elements are separated by blank lines.
+func (opt *Options) endDocumentation() {
+	if opt.text == nil {
+		return
+	}
+	if doc := normalizeDocumentation(opt.text.String()); doc != "" {
+		if *opt.docTarget != "" {
+			*opt.docTarget += "\n\n"
+		}
+		*opt.docTarget += doc
+	}
+	opt.text = nil
+}
+
+// endAncestor removes the element with the name from the ancestors of the
+// elements being parsed, the elements whose end elements have been skipped
+// are removed along with it.
+func (opt *Options) endAncestor(name string) {
+	for i := len(opt.ancestors) - 1; i >= 0; i-- {
+		if opt.ancestors[i] == name {
+			opt.ancestors = opt.ancestors[:i]
+			return
+		}
+	}
+}
+
+// annotated returns the documentation and the customization hints of the
+// schema component which is being parsed by given element name of the
+// component, either is nil if it isn't kept for the component. The hints
+// are only kept for the element and attribute declarations.
+func (opt *Options) annotated(name string) (*string, *[]Hint) {
+	switch name {
+	case "element":
+		if opt.ComplexType.Len() > 0 {
+			if elements := opt.ComplexType.Peek().(*ComplexType).Elements; len(elements) > 0 {
+				return &elements[len(elements)-1].Doc, &elements[len(elements)-1].Hints
+			}
+			return nil, nil
+		}
+		if opt.InGroup > 0 {
+			if opt.Group.Len() > 0 {
+				if elements := opt.Group.Peek().(*Group).Elements; len(elements) > 0 {
+					return &elements[len(elements)-1].Doc, &elements[len(elements)-1].Hints
+				}
+			}
+			return nil, nil
+		}
+		if opt.Element.Len() > 0 {
+			element := opt.Element.Peek().(*Element)
+			return &element.Doc, &element.Hints
+		}
+	case "attribute":
+		if opt.ComplexType.Len() > 0 {
+			if attributes := opt.ComplexType.Peek().(*ComplexType).Attributes; len(attributes) > 0 {
+				return &attributes[len(attributes)-1].Doc, &attributes[len(attributes)-1].Hints
+			}
+			return nil, nil
+		}
+		if opt.Attribute.Len() > 0 {
+			attribute := opt.Attribute.Peek().(*Attribute)
+			return &attribute.Doc, &attribute.Hints
+		}
+	case "complexType":
+		if opt.ComplexType.Len() > 0 {
+			return &opt.ComplexType.Peek().(*ComplexType).Doc, nil
+		}
+	case "simpleType":
+		if opt.SimpleType.Len() > 0 {
+			return &opt.SimpleType.Peek().(*SimpleType).Doc, nil
+		}
+	case "group":
+		if opt.InGroup == 1 && opt.Group.Len() > 0 && opt.ComplexType.Len() == 0 {
+			return &opt.Group.Peek().(*Group).Doc, nil
+		}
+	case "attributeGroup":
+		if opt.AttributeGroup.Len() > 0 && opt.ComplexType.Len() == 0 {
+			return &opt.AttributeGroup.Peek().(*AttributeGroup).Doc, nil
+		}
+	}
+	return nil, nil
+}
+
+// normalizeDocumentation trims the indentation of the lines of the
+// documentation and the blank lines around them, the blank lines between the
+// paragraphs are squashed into one.
+func normalizeDocumentation(text string) string {
+	var lines []string
+	var blank bool
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			blank = len(lines) > 0
+			continue
+		}
+		if blank {
+			lines, blank = append(lines, ""), false
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
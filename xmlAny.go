@@ -0,0 +1,46 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import "encoding/xml"
+
+// OnAny handles parsing event on the any start elements. The any element
+// enables the author to extend the XML document with elements not specified
+// by the schema, it's recorded as the wildcard element of the complex type
+// or group, which may repeat if the maxOccurs is greater than 1.
+func (opt *Options) OnAny(ele xml.StartElement, protoTree []interface{}) (err error) {
+	e := Element{Name: "any", Wildcard: true}
+	e.Type, _ = getBuildInTypeByLang("anyType", opt.Lang)
+	for _, attr := range ele.Attr {
+		if attr.Name.Local == "minOccurs" {
+			if attr.Value == "0" {
+				e.Optional = true
+			}
+		}
+		if attr.Name.Local == "maxOccurs" {
+			if attr.Value != "0" && attr.Value != "1" {
+				e.Plural = true
+			}
+		}
+	}
+	opt.addChoiceElement(&e)
+	opt.addSequenceElement(&e)
+	if opt.ComplexType.Len() > 0 {
+		if !inElements(&e, opt.ComplexType.Peek().(*ComplexType).Elements) {
+			opt.ComplexType.Peek().(*ComplexType).Elements = append(opt.ComplexType.Peek().(*ComplexType).Elements, e)
+		}
+		return
+	}
+	if opt.InGroup > 0 && opt.Group.Len() > 0 {
+		if !inElements(&e, opt.Group.Peek().(*Group).Elements) {
+			opt.Group.Peek().(*Group).Elements = append(opt.Group.Peek().(*Group).Elements, e)
+		}
+	}
+	return
+}
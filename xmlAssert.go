@@ -0,0 +1,61 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import (
+	"encoding/xml"
+)
+
+// OnAssert handles parsing event on the assert start elements. The assert
+// element defined by XML schema 1.1 constrains the content of a complex type
+// by an XPath expression, which isn't evaluated, so the expression is only
+// recorded in the assertions of the complex type.
+func (opt *Options) OnAssert(ele xml.StartElement, protoTree []interface{}) (err error) {
+	if opt.ComplexType.Len() == 0 {
+		opt.skipUnsupported(ele)
+		return
+	}
+	complexType := opt.ComplexType.Peek().(*ComplexType)
+	complexType.Assertions = append(complexType.Assertions, getAssertionTest(ele))
+	opt.skipDepth = 1
+	return
+}
+
+// OnAssertion handles parsing event on the assertion start elements. The
+// assertion element defined by XML schema 1.1 is a facet which constrains
+// the value of a simple type by an XPath expression, which isn't evaluated,
+// so the expression is only recorded in the restriction of the simple type.
+func (opt *Options) OnAssertion(ele xml.StartElement, protoTree []interface{}) (err error) {
+	if opt.SimpleType.Len() == 0 {
+		opt.skipUnsupported(ele)
+		return
+	}
+	restriction := &opt.SimpleType.Peek().(*SimpleType).Restriction
+	restriction.Assertions = append(restriction.Assertions, getAssertionTest(ele))
+	opt.skipDepth = 1
+	return
+}
+
+// getAssertionTest returns the XPath expression of the assert or assertion
+// element.
+func getAssertionTest(ele xml.StartElement) (test string) {
+	for _, attr := range ele.Attr {
+		if attr.Name.Local == "test" {
+			test = attr.Value
+		}
+	}
+	return
+}
+
+// skipUnsupported ignores the element and its descendants of the XML schema
+// 1.1 features which aren't supported by the parser.
+func (opt *Options) skipUnsupported(ele xml.StartElement) {
+	opt.warnf("xgen: %s: skipping unsupported XML schema 1.1 element %s", opt.FilePath, ele.Name.Local)
+	opt.skipDepth = 1
+}
@@ -16,8 +16,10 @@ func (opt *Options) OnAttribute(ele xml.StartElement, protoTree []interface{}) (
 	attribute := Attribute{
 		Optional: true,
 	}
+	var ref, form string
 	for _, attr := range ele.Attr {
 		if attr.Name.Local == "ref" {
+			ref = attr.Value
 			attribute.Name = attr.Value
 			attribute.Type, err = opt.GetValueType(attr.Value, protoTree)
 			if err != nil {
@@ -37,9 +39,22 @@ func (opt *Options) OnAttribute(ele xml.StartElement, protoTree []interface{}) (
 			if attr.Value == "required" {
 				attribute.Optional = false
 			}
+			if attr.Value == "prohibited" {
+				attribute.Prohibited = true
+			}
+		}
+		if attr.Name.Local == "default" || attr.Name.Local == "fixed" {
+			// the fixed value is also supplied for the absent attribute.
+			attribute.Default = attr.Value
+			attribute.Fixed = attr.Name.Local == "fixed"
+		}
+		if attr.Name.Local == "form" {
+			form = attr.Value
 		}
 	}
+	attribute.Namespace = opt.declNamespace(ref, form, opt.attributeFormDefault, opt.ComplexType.Len() == 0 && !opt.InAttributeGroup)
 	if opt.ComplexType.Len() > 0 {
+		opt.localDecl = "attribute"
 		opt.ComplexType.Peek().(*ComplexType).Attributes = append(opt.ComplexType.Peek().(*ComplexType).Attributes, attribute)
 		return
 	}
@@ -50,6 +65,7 @@ func (opt *Options) OnAttribute(ele xml.StartElement, protoTree []interface{}) (
 
 // EndAttribute handles parsing event on the attribute end elements.
 func (opt *Options) EndAttribute(ele xml.EndElement, protoTree []interface{}) (err error) {
+	opt.localDecl = ""
 	if opt.Attribute.Len() == 0 {
 		return
 	}
@@ -43,7 +43,14 @@ func (opt *Options) OnAttributeGroup(ele xml.StartElement, protoTree []interface
 }
 
 // EndAttributeGroup handles parsing event on the attributeGroup end elements.
+// The attributeGroup reference inside an attributeGroup definition will be
+// added to the enclosing definition.
 func (opt *Options) EndAttributeGroup(ele xml.EndElement, protoTree []interface{}) (err error) {
+	if opt.AttributeGroup.Len() > 1 {
+		attributeGroup := opt.AttributeGroup.Pop().(*AttributeGroup)
+		opt.AttributeGroup.Peek().(*AttributeGroup).AttributeGroup = append(opt.AttributeGroup.Peek().(*AttributeGroup).AttributeGroup, *attributeGroup)
+		return
+	}
 	if opt.AttributeGroup.Len() > 0 {
 		opt.ProtoTree = append(opt.ProtoTree, opt.AttributeGroup.Pop())
 		opt.CurrentEle = ""
@@ -51,3 +58,75 @@ func (opt *Options) EndAttributeGroup(ele xml.EndElement, protoTree []interface{
 	}
 	return
 }
+
+// resolveAttributeGroups expands the attributes of the attributeGroup
+// definitions into the complex types and attribute groups which reference
+// them, nested attributeGroup references are resolved transitively.
+// References that can't be found in the proto tree are kept as they are,
+// including the ones nested in the referenced attribute groups.
+func (opt *Options) resolveAttributeGroups() {
+	attributeGroups := map[string]*AttributeGroup{}
+	for _, ele := range opt.ProtoTree {
+		if attributeGroup, ok := ele.(*AttributeGroup); ok && attributeGroup.Ref == "" {
+			attributeGroups[attributeGroup.Name] = attributeGroup
+		}
+	}
+	if len(attributeGroups) == 0 {
+		return
+	}
+	for _, ele := range opt.ProtoTree {
+		switch v := ele.(type) {
+		case *ComplexType:
+			v.Attributes, v.AttributeGroup = inlineAttributeGroups(v.Attributes, v.AttributeGroup, attributeGroups, map[string]bool{})
+		case *AttributeGroup:
+			v.Attributes, v.AttributeGroup = inlineAttributeGroups(v.Attributes, v.AttributeGroup, attributeGroups, map[string]bool{v.Name: true})
+		}
+	}
+}
+
+func inlineAttributeGroups(attributes []Attribute, refs []AttributeGroup, attributeGroups map[string]*AttributeGroup, visited map[string]bool) ([]Attribute, []AttributeGroup) {
+	var unresolved []AttributeGroup
+	for _, ref := range refs {
+		attributeGroup, ok := attributeGroups[trimNSPrefix(ref.Name)]
+		if !ok || visited[attributeGroup.Name] {
+			unresolved = append(unresolved, ref)
+			continue
+		}
+		visited[attributeGroup.Name] = true
+		groupAttributes, groupRefs := inlineAttributeGroups(append([]Attribute{}, attributeGroup.Attributes...), attributeGroup.AttributeGroup, attributeGroups, visited)
+		delete(visited, attributeGroup.Name)
+		// the nested references which can't be found are kept as the
+		// references of the referencing definition, except for the circular
+		// ones.
+		for _, groupRef := range groupRefs {
+			if _, ok := attributeGroups[trimNSPrefix(groupRef.Name)]; ok || inAttributeGroupRefs(&groupRef, unresolved) {
+				continue
+			}
+			unresolved = append(unresolved, groupRef)
+		}
+		for _, attribute := range groupAttributes {
+			if !inAttributes(&attribute, attributes) {
+				attributes = append(attributes, attribute)
+			}
+		}
+	}
+	return attributes, unresolved
+}
+
+func inAttributeGroupRefs(attributeGroup *AttributeGroup, attributeGroups []AttributeGroup) bool {
+	for _, g := range attributeGroups {
+		if attributeGroup.Name == g.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func inAttributes(attribute *Attribute, attributes []Attribute) bool {
+	for _, attr := range attributes {
+		if attribute.Name == attr.Name {
+			return true
+		}
+	}
+	return false
+}
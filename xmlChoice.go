@@ -0,0 +1,103 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import "encoding/xml"
+
+// choiceGroup is the choice model group which is being parsed, the owner is
+// the complex type or group containing it. The choice is compound if any of
+// its branches is a sequence or a group, which may contain more than one
+// element.
+type choiceGroup struct {
+	owner    interface{}
+	choice   Choice
+	plural   bool
+	compound bool
+}
+
+// OnChoice handles parsing event on the choice start elements.
+func (opt *Options) OnChoice(ele xml.StartElement, protoTree []interface{}) (err error) {
+	group := &choiceGroup{owner: opt.particleOwner()}
+	for _, attr := range ele.Attr {
+		if attr.Name.Local == "minOccurs" && attr.Value == "0" {
+			group.choice.Optional = true
+		}
+		if attr.Name.Local == "maxOccurs" && attr.Value != "0" && attr.Value != "1" {
+			group.plural = true
+		}
+	}
+	opt.choices = append(opt.choices, group)
+	return
+}
+
+// EndChoice handles parsing event on the choice end elements. The choice of
+// the complex type is recorded if it's not nested in another choice of it
+// and can't repeat.
+func (opt *Options) EndChoice(ele xml.EndElement, protoTree []interface{}) (err error) {
+	if len(opt.choices) == 0 {
+		return
+	}
+	group := opt.choices[len(opt.choices)-1]
+	opt.choices = opt.choices[:len(opt.choices)-1]
+	if outer := opt.choiceOf(group.owner); outer != nil || group.plural || group.compound || len(group.choice.Elements) < 2 {
+		return
+	}
+	if complexType, ok := group.owner.(*ComplexType); ok {
+		complexType.Choices = append(complexType.Choices, group.choice)
+	}
+	return
+}
+
+// particleOwner returns the complex type or group containing the particles
+// which are being parsed.
+func (opt *Options) particleOwner() interface{} {
+	if opt.ComplexType.Len() > 0 {
+		return opt.ComplexType.Peek()
+	}
+	if opt.InGroup > 0 && opt.Group.Len() > 0 {
+		return opt.Group.Peek()
+	}
+	return nil
+}
+
+// choiceOf returns the outermost choice in the complex type or group which
+// is being parsed, or nil if it's not in a choice.
+func (opt *Options) choiceOf(owner interface{}) *choiceGroup {
+	for _, group := range opt.choices {
+		if group.owner == owner {
+			return group
+		}
+	}
+	return nil
+}
+
+// addChoiceElement declares the element as the branch of the choice which
+// is being parsed in the complex type or group, the element is optional and
+// repeats if the choice repeats.
+func (opt *Options) addChoiceElement(e *Element) {
+	owner := opt.particleOwner()
+	group := opt.choiceOf(owner)
+	if owner == nil || group == nil {
+		return
+	}
+	e.Optional = true
+	for _, inner := range opt.choices {
+		e.Plural = e.Plural || inner.owner == owner && inner.plural
+	}
+	group.choice.Elements = append(group.choice.Elements, e.Name)
+}
+
+// addChoiceParticle declares the sequence or group which is being parsed as
+// the branch of the choice in the complex type, so the elements in it can be
+// present together.
+func (opt *Options) addChoiceParticle() {
+	if group := opt.choiceOf(opt.particleOwner()); group != nil {
+		group.compound = true
+	}
+}
@@ -15,9 +15,27 @@ import "encoding/xml"
 func (opt *Options) OnComplexType(ele xml.StartElement, protoTree []interface{}) (err error) {
 	if opt.ComplexType.Len() > 0 {
 		e := opt.Element.Pop().(*Element)
-		opt.ComplexType.Push(&ComplexType{
+		c := ComplexType{
 			Name: e.Name,
-		})
+		}
+		if parent := opt.ComplexType.Peek().(*ComplexType); opt.localDecl == "element" {
+			// the anonymous complex type of the local element is named
+			// by the path of the element.
+			c.Name, c.Anonymous = opt.anonymousTypeName(parent.Name, e.Name), true
+			for i := len(parent.Elements) - 1; i >= 0; i-- {
+				if parent.Elements[i].Name == e.Name {
+					parent.Elements[i].Type = c.Name
+					break
+				}
+			}
+			opt.localDecl = ""
+		}
+		for _, attr := range ele.Attr {
+			if attr.Name.Local == "mixed" {
+				c.Mixed = attr.Value == "true" || attr.Value == "1"
+			}
+		}
+		opt.ComplexType.Push(&c)
 	}
 
 	if opt.ComplexType.Len() == 0 {
@@ -27,10 +45,22 @@ func (opt *Options) OnComplexType(ele xml.StartElement, protoTree []interface{})
 			if attr.Name.Local == "name" {
 				c.Name = attr.Value
 			}
+			if attr.Name.Local == "mixed" {
+				c.Mixed = attr.Value == "true" || attr.Value == "1"
+			}
+			if attr.Name.Local == "abstract" {
+				c.Abstract = attr.Value == "true" || attr.Value == "1"
+			}
+			if attr.Name.Local == "block" {
+				c.Block = attr.Value
+			}
+			if attr.Name.Local == "final" {
+				c.Final = attr.Value
+			}
 		}
 		if c.Name == "" {
 			e := opt.Element.Pop().(*Element)
-			c.Name = e.Name
+			c.Name, c.Namespace = e.Name, e.Namespace
 		}
 		opt.ComplexType.Push(&c)
 	}
@@ -43,3 +73,74 @@ func (opt *Options) EndComplexType(ele xml.EndElement, protoTree []interface{})
 	opt.CurrentEle = ""
 	return
 }
+
+// resolveRestrictions copies the elements and attributes of the base type
+// into the complex types derived by restriction, the element and attribute
+// declarations of the derived type replace those with the same name in the
+// base type. Base types derived by extension or restriction are resolved
+// through the whole inheritance chain.
+func (opt *Options) resolveRestrictions() {
+	complexTypes := map[string]*ComplexType{}
+	for _, ele := range opt.ProtoTree {
+		if complexType, ok := ele.(*ComplexType); ok {
+			complexTypes[complexType.Name] = complexType
+		}
+	}
+	for _, complexType := range complexTypes {
+		if complexType.Base != "" && !complexType.Extension {
+			complexType.Elements, complexType.Attributes = complexTypeContent(complexType.Name, complexTypes, map[string]bool{})
+		}
+	}
+}
+
+// complexTypeContent returns the elements and attributes of the complex type
+// with the name, including the content inherited from the base types.
+func complexTypeContent(name string, complexTypes map[string]*ComplexType, visited map[string]bool) ([]Element, []Attribute) {
+	complexType, ok := complexTypes[trimNSPrefix(name)]
+	if !ok || visited[complexType.Name] {
+		return nil, nil
+	}
+	if _, ok := complexTypes[trimNSPrefix(complexType.Base)]; !ok {
+		return complexType.Elements, complexType.Attributes
+	}
+	visited[complexType.Name] = true
+	elements, attributes := complexTypeContent(complexType.Base, complexTypes, visited)
+	delete(visited, complexType.Name)
+	elements, attributes = append([]Element{}, elements...), append([]Attribute{}, attributes...)
+	if complexType.Extension {
+		return append(elements, complexType.Elements...), append(attributes, complexType.Attributes...)
+	}
+	for _, element := range complexType.Elements {
+		if idx := indexElement(element.Name, elements); idx != -1 {
+			elements[idx] = element
+			continue
+		}
+		elements = append(elements, element)
+	}
+	for _, attribute := range complexType.Attributes {
+		if idx := indexAttribute(attribute.Name, attributes); idx != -1 {
+			attributes[idx] = attribute
+			continue
+		}
+		attributes = append(attributes, attribute)
+	}
+	return elements, attributes
+}
+
+func indexElement(name string, elements []Element) int {
+	for idx, element := range elements {
+		if element.Name == name {
+			return idx
+		}
+	}
+	return -1
+}
+
+func indexAttribute(name string, attributes []Attribute) int {
+	for idx, attribute := range attributes {
+		if attribute.Name == name {
+			return idx
+		}
+	}
+	return -1
+}
@@ -13,8 +13,10 @@ import "encoding/xml"
 // OnElement handles parsing event on the element start elements.
 func (opt *Options) OnElement(ele xml.StartElement, protoTree []interface{}) (err error) {
 	e := Element{}
+	var ref, form string
 	for _, attr := range ele.Attr {
 		if attr.Name.Local == "ref" {
+			ref = attr.Value
 			e.Name = attr.Value
 			e.Type, err = opt.GetValueType(attr.Value, protoTree)
 			if err != nil {
@@ -31,17 +33,42 @@ func (opt *Options) OnElement(ele xml.StartElement, protoTree []interface{}) (er
 				return
 			}
 		}
-		if attr.Name.Local == "maxOccurs" {
-			if attr.Value != "0" {
-				e.Plural = true
+		if attr.Name.Local == "minOccurs" {
+			if attr.Value == "0" {
+				e.Optional = true
 			}
 		}
-		if attr.Name.Local == "unbounded" {
-			if attr.Value != "0" {
+		if attr.Name.Local == "maxOccurs" {
+			if attr.Value != "0" && attr.Value != "1" {
 				e.Plural = true
 			}
 		}
+		if attr.Name.Local == "abstract" {
+			e.Abstract = attr.Value == "true" || attr.Value == "1"
+		}
+		if attr.Name.Local == "substitutionGroup" {
+			e.SubstitutionGroup = attr.Value
+		}
+		if attr.Name.Local == "nillable" {
+			e.Nillable = attr.Value == "true" || attr.Value == "1"
+		}
+		if attr.Name.Local == "block" {
+			e.Block = attr.Value
+		}
+		if attr.Name.Local == "final" {
+			e.Final = attr.Value
+		}
+		if attr.Name.Local == "default" || attr.Name.Local == "fixed" {
+			e.Default = attr.Value
+			e.Fixed = attr.Name.Local == "fixed"
+		}
+		if attr.Name.Local == "form" {
+			form = attr.Value
+		}
 	}
+	e.Namespace = opt.declNamespace(ref, form, opt.elementFormDefault, opt.ComplexType.Len() == 0 && opt.InGroup == 0)
+	opt.addChoiceElement(&e)
+	opt.addSequenceElement(&e)
 
 	if e.Type == "" {
 		e.Type, err = opt.GetValueType(e.Name, protoTree)
@@ -51,6 +78,7 @@ func (opt *Options) OnElement(ele xml.StartElement, protoTree []interface{}) (er
 		opt.Element.Push(&e)
 	}
 	if opt.ComplexType.Len() > 0 {
+		opt.localDecl = "element"
 		if !inElements(&e, opt.ComplexType.Peek().(*ComplexType).Elements) {
 			opt.ComplexType.Peek().(*ComplexType).Elements = append(opt.ComplexType.Peek().(*ComplexType).Elements, e)
 		}
@@ -70,6 +98,7 @@ func (opt *Options) OnElement(ele xml.StartElement, protoTree []interface{}) (er
 
 // EndElement handles parsing event on the element end elements.
 func (opt *Options) EndElement(ele xml.EndElement, protoTree []interface{}) (err error) {
+	opt.localDecl = ""
 	if opt.Element.Len() > 0 && opt.ComplexType.Len() == 0 {
 		opt.ProtoTree = append(opt.ProtoTree, opt.Element.Pop())
 	}
@@ -0,0 +1,25 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import "encoding/xml"
+
+// OnExtension handles parsing event on the extension start elements. The
+// extension element extends an existing simpleType or complexType element.
+func (opt *Options) OnExtension(ele xml.StartElement, protoTree []interface{}) (err error) {
+	for _, attr := range ele.Attr {
+		if attr.Name.Local == "base" && opt.ComplexType.Len() > 0 {
+			if opt.ComplexType.Peek().(*ComplexType).Base, err = opt.GetValueType(attr.Value, protoTree); err != nil {
+				return
+			}
+			opt.ComplexType.Peek().(*ComplexType).Extension = true
+		}
+	}
+	return
+}
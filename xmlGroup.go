@@ -26,8 +26,13 @@ func (opt *Options) OnGroup(ele xml.StartElement, protoTree []interface{}) (err
 				return
 			}
 		}
+		if attr.Name.Local == "minOccurs" {
+			if attr.Value == "0" {
+				group.Optional = true
+			}
+		}
 		if attr.Name.Local == "maxOccurs" {
-			if attr.Value != "0" {
+			if attr.Value != "0" && attr.Value != "1" {
 				group.Plural = true
 			}
 		}
@@ -41,12 +46,15 @@ func (opt *Options) OnGroup(ele xml.StartElement, protoTree []interface{}) (err
 		}
 		if opt.InGroup > 0 {
 			opt.InGroup++
+			opt.addGroupOccurrence(&group)
 			opt.Group.Peek().(*Group).Groups = append(opt.Group.Peek().(*Group).Groups, group)
 			return
 		}
 
 	}
 	if opt.ComplexType.Len() > 0 {
+		opt.addChoiceParticle()
+		opt.addGroupOccurrence(&group)
 		if !inGroups(&group, opt.ComplexType.Peek().(*ComplexType).Groups) {
 			opt.ComplexType.Peek().(*ComplexType).Groups = append(opt.ComplexType.Peek().(*ComplexType).Groups, group)
 		}
@@ -68,6 +76,26 @@ func (opt *Options) EndGroup(ele xml.EndElement, protoTree []interface{}) (err e
 	return
 }
 
+// addGroupOccurrence applies the occurrence of the choices and sequences in
+// which the group reference is being parsed to the reference, the elements
+// inlined from the referenced group are optional in a choice or an optional
+// sequence, and repeat in a repeating one.
+func (opt *Options) addGroupOccurrence(group *Group) {
+	owner := opt.particleOwner()
+	if owner == nil {
+		return
+	}
+	e := Element{Optional: group.Optional, Plural: group.Plural}
+	opt.addSequenceElement(&e)
+	if opt.choiceOf(owner) != nil {
+		e.Optional = true
+		for _, inner := range opt.choices {
+			e.Plural = e.Plural || inner.owner == owner && inner.plural
+		}
+	}
+	group.Optional, group.Plural = e.Optional, e.Plural
+}
+
 func inGroups(group *Group, groups []Group) bool {
 	for _, g := range groups {
 		if g.Name == group.Name {
@@ -76,3 +104,62 @@ func inGroups(group *Group, groups []Group) bool {
 	}
 	return false
 }
+
+// resolveGroups inlines the elements of the named group definitions into the
+// complex types and groups which reference them, nested group references are
+// resolved transitively. The occurrence of the group reference applies to
+// each of the inlined elements. Group references that can't be found in the
+// proto tree are kept as they are, including the ones nested in the
+// referenced groups.
+func (opt *Options) resolveGroups() {
+	groups := map[string]*Group{}
+	for _, ele := range opt.ProtoTree {
+		if group, ok := ele.(*Group); ok && group.Ref == "" {
+			groups[group.Name] = group
+		}
+	}
+	if len(groups) == 0 {
+		return
+	}
+	for _, ele := range opt.ProtoTree {
+		switch v := ele.(type) {
+		case *ComplexType:
+			v.Elements, v.Groups = inlineGroups(v.Elements, v.Groups, groups, map[string]bool{})
+		case *Group:
+			v.Elements, v.Groups = inlineGroups(v.Elements, v.Groups, groups, map[string]bool{v.Name: true})
+		}
+	}
+}
+
+func inlineGroups(elements []Element, refs []Group, groups map[string]*Group, visited map[string]bool) ([]Element, []Group) {
+	var unresolved []Group
+	for _, ref := range refs {
+		group, ok := groups[trimNSPrefix(ref.Name)]
+		if !ok || visited[group.Name] {
+			unresolved = append(unresolved, ref)
+			continue
+		}
+		visited[group.Name] = true
+		groupElements, groupRefs := inlineGroups(append([]Element{}, group.Elements...), group.Groups, groups, visited)
+		delete(visited, group.Name)
+		// the nested references which can't be found are kept as the
+		// references of the referencing definition, except for the circular
+		// ones.
+		for _, groupRef := range groupRefs {
+			if _, ok := groups[trimNSPrefix(groupRef.Name)]; ok || inGroups(&groupRef, unresolved) {
+				continue
+			}
+			groupRef.Plural = groupRef.Plural || ref.Plural
+			groupRef.Optional = groupRef.Optional || ref.Optional
+			unresolved = append(unresolved, groupRef)
+		}
+		for _, element := range groupElements {
+			element.Plural = element.Plural || ref.Plural
+			element.Optional = element.Optional || ref.Optional
+			if !inElements(&element, elements) {
+				elements = append(elements, element)
+			}
+		}
+	}
+	return elements, unresolved
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import "encoding/xml"
+
+// OnKey handles parsing event on the key start elements.
+func (opt *Options) OnKey(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.onIdentityConstraint(ele)
+	return
+}
+
+// EndKey handles parsing event on the key end elements.
+func (opt *Options) EndKey(ele xml.EndElement, protoTree []interface{}) (err error) {
+	opt.endIdentityConstraint()
+	return
+}
+
+// OnKeyref handles parsing event on the keyref start elements.
+func (opt *Options) OnKeyref(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.onIdentityConstraint(ele)
+	return
+}
+
+// EndKeyref handles parsing event on the keyref end elements.
+func (opt *Options) EndKeyref(ele xml.EndElement, protoTree []interface{}) (err error) {
+	opt.endIdentityConstraint()
+	return
+}
+
+// OnUnique handles parsing event on the unique start elements.
+func (opt *Options) OnUnique(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.onIdentityConstraint(ele)
+	return
+}
+
+// EndUnique handles parsing event on the unique end elements.
+func (opt *Options) EndUnique(ele xml.EndElement, protoTree []interface{}) (err error) {
+	opt.endIdentityConstraint()
+	return
+}
+
+// OnSelector handles parsing event on the selector start elements, which
+// select the elements constrained by the identity constraint.
+func (opt *Options) OnSelector(ele xml.StartElement, protoTree []interface{}) (err error) {
+	if opt.identityConstraint == nil {
+		return
+	}
+	for _, attr := range ele.Attr {
+		if attr.Name.Local == "xpath" {
+			opt.identityConstraint.Selector = attr.Value
+		}
+	}
+	return
+}
+
+// OnField handles parsing event on the field start elements, which select
+// the values of the selected elements compared by the identity constraint.
+func (opt *Options) OnField(ele xml.StartElement, protoTree []interface{}) (err error) {
+	if opt.identityConstraint == nil {
+		return
+	}
+	for _, attr := range ele.Attr {
+		if attr.Name.Local == "xpath" {
+			opt.identityConstraint.Fields = append(opt.identityConstraint.Fields, attr.Value)
+		}
+	}
+	return
+}
+
+// onIdentityConstraint starts the key, keyref or unique constraint of the
+// element declaration being parsed.
+func (opt *Options) onIdentityConstraint(ele xml.StartElement) {
+	opt.identityConstraint = &IdentityConstraint{Kind: ele.Name.Local}
+	for _, attr := range ele.Attr {
+		if attr.Name.Local == "name" {
+			opt.identityConstraint.Name = attr.Value
+		}
+		if attr.Name.Local == "refer" {
+			opt.identityConstraint.Refer = attr.Value
+		}
+	}
+}
+
+// endIdentityConstraint adds the identity constraint to the element
+// declaration which contains it. The local declarations have been appended
+// to the complex type or group being parsed, and the global declaration is
+// on the top of the element stack until it ends.
+func (opt *Options) endIdentityConstraint() {
+	constraint := opt.identityConstraint
+	opt.identityConstraint = nil
+	if constraint == nil {
+		return
+	}
+	var elements []Element
+	if opt.ComplexType.Len() > 0 {
+		elements = opt.ComplexType.Peek().(*ComplexType).Elements
+	} else if opt.InGroup > 0 && opt.Group.Len() > 0 {
+		elements = opt.Group.Peek().(*Group).Elements
+	} else if opt.Element.Len() > 0 {
+		e := opt.Element.Peek().(*Element)
+		e.Constraints = append(e.Constraints, *constraint)
+		return
+	}
+	if len(elements) > 0 {
+		e := &elements[len(elements)-1]
+		e.Constraints = append(e.Constraints, *constraint)
+	}
+}
@@ -10,16 +10,20 @@ package xgen
 
 import "encoding/xml"
 
-// OnInclude handles parsing event on the include start elements. The list
-// element defines a simple type element as a list of values of a specified
-// data type.
+// OnInclude handles parsing event on the include start elements. The include
+// element adds the components of the schema at the location, which is
+// resolved against the location of the including document, to the target
+// namespace of the document.
 func (opt *Options) OnInclude(ele xml.StartElement, protoTree []interface{}) (err error) {
 	for _, ele := range ele.Attr {
 		if ele.Name.Local == "schemaLocation" {
-			if _, ok := opt.IncludeMap[ele.Value]; ok {
+			location := opt.locateSchema(opt.FilePath, ele.Value)
+			if _, ok := opt.IncludeMap[location]; ok {
 				continue
 			}
-			opt.IncludeMap[ele.Value] = true
+			opt.IncludeMap[location] = true
+			opt.dependencies = append(opt.dependencies, location)
+			opt.logf("include %s", location)
 		}
 	}
 	return
@@ -10,6 +10,14 @@ package xgen
 
 import "encoding/xml"
 
+// OnLength handles parsing event on the length start elements, the length is
+// recorded in the restriction of the simple type as both the minimum and
+// maximum length.
+func (opt *Options) OnLength(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.setLength(ele, true, true)
+	return
+}
+
 // EndLength handles parsing event on the length end elements. Length
 // specifies the exact number of characters or list items allowed. Must be
 // equal to or greater than zero.
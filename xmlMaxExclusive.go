@@ -10,6 +10,14 @@ package xgen
 
 import "encoding/xml"
 
+// OnMaxExclusive handles parsing event on the maxExclusive start elements, the
+// exclusive upper bound of the numeric values is recorded in the restriction
+// of the simple type.
+func (opt *Options) OnMaxExclusive(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.setBound(ele, true, true)
+	return
+}
+
 // EndMaxExclusive handles parsing event on the maxExclusive end elements.
 // MaxExclusive specifies the upper bounds for numeric values (the value must
 // be less than this value).
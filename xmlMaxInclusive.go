@@ -10,6 +10,14 @@ package xgen
 
 import "encoding/xml"
 
+// OnMaxInclusive handles parsing event on the maxInclusive start elements, the
+// upper bound of the numeric values is recorded in the restriction of the
+// simple type.
+func (opt *Options) OnMaxInclusive(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.setBound(ele, true, false)
+	return
+}
+
 // EndMaxInclusive handles parsing event on the maxInclusive end elements.
 // MaxInclusive specifies the upper bounds for numeric values (the value must
 // be less than or equal to this value).
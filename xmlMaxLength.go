@@ -10,6 +10,13 @@ package xgen
 
 import "encoding/xml"
 
+// OnMaxLength handles parsing event on the maxLength start elements, the
+// maximum length is recorded in the restriction of the simple type.
+func (opt *Options) OnMaxLength(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.setLength(ele, false, true)
+	return
+}
+
 // EndMaxLength handles parsing event on the maxLength end elements. MaxLength
 // specifies the maximum number of characters or list items allowed. Must be
 // equal to or greater than zero.
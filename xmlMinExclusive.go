@@ -10,6 +10,14 @@ package xgen
 
 import "encoding/xml"
 
+// OnMinExclusive handles parsing event on the minExclusive start elements, the
+// exclusive lower bound of the numeric values is recorded in the restriction
+// of the simple type.
+func (opt *Options) OnMinExclusive(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.setBound(ele, false, true)
+	return
+}
+
 // EndMinExclusive handles parsing event on the minExclusive end elements.
 // MinExclusive specifies the lower bounds for numeric values (the value must
 // be greater than this value).
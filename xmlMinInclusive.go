@@ -10,6 +10,14 @@ package xgen
 
 import "encoding/xml"
 
+// OnMinInclusive handles parsing event on the minInclusive start elements, the
+// lower bound of the numeric values is recorded in the restriction of the
+// simple type.
+func (opt *Options) OnMinInclusive(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.setBound(ele, false, false)
+	return
+}
+
 // EndMinInclusive handles parsing event on the minInclusive end elements.
 // MinInclusive specifies the lower bounds for numeric values (the value must
 // be greater than or equal to this value).
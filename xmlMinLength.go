@@ -10,6 +10,13 @@ package xgen
 
 import "encoding/xml"
 
+// OnMinLength handles parsing event on the minLength start elements, the
+// minimum length is recorded in the restriction of the simple type.
+func (opt *Options) OnMinLength(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.setLength(ele, true, false)
+	return
+}
+
 // EndMinLength handles parsing event on the minLength end elements. MinLength
 // specifies the minimum number of characters or list items allowed. Must be
 // equal to or greater than zero.
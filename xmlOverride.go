@@ -0,0 +1,73 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import "encoding/xml"
+
+// OnOverride handles parsing event on the override start elements. The
+// override element defined by XML schema 1.1 replaces the components of an
+// external schema. The definitions of the external schema are loaded into
+// the proto tree, and the overriding definitions will replace them at the
+// end of the override element.
+func (opt *Options) OnOverride(ele xml.StartElement, protoTree []interface{}) (err error) {
+	for _, attr := range ele.Attr {
+		if attr.Name.Local == "schemaLocation" {
+			if err = opt.parseRedefinedSchema(attr.Value); err != nil {
+				return
+			}
+		}
+	}
+	opt.RedefineStart = len(opt.ProtoTree)
+	return
+}
+
+// EndOverride handles parsing event on the override end elements. Unlike the
+// redefinitions, each overriding definition replaces the original definition
+// of the same kind with the same name as it is, and the definitions which
+// don't override any definition of the external schema are discarded.
+func (opt *Options) EndOverride(ele xml.EndElement, protoTree []interface{}) (err error) {
+	definitions := opt.ProtoTree[opt.RedefineStart:]
+	opt.ProtoTree = opt.ProtoTree[:opt.RedefineStart:opt.RedefineStart]
+	for _, definition := range definitions {
+		for idx, original := range opt.ProtoTree {
+			if overrides(original, definition) {
+				opt.ProtoTree[idx] = definition
+				break
+			}
+		}
+	}
+	return
+}
+
+// overrides reports whether the definition overrides the original
+// definition, which is the top-level component of the same kind with the
+// same name.
+func overrides(original, definition interface{}) bool {
+	switch v := definition.(type) {
+	case *SimpleType:
+		o, ok := original.(*SimpleType)
+		return ok && o.Name == v.Name
+	case *ComplexType:
+		o, ok := original.(*ComplexType)
+		return ok && o.Name == v.Name
+	case *Group:
+		o, ok := original.(*Group)
+		return ok && o.Name == v.Name
+	case *AttributeGroup:
+		o, ok := original.(*AttributeGroup)
+		return ok && o.Name == v.Name
+	case *Element:
+		o, ok := original.(*Element)
+		return ok && o.Name == v.Name
+	case *Attribute:
+		o, ok := original.(*Attribute)
+		return ok && o.Name == v.Name
+	}
+	return false
+}
@@ -8,7 +8,40 @@
 
 package xgen
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"regexp"
+	"strings"
+)
+
+// OnPattern handles parsing event on the pattern start elements, the pattern
+// is recorded in the restriction of the simple type as the regular
+// expression matching the whole value. The patterns in the same restriction
+// are combined by alternation, and the ones which can't be compiled by the
+// regexp package, such as those using the character class subtraction, are
+// ignored with a warning.
+func (opt *Options) OnPattern(ele xml.StartElement, protoTree []interface{}) (err error) {
+	if opt.SimpleType.Peek() == nil {
+		return
+	}
+	restriction := &opt.SimpleType.Peek().(*SimpleType).Restriction
+	for _, attr := range ele.Attr {
+		if attr.Name.Local != "value" {
+			continue
+		}
+		expr := attr.Value
+		if restriction.Pattern != nil {
+			expr = strings.TrimSuffix(strings.TrimPrefix(restriction.Pattern.String(), "^(?:"), ")$") + "|" + expr
+		}
+		pattern, compileErr := regexp.Compile("^(?:" + expr + ")$")
+		if compileErr != nil {
+			opt.warnf("xgen: %s: ignoring unsupported pattern %s", opt.FilePath, attr.Value)
+			continue
+		}
+		restriction.Pattern = pattern
+	}
+	return
+}
 
 // EndPattern handles parsing event on the pattern end elements. Pattern
 // defines the exact sequence of characters that are acceptable.
@@ -0,0 +1,143 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import "encoding/xml"
+
+// OnRedefine handles parsing event on the redefine start elements. The
+// redefine element redefines simple and complex types, groups, and attribute
+// groups from an external schema. The definitions of the external schema are
+// loaded into the proto tree, and the redefinitions will replace them at the
+// end of the redefine element.
+func (opt *Options) OnRedefine(ele xml.StartElement, protoTree []interface{}) (err error) {
+	for _, attr := range ele.Attr {
+		if attr.Name.Local == "schemaLocation" {
+			if err = opt.parseRedefinedSchema(attr.Value); err != nil {
+				return
+			}
+		}
+	}
+	opt.RedefineStart = len(opt.ProtoTree)
+	return
+}
+
+// parseRedefinedSchema loads the definitions of the external schema at the
+// location, which are redefined or overridden by the document, into the
+// proto tree.
+func (opt *Options) parseRedefinedSchema(location string) error {
+	parser := NewParser(&Options{
+		FilePath:            opt.locateSchema(opt.FilePath, location),
+		OutputDir:           opt.OutputDir,
+		Extract:             true,
+		Lang:                opt.Lang,
+		Logger:              opt.Logger,
+		DumpAST:             opt.DumpAST,
+		Proxy:               opt.Proxy,
+		InsecureSkipVerify:  opt.InsecureSkipVerify,
+		Package:             opt.Package,
+		PackagePerNamespace: opt.PackagePerNamespace,
+		AnonymousTypeNaming: opt.AnonymousTypeNaming,
+		AnonymousTypeName:   opt.AnonymousTypeName,
+		IncludeMap:          opt.IncludeMap,
+		LocalNameNSMap:      opt.LocalNameNSMap,
+		NSSchemaLocationMap: opt.NSSchemaLocationMap,
+		SchemaLocationMap:   opt.SchemaLocationMap,
+		ParseFileList:       opt.ParseFileList,
+		ParseFileMap:        opt.ParseFileMap,
+		ProtoTree:           make([]interface{}, 0),
+		RemoteSchema:        opt.RemoteSchema,
+		typeNamespaces:      opt.typeNamespaces,
+		redefined:           true,
+	})
+	if err := parser.Parse(); err != nil {
+		return err
+	}
+	opt.ProtoTree = append(opt.ProtoTree, parser.ProtoTree...)
+	return nil
+}
+
+// EndRedefine handles parsing event on the redefine end elements. Each
+// redefinition replaces the original definition with the same name in place,
+// the reference to the original definition inside the redefinition, such as
+// the extension of a complex type by itself, is resolved with the content of
+// the original definition.
+func (opt *Options) EndRedefine(ele xml.EndElement, protoTree []interface{}) (err error) {
+	redefinitions := opt.ProtoTree[opt.RedefineStart:]
+	opt.ProtoTree = opt.ProtoTree[:opt.RedefineStart:opt.RedefineStart]
+	for _, redefinition := range redefinitions {
+		replaced := false
+		for idx, original := range opt.ProtoTree {
+			if redefined := redefine(original, redefinition); redefined != nil {
+				opt.ProtoTree[idx] = redefined
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			opt.ProtoTree = append(opt.ProtoTree, redefinition)
+		}
+	}
+	return
+}
+
+// redefine returns the redefinition merged with the original definition, or
+// nil if the redefinition doesn't redefine the original definition.
+func redefine(original, redefinition interface{}) interface{} {
+	switch v := redefinition.(type) {
+	case *SimpleType:
+		if o, ok := original.(*SimpleType); ok && o.Name == v.Name {
+			return v
+		}
+	case *ComplexType:
+		if o, ok := original.(*ComplexType); ok && o.Name == v.Name {
+			if trimNSPrefix(v.Base) == o.Name {
+				v.Base, v.Extension = o.Base, o.Extension
+				v.Elements = append(append([]Element{}, o.Elements...), v.Elements...)
+				v.Attributes = append(append([]Attribute{}, o.Attributes...), v.Attributes...)
+				v.Groups = append(append([]Group{}, o.Groups...), v.Groups...)
+				v.AttributeGroup = append(append([]AttributeGroup{}, o.AttributeGroup...), v.AttributeGroup...)
+			}
+			return v
+		}
+	case *Group:
+		if o, ok := original.(*Group); ok && o.Name == v.Name {
+			var elements []Element
+			var groups []Group
+			for _, group := range v.Groups {
+				if trimNSPrefix(group.Name) != o.Name {
+					groups = append(groups, group)
+					continue
+				}
+				for _, element := range o.Elements {
+					element.Plural = element.Plural || group.Plural
+					element.Optional = element.Optional || group.Optional
+					elements = append(elements, element)
+				}
+				groups = append(groups, o.Groups...)
+			}
+			v.Elements, v.Groups = append(elements, v.Elements...), groups
+			return v
+		}
+	case *AttributeGroup:
+		if o, ok := original.(*AttributeGroup); ok && o.Name == v.Name {
+			var attributeGroups []AttributeGroup
+			for _, attributeGroup := range v.AttributeGroup {
+				if trimNSPrefix(attributeGroup.Name) != o.Name {
+					attributeGroups = append(attributeGroups, attributeGroup)
+					continue
+				}
+				v.Attributes = append(append([]Attribute{}, o.Attributes...), v.Attributes...)
+				attributeGroups = append(attributeGroups, o.AttributeGroup...)
+			}
+			v.AttributeGroup = attributeGroups
+			return v
+		}
+	}
+	return nil
+}
@@ -8,7 +8,11 @@
 
 package xgen
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
 
 // OnRestriction handles parsing event on the restriction start elements. The
 // restriction element defines restrictions on a simpleType, simpleContent, or
@@ -29,6 +33,11 @@ func (opt *Options) OnRestriction(ele xml.StartElement, protoTree []interface{})
 				if opt.SimpleType.Peek().(*SimpleType).Name == "" {
 					opt.SimpleType.Peek().(*SimpleType).Name = attr.Value
 				}
+				// the white space normalization is inherited from the base
+				// type, which may be overridden by the whiteSpace facet.
+				opt.SimpleType.Peek().(*SimpleType).Restriction.WhiteSpace = opt.getWhiteSpace(attr.Value, protoTree)
+			} else if opt.ComplexType.Len() > 0 {
+				opt.ComplexType.Peek().(*ComplexType).Base = valueType
 			}
 		}
 	}
@@ -51,3 +60,51 @@ func (opt *Options) EndRestriction(ele xml.EndElement, protoTree []interface{})
 	}
 	return
 }
+
+// setBound records the bound of the numeric values declared by the facet in
+// the restriction of the simple type being parsed, the bounds which aren't
+// numbers, such as those of the date and time types, are ignored.
+func (opt *Options) setBound(ele xml.StartElement, max, exclusive bool) {
+	if opt.SimpleType.Peek() == nil {
+		return
+	}
+	restriction := &opt.SimpleType.Peek().(*SimpleType).Restriction
+	for _, attr := range ele.Attr {
+		if attr.Name.Local != "value" {
+			continue
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(attr.Value), 64)
+		if err != nil {
+			continue
+		}
+		if max {
+			restriction.Max, restriction.HasMax, restriction.MaxExclusive = value, true, exclusive
+			continue
+		}
+		restriction.Min, restriction.HasMin, restriction.MinExclusive = value, true, exclusive
+	}
+}
+
+// setLength records the minimum or maximum length, or both of them by the
+// length facet, in the restriction of the simple type being parsed.
+func (opt *Options) setLength(ele xml.StartElement, min, max bool) {
+	if opt.SimpleType.Peek() == nil {
+		return
+	}
+	restriction := &opt.SimpleType.Peek().(*SimpleType).Restriction
+	for _, attr := range ele.Attr {
+		if attr.Name.Local != "value" {
+			continue
+		}
+		length, err := strconv.Atoi(strings.TrimSpace(attr.Value))
+		if err != nil || length < 0 {
+			continue
+		}
+		if min {
+			restriction.MinLength = length
+		}
+		if max {
+			restriction.MaxLength, restriction.HasMaxLength = length, true
+		}
+	}
+}
@@ -14,5 +14,17 @@ import "encoding/xml"
 // root element of every XML Schema.
 func (opt *Options) OnSchema(ele xml.StartElement, protoTree []interface{}) (err error) {
 	opt.prepareLocalNameNSMap(ele)
+	opt.elementFormDefault, opt.attributeFormDefault = "", ""
+	for _, attr := range ele.Attr {
+		if attr.Name.Local == "targetNamespace" {
+			opt.TargetNamespace = attr.Value
+		}
+		if attr.Name.Local == "elementFormDefault" {
+			opt.elementFormDefault = attr.Value
+		}
+		if attr.Name.Local == "attributeFormDefault" {
+			opt.attributeFormDefault = attr.Value
+		}
+	}
 	return
 }
@@ -0,0 +1,60 @@
+// Copyright 2020 The xgen Authors. All rights reserved. Use of this source
+// code is governed by a BSD-style license that can be found in the LICENSE
+// file.
+//
+// Package xgen written in pure Go providing a set of functions that allow you
+// to parse XSD (XML schema files). This library needs Go version 1.10 or
+// later.
+
+package xgen
+
+import "encoding/xml"
+
+// sequenceGroup is the sequence model group which is being parsed, the
+// owner is the complex type or group containing it.
+type sequenceGroup struct {
+	owner    interface{}
+	optional bool
+	plural   bool
+}
+
+// OnSequence handles parsing event on the sequence start elements. The
+// sequence in a choice is one of its branches.
+func (opt *Options) OnSequence(ele xml.StartElement, protoTree []interface{}) (err error) {
+	opt.addChoiceParticle()
+	group := &sequenceGroup{owner: opt.particleOwner()}
+	for _, attr := range ele.Attr {
+		if attr.Name.Local == "minOccurs" && attr.Value == "0" {
+			group.optional = true
+		}
+		if attr.Name.Local == "maxOccurs" && attr.Value != "0" && attr.Value != "1" {
+			group.plural = true
+		}
+	}
+	opt.sequences = append(opt.sequences, group)
+	return
+}
+
+// EndSequence handles parsing event on the sequence end elements.
+func (opt *Options) EndSequence(ele xml.EndElement, protoTree []interface{}) (err error) {
+	if len(opt.sequences) > 0 {
+		opt.sequences = opt.sequences[:len(opt.sequences)-1]
+	}
+	return
+}
+
+// addSequenceElement declares the element in the sequences which are being
+// parsed in the complex type or group, the element is optional if any of the
+// sequences may be absent, and repeats if any of them repeats.
+func (opt *Options) addSequenceElement(e *Element) {
+	owner := opt.particleOwner()
+	if owner == nil {
+		return
+	}
+	for _, group := range opt.sequences {
+		if group.owner == owner {
+			e.Optional = e.Optional || group.optional
+			e.Plural = e.Plural || group.plural
+		}
+	}
+}
@@ -14,8 +14,15 @@ import "encoding/xml"
 // simpleType element defines a simple type and specifies the constraints and
 // information about the values of attributes or text-only elements.
 func (opt *Options) OnSimpleType(ele xml.StartElement, protoTree []interface{}) (err error) {
+	if opt.anonymous != nil {
+		opt.anonymous.depth++
+	}
 	if opt.SimpleType.Len() == 0 {
-		opt.SimpleType.Push(&SimpleType{})
+		simpleType := &SimpleType{}
+		if opt.ComplexType.Len() > 0 && opt.localDecl != "" {
+			opt.onAnonymousType(simpleType)
+		}
+		opt.SimpleType.Push(simpleType)
 	}
 	if opt.CurrentEle == "attributeGroup" {
 		// return
@@ -25,12 +32,21 @@ func (opt *Options) OnSimpleType(ele xml.StartElement, protoTree []interface{})
 		if attr.Name.Local == "name" {
 			opt.SimpleType.Peek().(*SimpleType).Name = attr.Value
 		}
+		if attr.Name.Local == "final" {
+			opt.SimpleType.Peek().(*SimpleType).Final = attr.Value
+		}
 	}
 	return
 }
 
 // EndSimpleType handles parsing event on the simpleType end elements.
 func (opt *Options) EndSimpleType(ele xml.EndElement, protoTree []interface{}) (err error) {
+	if opt.anonymous != nil {
+		if opt.anonymous.depth--; opt.anonymous.depth == 0 {
+			opt.endAnonymousType()
+		}
+		return
+	}
 	if opt.SimpleType.Len() > 0 && opt.Attribute.Len() > 0 {
 		opt.Attribute.Peek().(*Attribute).Type = opt.SimpleType.Pop().(*SimpleType).Base
 		return
@@ -46,3 +62,62 @@ func (opt *Options) EndSimpleType(ele xml.EndElement, protoTree []interface{}) (
 	}
 	return
 }
+
+// anonymousType is the anonymous simple type defined in the local element or
+// attribute declaration of the complex type. The element stack is set aside
+// while it's parsed, so the facets are applied to the simple type as the
+// named ones instead of replacing the type of the element by the base type.
+type anonymousType struct {
+	parent   *ComplexType
+	decl     string
+	name     string
+	depth    int
+	elements *Stack
+}
+
+// onAnonymousType names the anonymous simple type of the local declaration
+// by the path of the declaration, which is hoisted into the proto tree at
+// the end of it.
+func (opt *Options) onAnonymousType(simpleType *SimpleType) {
+	a := &anonymousType{parent: opt.ComplexType.Peek().(*ComplexType), decl: opt.localDecl, depth: 1}
+	if a.decl == "element" {
+		a.name = opt.Element.Pop().(*Element).Name
+	} else if len(a.parent.Attributes) > 0 {
+		a.name = a.parent.Attributes[len(a.parent.Attributes)-1].Name
+	}
+	simpleType.Name, simpleType.Anonymous = opt.anonymousTypeName(a.parent.Name, a.name), true
+	a.elements, opt.Element = opt.Element, NewStack()
+	opt.anonymous, opt.localDecl = a, ""
+}
+
+// endAnonymousType appends the anonymous simple type to the proto tree and
+// refers the local declaration to it, the reference is replaced by the base
+// type as the references to the named simple types unless they are kept in
+// the language.
+func (opt *Options) endAnonymousType() {
+	a := opt.anonymous
+	opt.anonymous, opt.Element, opt.CurrentEle = nil, a.elements, ""
+	if opt.SimpleType.Len() == 0 {
+		return
+	}
+	simpleType := opt.SimpleType.Pop().(*SimpleType)
+	opt.ProtoTree = append(opt.ProtoTree, simpleType)
+	ref := simpleType.Name
+	if !simpleType.List && !simpleType.Union && !opt.keepsSimpleTypeRef(ref, opt.ProtoTree) {
+		ref = simpleType.Base
+	}
+	if a.decl == "element" {
+		for i := len(a.parent.Elements) - 1; i >= 0; i-- {
+			if a.parent.Elements[i].Name == a.name {
+				a.parent.Elements[i].Type = ref
+				return
+			}
+		}
+	}
+	for i := len(a.parent.Attributes) - 1; i >= 0; i-- {
+		if a.parent.Attributes[i].Name == a.name {
+			a.parent.Attributes[i].Type = ref
+			return
+		}
+	}
+}